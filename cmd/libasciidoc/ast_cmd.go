@@ -0,0 +1,44 @@
+package main
+
+import (
+	"context"
+	"os"
+
+	"github.com/bytesparadise/libasciidoc"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// NewASTCmd returns the command which prints the abstract syntax tree of a document
+func NewASTCmd() *cobra.Command {
+	var format string
+	astCmd := &cobra.Command{
+		Use:   "ast [FILE]",
+		Short: "Print the abstract syntax tree of an asciidoc file (or of STDIN if no file is specified)",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if format != "json" {
+				return errors.Errorf("unsupported format: '%s'", format)
+			}
+			var doc types.Document
+			var err error
+			if len(args) == 0 {
+				doc, err = libasciidoc.Parse(context.Background(), os.Stdin)
+			} else {
+				doc, err = libasciidoc.ParseFile(context.Background(), args[0])
+			}
+			if err != nil {
+				return err
+			}
+			result, err := types.MarshalDocument(doc)
+			if err != nil {
+				return err
+			}
+			_, err = cmd.OutOrStdout().Write(append(result, '\n'))
+			return err
+		},
+	}
+	astCmd.Flags().StringVarP(&format, "format", "f", "json", "output format {json}")
+	return astCmd
+}
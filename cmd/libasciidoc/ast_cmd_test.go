@@ -0,0 +1,41 @@
+package main_test
+
+import (
+	"bytes"
+
+	main "github.com/bytesparadise/libasciidoc/cmd/libasciidoc"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	. "github.com/onsi/ginkgo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var _ = Describe("ast cmd", func() {
+
+	It("print the AST in JSON", func() {
+		// given
+		astCmd := main.NewASTCmd()
+		buf := new(bytes.Buffer)
+		astCmd.SetOutput(buf)
+		astCmd.SetArgs([]string{"--format", "json", "test/test.adoc"})
+		// when
+		err := astCmd.Execute()
+		// then
+		require.NoError(GinkgoT(), err)
+		doc, err := types.UnmarshalDocument(buf.Bytes())
+		require.NoError(GinkgoT(), err)
+		assert.NotEmpty(GinkgoT(), doc.Elements)
+	})
+
+	It("fail with unsupported format", func() {
+		// given
+		astCmd := main.NewASTCmd()
+		buf := new(bytes.Buffer)
+		astCmd.SetOutput(buf)
+		astCmd.SetArgs([]string{"--format", "yaml", "test/test.adoc"})
+		// when
+		err := astCmd.Execute()
+		// then
+		require.Error(GinkgoT(), err)
+	})
+})
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/lint"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// NewLintCmd returns the command which checks the asciidoc files without rendering them
+func NewLintCmd() *cobra.Command {
+	var format string
+	var failureLevel string
+	lintCmd := &cobra.Command{
+		Use:           "lint [FILE...]",
+		Short:         "Check asciidoc files (or STDIN if no file is specified) for problems such as broken cross references or duplicate IDs",
+		Args:          cobra.ArbitraryArgs,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !supportedFormat(format) {
+				return errors.Errorf("unsupported format: '%s'", format)
+			}
+			failureSeverity, err := types.ParseSeverity(failureLevel)
+			if err != nil {
+				return errors.Wrap(err, "invalid failure level")
+			}
+			diagnostics := types.Diagnostics{}
+			if len(args) == 0 {
+				d, err := lint.LintReader(context.Background(), "", os.Stdin)
+				if err != nil {
+					return err
+				}
+				diagnostics = append(diagnostics, d...)
+			}
+			for _, source := range args {
+				d, err := lint.LintFile(context.Background(), source)
+				if err != nil {
+					return err
+				}
+				diagnostics = append(diagnostics, d...)
+			}
+			if err := lint.Report(cmd.OutOrStdout(), format, diagnostics); err != nil {
+				return err
+			}
+			if failures := diagnostics.AtLeast(failureSeverity); len(failures) > 0 {
+				return errors.Errorf("%d problem(s) reported at or above the '%s' failure level", len(failures), failureSeverity)
+			}
+			return nil
+		},
+	}
+	lintCmd.Flags().StringVarP(&format, "format", "f", "text", "output format {"+strings.Join(lint.Formats, ", ")+"}")
+	lintCmd.Flags().StringVar(&failureLevel, "failure-level", "warning", "minimum severity of the reported problems which makes the command fail {info, warning, error}")
+	return lintCmd
+}
+
+func supportedFormat(format string) bool {
+	for _, f := range lint.Formats {
+		if f == format {
+			return true
+		}
+	}
+	return false
+}
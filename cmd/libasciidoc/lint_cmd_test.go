@@ -0,0 +1,68 @@
+package main_test
+
+import (
+	"bytes"
+
+	main "github.com/bytesparadise/libasciidoc/cmd/libasciidoc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/require"
+)
+
+var _ = Describe("lint cmd", func() {
+
+	It("report problems in text", func() {
+		// given
+		lintCmd := main.NewLintCmd()
+		buf := new(bytes.Buffer)
+		lintCmd.SetOutput(buf)
+		lintCmd.SetArgs([]string{"test/diagnostics.adoc"})
+		// when
+		err := lintCmd.Execute()
+		// then
+		require.Error(GinkgoT(), err)
+		Expect(err.Error()).To(Equal("1 problem(s) reported at or above the 'warning' failure level"))
+		Expect(buf.String()).To(ContainSubstring("test/diagnostics.adoc:3:16: error: cross reference to an unknown element: 'unknown' [dangling-xref]\n"))
+		// neither the usage nor the error are printed by the command
+		Expect(buf.String()).NotTo(ContainSubstring("Usage:"))
+		Expect(buf.String()).NotTo(ContainSubstring("Error:"))
+	})
+
+	It("report problems in SARIF", func() {
+		// given
+		lintCmd := main.NewLintCmd()
+		buf := new(bytes.Buffer)
+		lintCmd.SetOutput(buf)
+		lintCmd.SetArgs([]string{"--format", "sarif", "--failure-level", "error", "test/diagnostics.adoc"})
+		// when
+		err := lintCmd.Execute()
+		// then
+		require.Error(GinkgoT(), err)
+		Expect(buf.String()).To(ContainSubstring(`"ruleId": "dangling-xref"`))
+	})
+
+	It("no problem", func() {
+		// given
+		lintCmd := main.NewLintCmd()
+		buf := new(bytes.Buffer)
+		lintCmd.SetOutput(buf)
+		lintCmd.SetArgs([]string{"--format", "json", "test/admonition.adoc"})
+		// when
+		err := lintCmd.Execute()
+		// then
+		require.NoError(GinkgoT(), err)
+		Expect(buf.String()).To(Equal("[]\n"))
+	})
+
+	It("fail with unsupported format", func() {
+		// given
+		lintCmd := main.NewLintCmd()
+		buf := new(bytes.Buffer)
+		lintCmd.SetOutput(buf)
+		lintCmd.SetArgs([]string{"--format", "yaml", "test/diagnostics.adoc"})
+		// when
+		err := lintCmd.Execute()
+		// then
+		require.Error(GinkgoT(), err)
+	})
+})
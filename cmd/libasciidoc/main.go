@@ -13,12 +13,14 @@ func main() {
 	rootCmd := NewRootCmd()
 	versionCmd := NewVersionCmd()
 	rootCmd.AddCommand(versionCmd)
+	rootCmd.AddCommand(NewASTCmd())
+	rootCmd.AddCommand(NewLintCmd())
 	rootCmd.SetHelpCommand(helpCommand)
 	// rootCmd.SetHelpTemplate(helpTemplate)
 	// rootCmd.PersistentFlags().BoolP("help", "h", false, "Print usage")
 	// rootCmd.PersistentFlags().MarkShorthandDeprecated("help", "please use --help")
 	if err := rootCmd.Execute(); err != nil {
-		fmt.Println(err)
+		fmt.Fprintln(os.Stderr, err)
 		os.Exit(1)
 	}
 }
@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"fmt"
 	"io"
 	"os"
 	"strings"
@@ -10,6 +11,9 @@ import (
 
 	"github.com/bytesparadise/libasciidoc"
 	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	htmlrenderer "github.com/bytesparadise/libasciidoc/pkg/renderer/html5"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 )
@@ -19,31 +23,84 @@ func NewRootCmd() *cobra.Command {
 	var noHeaderFooter bool
 	var outputName string
 	var logLevel string
+	var backendName string
+	var failureLevel string
+	var templateDir string
+	var attributes []string
+	var safeModeName string
 	rootCmd := &cobra.Command{
 		Use: "libasciidoc FILE...",
 		Short: `libasciidoc is a tool to generate an html output from an asciidoc file
 
 Positional args:
 If no files are specified, input is read from STDIN
-If more than 1 file is specified, then output is written to ".html" file alongside the source file
+If more than 1 file is specified, then output is written to ".html" (or ".xml" with the docbook5 backend, or ".man" with the manpage backend) file alongside the source file,
+and the cross references between these files (eg: "<<other.adoc#id>>") are checked
+If a directory is specified, all its asciidoc files (and those of its subdirectories) are rendered
 `,
-		Args: cobra.ArbitraryArgs,
+		Args:          cobra.ArbitraryArgs,
+		SilenceUsage:  true,
+		SilenceErrors: true,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			var err error
+			b, found := backends[backendName]
+			if !found {
+				return errors.Errorf("unsupported backend: '%s'", backendName)
+			}
+			var failureSeverity types.Severity
+			if failureLevel != "" {
+				s, err := types.ParseSeverity(failureLevel)
+				if err != nil {
+					return errors.Wrap(err, "invalid failure level")
+				}
+				failureSeverity = s
+			}
+			safeMode, err := renderer.ParseSafeMode(safeModeName)
+			if err != nil {
+				return errors.Wrap(err, "invalid safe mode")
+			}
+			options := []renderer.Option{renderer.IncludeHeaderFooter(!noHeaderFooter), renderer.Safe(safeMode)}
+			if templateDir != "" {
+				templates, err := renderer.LoadTemplates(templateDir)
+				if err != nil {
+					return errors.Wrap(err, "invalid template directory")
+				}
+				options = append(options, renderer.Templates(templates))
+			}
+			if len(attributes) > 0 {
+				options = append(options, renderer.Attributes(parseAttributes(attributes)))
+			}
+			diagnostics := types.Diagnostics{}
 			if len(args) == 0 {
-				out, close := getOut(cmd, "", outputName)
+				out, close := getOut(cmd, "", outputName, b.extension)
 				if out != nil {
 					defer close()
-					_, err = libasciidoc.ConvertToHTML(context.Background(), os.Stdin, out, renderer.IncludeHeaderFooter(!noHeaderFooter))
+					var d types.Diagnostics
+					var metadata map[string]interface{}
+					metadata, d, err = b.convert(context.Background(), os.Stdin, out, options...)
+					diagnostics = append(diagnostics, d...)
+					if err == nil {
+						err = b.copyResources(metadata, "", outputFilename("", outputName, b.extension))
+					}
 				}
 			} else {
-				for _, source := range args {
-					out, close := getOut(cmd, source, outputName)
+				sources, e := expandSources(args)
+				if e != nil {
+					return e
+				}
+				if len(sources) > 1 {
+					options = append(options, renderer.References(newReferenceCatalog(sources, options...)))
+				}
+				for _, source := range sources {
+					out, close := getOut(cmd, source, outputName, b.extension)
 					if out != nil {
 						defer close()
 						path, _ := filepath.Abs(source)
 						log.Debugf("Starting to process file %v", path)
-						_, e := libasciidoc.ConvertFileToHTML(context.Background(), source, out, renderer.IncludeHeaderFooter(!noHeaderFooter)) //renderer.IncludeHeaderFooter(true)
+						metadata, d, e := b.convertFile(context.Background(), source, out, options...)
+						diagnostics = append(diagnostics, d...)
+						if e == nil {
+							e = b.copyResources(metadata, source, outputFilename(source, outputName, b.extension))
+						}
 						if e != nil {
 							log.Errorf("error while rendering file ", err)
 							err = e
@@ -51,7 +108,18 @@ If more than 1 file is specified, then output is written to ".html" file alongsi
 					}
 				}
 			}
-			return err
+			for _, d := range diagnostics {
+				fmt.Fprintln(cmd.ErrOrStderr(), d)
+			}
+			if err != nil {
+				return err
+			}
+			if failureSeverity > 0 {
+				if failures := diagnostics.AtLeast(failureSeverity); len(failures) > 0 {
+					return errors.Errorf("%d problem(s) reported at or above the '%s' failure level", len(failures), failureSeverity)
+				}
+			}
+			return nil
 		},
 		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
 			lvl, err := log.ParseLevel(logLevel)
@@ -67,10 +135,122 @@ If more than 1 file is specified, then output is written to ".html" file alongsi
 	flags := rootCmd.Flags()
 	flags.BoolVarP(&noHeaderFooter, "no-header-footer", "s", false, "Do not render header/footer (default: false)")
 	flags.StringVarP(&outputName, "out-file", "o", "", "output file (default: based on path of input file); use - to output to STDOUT")
+	flags.StringVarP(&backendName, "backend", "b", "html5", "backend to render the document with {html5 (or html), docbook5 (or docbook), manpage}")
+	flags.StringVar(&failureLevel, "failure-level", "", "minimum severity of the reported problems which makes the command fail {info, warning, error} (default: never fail on reported problems)")
+	flags.StringVar(&templateDir, "template-dir", "", "directory of the custom templates (eg: 'paragraph.tmpl') which override the built-in templates of the html5 backend")
+	flags.StringArrayVarP(&attributes, "attribute", "a", nil, "document attribute to set (eg: 'sectnums', 'toc=left' or 'icons=font@' to let the document override it) or unset (eg: 'toc!') from the command line")
+	flags.StringVar(&safeModeName, "safe-mode", "unsafe", "safe mode to convert the documents with {unsafe, safe, server, secure}, which restricts the file inclusions, the passthrough content and the attributes that the documents can set")
 	rootCmd.PersistentFlags().StringVar(&logLevel, "log", "warning", "log level to set {debug, info, warning, error, fatal, panic}")
 	return rootCmd
 }
 
+// backend the conversion functions and the output file extension of a backend
+type backend struct {
+	convert     func(context.Context, io.Reader, io.Writer, ...renderer.Option) (map[string]interface{}, types.Diagnostics, error)
+	convertFile func(context.Context, string, io.Writer, ...renderer.Option) (map[string]interface{}, types.Diagnostics, error)
+	extension   string
+	copy        func(map[string]interface{}, string, string) error // copies the resources of the document next to the output file (optional)
+}
+
+// copyResources copies the resources of the rendered document next to its output file (eg: the stylesheet of an HTML document),
+// unless the document was written to STDOUT
+func (b backend) copyResources(metadata map[string]interface{}, source, output string) error {
+	if b.copy == nil || output == "" {
+		return nil
+	}
+	return b.copy(metadata, source, output)
+}
+
+var backends = map[string]backend{}
+
+// parseAttributes parses the `name=value` (or `name` if there is no value) attributes set with the command line.
+// When an attribute is set (or unset) several times, the last occurrence wins.
+func parseAttributes(attributes []string) map[string]string {
+	result := make(map[string]string, len(attributes))
+	keys := make(map[string]string, len(attributes)) // keys indexed by attribute name, without the `!` and `@` markers
+	for _, a := range attributes {
+		key, value := a, ""
+		if i := strings.Index(a, "="); i >= 0 {
+			key, value = a[:i], a[i+1:]
+		}
+		name := strings.Trim(key, "!@")
+		if previous, found := keys[name]; found {
+			delete(result, previous)
+		}
+		keys[name] = key
+		result[key] = value
+	}
+	return result
+}
+
+func init() {
+	html5 := backend{
+		convert:     libasciidoc.ConvertToHTML,
+		convertFile: libasciidoc.ConvertFileToHTML,
+		extension:   ".html",
+		copy:        htmlrenderer.CopyStylesheet,
+	}
+	docbook5 := backend{
+		convert:     libasciidoc.ConvertToDocBook,
+		convertFile: libasciidoc.ConvertFileToDocBook,
+		extension:   ".xml",
+	}
+	manpage := backend{
+		convert:     libasciidoc.ConvertToManpage,
+		convertFile: libasciidoc.ConvertFileToManpage,
+		extension:   ".man",
+	}
+	backends["html5"] = html5
+	backends["html"] = html5
+	backends["docbook5"] = docbook5
+	backends["docbook"] = docbook5
+	backends["manpage"] = manpage
+}
+
+// expandSources returns the given source files, in which the directories are replaced by the asciidoc files they contain
+// (including in their subdirectories)
+func expandSources(args []string) ([]string, error) {
+	sources := []string{}
+	for _, arg := range args {
+		info, err := os.Stat(arg)
+		if err != nil || !info.IsDir() {
+			// the missing files are reported when they are converted
+			sources = append(sources, arg)
+			continue
+		}
+		err = filepath.Walk(arg, func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if !info.IsDir() && types.IsAsciidocFile(path) {
+				sources = append(sources, path)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "error while listing the files of %s", arg)
+		}
+	}
+	return sources, nil
+}
+
+// newReferenceCatalog returns the catalog of the titles and element references of the given source files,
+// which is used to resolve and check the cross references between them.
+// The files are parsed with the same options as when they are converted (eg: the safe mode and the attributes).
+// The files which cannot be parsed are skipped, and the error is reported when they are converted.
+func newReferenceCatalog(sources []string, options ...renderer.Option) renderer.ReferenceCatalog {
+	catalog := renderer.NewReferenceCatalog()
+	for _, source := range sources {
+		doc, err := libasciidoc.ParseFile(context.Background(), source, options...)
+		if err != nil {
+			log.Debugf("skipping %s in the reference catalog: %v", source, err)
+			continue
+		}
+		catalog.Add(source, doc)
+	}
+	return catalog
+}
+
 type closeFunc func() error
 
 func defaultCloseFunc() closeFunc {
@@ -83,27 +263,31 @@ func newCloseFileFunc(c io.Closer) closeFunc {
 	}
 }
 
-func getOut(cmd *cobra.Command, source, outputName string) (io.Writer, closeFunc) {
-	if outputName == "-" {
+func getOut(cmd *cobra.Command, source, outputName, extension string) (io.Writer, closeFunc) {
+	outname := outputFilename(source, outputName, extension)
+	if outname == "" {
 		// outfile is STDOUT
 		return cmd.OutOrStdout(), defaultCloseFunc()
+	}
+	outfile, err := os.Create(outname)
+	if err != nil {
+		log.Warnf("Cannot create output file - %v, skipping", outname)
+		return nil, nil
+	}
+	return outfile, newCloseFileFunc(outfile)
+}
+
+// outputFilename returns the name of the output file for the given source file, or an empty string if the output is STDOUT
+func outputFilename(source, outputName, extension string) string {
+	if outputName == "-" {
+		return ""
 	} else if outputName != "" {
 		// outfile is specified in the command line
-		outfile, e := os.Create(outputName)
-		if e != nil {
-			log.Warnf("Cannot create output file - %v, skipping", outputName)
-		}
-		return outfile, newCloseFileFunc(outfile)
+		return outputName
 	} else if source != "" {
 		// outfile is based on source
 		path, _ := filepath.Abs(source)
-		outname := strings.TrimSuffix(path, filepath.Ext(path)) + ".html"
-		outfile, err := os.Create(outname)
-		if err != nil {
-			log.Warnf("Cannot create output file - %v, skipping", outname)
-			return nil, nil
-		}
-		return outfile, newCloseFileFunc(outfile)
+		return strings.TrimSuffix(path, filepath.Ext(path)) + extension
 	}
-	return cmd.OutOrStdout(), defaultCloseFunc()
+	return ""
 }
@@ -5,6 +5,7 @@ import (
 	"io/ioutil"
 	"log"
 	"os"
+	"strings"
 	"testing"
 
 	main "github.com/bytesparadise/libasciidoc/cmd/libasciidoc"
@@ -45,6 +46,151 @@ var _ = Describe("root cmd", func() {
 		require.NotEmpty(GinkgoT(), content)
 	})
 
+	It("render with the docbook5 backend", func() {
+		// given
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"-b", "docbook5", "-o", "-", "test/test.adoc"})
+		// when
+		err := root.Execute()
+		// then
+		require.NoError(GinkgoT(), err)
+		Expect(buf.String()).To(ContainSubstring(`<article xmlns="http://docbook.org/ns/docbook"`))
+	})
+
+	It("render with the docbook backend and file output", func() {
+		// given
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"--backend", "docbook", "test/test.adoc"})
+		// when
+		err := root.Execute()
+		// then
+		require.NoError(GinkgoT(), err)
+		content, err := ioutil.ReadFile("test/test.xml")
+		require.NoError(GinkgoT(), err)
+		require.NotEmpty(GinkgoT(), content)
+	})
+
+	It("render with the manpage backend", func() {
+		// given
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"--backend", "manpage", "--failure-level", "warning", "-o", "-", "test/manpage.adoc"})
+		// when
+		err := root.Execute()
+		// then
+		require.NoError(GinkgoT(), err)
+		Expect(buf.String()).To(ContainSubstring(`.TH "LIBASCIIDOC\-TEST" "1" "" "Libasciidoc" "Libasciidoc Manual"`))
+		Expect(buf.String()).To(ContainSubstring(".SH \"NAME\"\nlibasciidoc\\-test \\- a command to test the manpage backend\n"))
+		Expect(buf.String()).To(ContainSubstring(".SH \"OPTIONS\"\n.sp\n\\fB\\-o, \\-\\-out\\-file\\fP=\\fIOUT_FILE\\fP\n.RS 4\nWrite the output to \\fIOUT_FILE\\fP.\n.RE"))
+	})
+
+	It("render with a linked stylesheet copied next to the output file", func() {
+		// given
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"test/linkcss.adoc"})
+		defer os.Remove("test/linkcss.html")
+		defer os.Remove("test/libasciidoc.css")
+		// when
+		err := root.Execute()
+		// then
+		require.NoError(GinkgoT(), err)
+		content, err := ioutil.ReadFile("test/linkcss.html")
+		require.NoError(GinkgoT(), err)
+		Expect(string(content)).To(ContainSubstring(`<link rel="stylesheet" href="./libasciidoc.css">`))
+		stylesheet, err := ioutil.ReadFile("test/libasciidoc.css")
+		require.NoError(GinkgoT(), err)
+		require.NotEmpty(GinkgoT(), stylesheet)
+	})
+
+	It("render with custom templates", func() {
+		// given
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"--template-dir", "test/templates", "-o", "-", "test/links.adoc"})
+		// when
+		err := root.Execute()
+		// then
+		require.NoError(GinkgoT(), err)
+		Expect(buf.String()).To(ContainSubstring(`<p class="custom">`))
+		Expect(buf.String()).ToNot(ContainSubstring(`<div class="paragraph">`))
+	})
+
+	It("fail with unknown template directory", func() {
+		// given
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"--template-dir", "test/unknown", "-o", "-", "test/test.adoc"})
+		// when
+		err := root.Execute()
+		// then
+		require.Error(GinkgoT(), err)
+	})
+
+	It("fail with unknown backend", func() {
+		// given
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"-b", "pdf", "-o", "-", "test/test.adoc"})
+		// when
+		err := root.Execute()
+		// then
+		require.Error(GinkgoT(), err)
+	})
+
+	It("fail with problems at the failure level", func() {
+		// given
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"--failure-level", "warning", "-o", "-", "test/diagnostics.adoc"})
+		// when
+		err := root.Execute()
+		// then
+		require.Error(GinkgoT(), err)
+		Expect(err.Error()).To(Equal("1 problem(s) reported at or above the 'warning' failure level"))
+		// the document is still rendered
+		Expect(buf.String()).To(ContainSubstring(`<a href="#unknown">[unknown]</a>`))
+		// the problems are printed once
+		Expect(strings.Count(buf.String(), "test/diagnostics.adoc:3:16: warning: unable to resolve cross reference to 'unknown' [dangling-xref]\n")).To(Equal(1))
+		// neither the usage nor the error are printed by the command
+		Expect(buf.String()).NotTo(ContainSubstring("Usage:"))
+		Expect(buf.String()).NotTo(ContainSubstring("Error:"))
+	})
+
+	It("render with problems below the failure level", func() {
+		// given
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"--failure-level", "error", "-o", "-", "test/diagnostics.adoc"})
+		// when
+		err := root.Execute()
+		// then
+		require.NoError(GinkgoT(), err)
+	})
+
+	It("fail with unknown failure level", func() {
+		// given
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"--failure-level", "fatal", "-o", "-", "test/test.adoc"})
+		// when
+		err := root.Execute()
+		// then
+		require.Error(GinkgoT(), err)
+	})
+
 	It("fail to parse bad log level", func() {
 		// given
 		root := main.NewRootCmd()
@@ -58,6 +204,85 @@ var _ = Describe("root cmd", func() {
 		require.Error(GinkgoT(), err)
 	})
 
+	It("render with attributes set from the command line", func() {
+		// given
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"-s", "-a", "edition=community", "-a", "product=asciidoc", "-o", "-", "test/attributes.adoc"})
+		// when
+		err := root.Execute()
+		// then
+		require.NoError(GinkgoT(), err)
+		Expect(buf.String()).To(ContainSubstring("This is the community edition of asciidoc."))
+	})
+
+	It("render with the source highlighter set from the command line", func() {
+		// given
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"-s", "-a", "source-highlighter=rouge", "-o", "-", "test/source.adoc"})
+		// when
+		err := root.Execute()
+		// then
+		require.NoError(GinkgoT(), err)
+		Expect(buf.String()).To(ContainSubstring(`<pre class="builtin highlight"><code data-lang="go"><span class="k">return</span> <span class="kc">true</span></code></pre>`))
+	})
+
+	It("render with attributes soft set and unset from the command line", func() {
+		// given
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"-s", "-a", "edition=community", "-a", "product=asciidoc@", "-o", "-", "test/attributes.adoc"})
+		// when
+		err := root.Execute()
+		// then
+		require.NoError(GinkgoT(), err)
+		Expect(buf.String()).To(ContainSubstring("This is the community edition of libasciidoc."))
+	})
+
+	It("render with the last occurrence of an attribute set from the command line", func() {
+		// given
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"-s", "-a", "edition=community", "-a", "edition!", "-o", "-", "test/attributes.adoc"})
+		// when
+		err := root.Execute()
+		// then
+		require.NoError(GinkgoT(), err)
+		Expect(buf.String()).ToNot(ContainSubstring("edition of"))
+	})
+
+	It("render in secure mode", func() {
+		// given
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"-s", "--safe-mode", "secure", "-o", "-", "test/untrusted.adoc"})
+		// when
+		err := root.Execute()
+		// then
+		require.NoError(GinkgoT(), err)
+		Expect(buf.String()).To(ContainSubstring(`<a href="admonition.adoc" class="bare">admonition.adoc</a>`))
+		Expect(buf.String()).To(ContainSubstring(`&lt;script&gt;`))
+		Expect(buf.String()).ToNot(ContainSubstring(`<script>`))
+	})
+
+	It("fail with unknown safe mode", func() {
+		// given
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"--safe-mode", "paranoid", "-o", "-", "test/test.adoc"})
+		// when
+		err := root.Execute()
+		// then
+		require.Error(GinkgoT(), err)
+	})
+
 	It("render without header/footer", func() {
 		// given
 		root := main.NewRootCmd()
@@ -112,6 +337,46 @@ var _ = Describe("root cmd", func() {
 		require.NoError(GinkgoT(), err)
 	})
 
+	It("render a directory and check the cross references between its files", func() {
+		// given
+		root := main.NewRootCmd()
+		root.SetArgs([]string{"-s", "--failure-level", "warning", "test/site"})
+		// when
+		err := root.Execute()
+		// then
+		require.NoError(GinkgoT(), err)
+		content, err := ioutil.ReadFile("test/site/index.html")
+		require.NoError(GinkgoT(), err)
+		Expect(string(content)).To(ContainSubstring(`see the <a href="guide.html#install">Installation</a> section of the <a href="guide.html">The Guide</a>.`))
+		content, err = ioutil.ReadFile("test/site/guide.html")
+		require.NoError(GinkgoT(), err)
+		Expect(string(content)).To(ContainSubstring(`back to <a href="index.html">the home page</a>.`))
+	})
+
+	It("check the cross references between multiple files with the attributes of the command line", func() {
+		// given
+		root := main.NewRootCmd()
+		root.SetArgs([]string{"-s", "--failure-level", "warning", "-a", "edition-pro", "test/editions"})
+		// when
+		err := root.Execute()
+		// then
+		require.NoError(GinkgoT(), err)
+		content, err := ioutil.ReadFile("test/editions/index.html")
+		require.NoError(GinkgoT(), err)
+		Expect(string(content)).To(ContainSubstring(`see the <a href="guide.html#support">Support</a> section`))
+	})
+
+	It("fail with dangling cross references between multiple files", func() {
+		// given
+		root := main.NewRootCmd()
+		root.SetArgs([]string{"-s", "--failure-level", "warning", "test/links.adoc", "test/site"})
+		// when
+		err := root.Execute()
+		// then
+		require.Error(GinkgoT(), err)
+		Expect(err.Error()).To(Equal("1 problem(s) reported at or above the 'warning' failure level"))
+	})
+
 	It("when rendering multiple files, return last error", func() {
 		// given
 		root := main.NewRootCmd()
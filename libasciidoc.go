@@ -8,8 +8,11 @@ import (
 	"time"
 
 	"github.com/bytesparadise/libasciidoc/pkg/parser"
+	"github.com/bytesparadise/libasciidoc/pkg/preprocessor"
 	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	docbookrenderer "github.com/bytesparadise/libasciidoc/pkg/renderer/docbook5"
 	htmlrenderer "github.com/bytesparadise/libasciidoc/pkg/renderer/html5"
+	manpagerenderer "github.com/bytesparadise/libasciidoc/pkg/renderer/manpage"
 	"github.com/bytesparadise/libasciidoc/pkg/types"
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
@@ -25,26 +28,104 @@ var (
 )
 
 // ConvertFileToHTML converts the content of the given filename into an HTML document.
-// The conversion result is written in the given writer `output`, whereas the document metadata (title, etc.) (or an error if a problem occurred) is returned
-// as the result of the function call.
-func ConvertFileToHTML(ctx context.Context, filename string, output io.Writer, options ...renderer.Option) (map[string]interface{}, error) {
+// The conversion result is written in the given writer `output`, whereas the document metadata (title, etc.) and the diagnostics
+// reported during the conversion (or an error if a problem occurred) are returned as the result of the function call.
+func ConvertFileToHTML(ctx context.Context, filename string, output io.Writer, options ...renderer.Option) (map[string]interface{}, types.Diagnostics, error) {
 	file, err := os.Open(filename)
 	if err != nil {
-		return nil, errors.Wrapf(err, "error opening %s", filename)
+		return nil, nil, errors.Wrapf(err, "error opening %s", filename)
 	}
 	defer file.Close()
-	return ConvertToHTML(ctx, file, output, options...)
+	return convert(ctx, filename, file, output, htmlrenderer.Render, options...)
 }
 
 // ConvertToHTML converts the content of the given reader `r` into a full HTML document, written in the given writer `output`.
-// Returns an error if a problem occurred
-func ConvertToHTML(ctx context.Context, r io.Reader, output io.Writer, options ...renderer.Option) (map[string]interface{}, error) {
+// Returns the document metadata and the diagnostics reported during the conversion, or an error if a problem occurred
+func ConvertToHTML(ctx context.Context, r io.Reader, output io.Writer, options ...renderer.Option) (map[string]interface{}, types.Diagnostics, error) {
+	return convert(ctx, "", r, output, htmlrenderer.Render, options...)
+}
+
+// ConvertFileToDocBook converts the content of the given filename into a DocBook 5 document.
+// The conversion result is written in the given writer `output`, whereas the document metadata (title, etc.) and the diagnostics
+// reported during the conversion (or an error if a problem occurred) are returned as the result of the function call.
+func ConvertFileToDocBook(ctx context.Context, filename string, output io.Writer, options ...renderer.Option) (map[string]interface{}, types.Diagnostics, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "error opening %s", filename)
+	}
+	defer file.Close()
+	return convert(ctx, filename, file, output, docbookrenderer.Render, options...)
+}
+
+// ConvertToDocBook converts the content of the given reader `r` into a full DocBook 5 document, written in the given writer `output`.
+// Returns the document metadata and the diagnostics reported during the conversion, or an error if a problem occurred
+func ConvertToDocBook(ctx context.Context, r io.Reader, output io.Writer, options ...renderer.Option) (map[string]interface{}, types.Diagnostics, error) {
+	return convert(ctx, "", r, output, docbookrenderer.Render, options...)
+}
+
+// ConvertFileToManpage converts the content of the given filename into a man page, in the roff format.
+// The conversion result is written in the given writer `output`, whereas the document metadata (title, etc.) and the diagnostics
+// reported during the conversion (or an error if a problem occurred) are returned as the result of the function call.
+func ConvertFileToManpage(ctx context.Context, filename string, output io.Writer, options ...renderer.Option) (map[string]interface{}, types.Diagnostics, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "error opening %s", filename)
+	}
+	defer file.Close()
+	return convert(ctx, filename, file, output, manpagerenderer.Render, options...)
+}
+
+// ConvertToManpage converts the content of the given reader `r` into a full man page in the roff format, written in the given writer `output`.
+// Returns the document metadata and the diagnostics reported during the conversion, or an error if a problem occurred
+func ConvertToManpage(ctx context.Context, r io.Reader, output io.Writer, options ...renderer.Option) (map[string]interface{}, types.Diagnostics, error) {
+	return convert(ctx, "", r, output, manpagerenderer.Render, options...)
+}
+
+// ParseFile parses the content of the given filename into a document, once its preprocessor directives (such as `include::`) were processed.
+// The document is not rendered, but its structure can be inspected or exported (eg: with `types.MarshalDocument`)
+func ParseFile(ctx context.Context, filename string, options ...renderer.Option) (types.Document, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return types.Document{}, errors.Wrapf(err, "error opening %s", filename)
+	}
+	defer file.Close()
+	return parse(renderer.Wrap(ctx, types.Document{}, options...), filename, file)
+}
+
+// Parse parses the content of the given reader `r` into a document, once its preprocessor directives (such as `include::`) were processed.
+func Parse(ctx context.Context, r io.Reader, options ...renderer.Option) (types.Document, error) {
+	return parse(renderer.Wrap(ctx, types.Document{}, options...), "", r)
+}
+
+// renderFunc the function which renders the document in the given context into the given output, in a given backend
+type renderFunc func(ctx *renderer.Context, output io.Writer) (map[string]interface{}, error)
+
+// convert converts the content of the given reader `r` read from the given `filename` (which may be empty)
+// into a full document rendered with the given `render` function, written in the given writer `output`.
+// The diagnostics reported while preprocessing, parsing and rendering the document are returned along with its metadata.
+func convert(ctx context.Context, filename string, r io.Reader, output io.Writer, render renderFunc, options ...renderer.Option) (map[string]interface{}, types.Diagnostics, error) {
+	rendererCtx := renderer.Wrap(ctx, types.Document{}, options...)
+	doc, err := parse(rendererCtx, filename, r)
+	if err != nil {
+		return nil, *rendererCtx.Diagnostics(), err
+	}
+	metadata, err := renderDocument(rendererCtx, doc, output, render)
+	return metadata, *rendererCtx.Diagnostics(), err
+}
+
+// parse preprocesses and parses the content of the given reader `r` read from the given `filename` (which may be empty)
+func parse(ctx *renderer.Context, filename string, r io.Reader) (types.Document, error) {
+	ctx.SetFilename(filename)
+	r, err := preprocessor.Process(ctx, filename, r)
+	if err != nil {
+		return types.Document{}, errors.Wrapf(err, "error while preprocessing the document")
+	}
 	log.Infof("parsing the asciidoc source...")
 	start := time.Now()
 	stats := parser.Stats{}
-	doc, err := parser.ParseReader("", r, parser.Statistics(&stats, "no match"))
+	doc, err := parser.ParseReader(filename, r, parser.Statistics(&stats, "no match"), parser.SourceMap(ctx.SourceMap()), parser.Diagnostics(ctx.Diagnostics()), parser.AttributeOverrides(ctx.Attributes()))
 	if err != nil {
-		return nil, errors.Wrapf(err, "error while parsing the document")
+		return types.Document{}, errors.Wrapf(err, "error while parsing the document")
 	}
 	duration := time.Since(start)
 	log.Infof("parsed the asciidoc source in %v ", duration)
@@ -56,17 +137,22 @@ func ConvertToHTML(ctx context.Context, r io.Reader, output io.Writer, options .
 	log.Infof("- parsing duration:                %v", duration)
 	log.Infof("- expressions processed:           %v", stats.ExprCnt)
 	log.Infof("- choice expressions alternatives:\n%s", string(b))
-	return convertToHTML(ctx, doc, output, options...)
+	return doc.(types.Document), nil
 }
 
-func convertToHTML(ctx context.Context, doc interface{}, output io.Writer, options ...renderer.Option) (map[string]interface{}, error) {
+func renderDocument(ctx *renderer.Context, doc types.Document, output io.Writer, render renderFunc) (map[string]interface{}, error) {
 	start := time.Now()
-	metadata, err := htmlrenderer.Render(renderer.Wrap(ctx, doc.(types.Document), options...), output)
+	ctx.Document = doc
+	metadata, err := render(ctx, output)
 	if err != nil {
+		if err, ok := errors.Cause(err).(types.LocationError); ok {
+			// the location of the element which could not be rendered is more useful than the context
+			return nil, err
+		}
 		return nil, errors.Wrapf(err, "error while rendering the document")
 	}
 	log.Debugf("Done processing document")
 	duration := time.Since(start)
-	log.Infof("rendered the HTML output in %v", duration)
+	log.Infof("rendered the output in %v", duration)
 	return metadata, nil
 }
@@ -3,11 +3,16 @@ package libasciidoc_test
 import (
 	"bytes"
 	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"strings"
 	"time"
 
 	. "github.com/bytesparadise/libasciidoc"
 	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer/html5"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
 	. "github.com/onsi/ginkgo"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -150,6 +155,9 @@ a paragraph with *bold content*`
 <meta name="viewport" content="width=device-width, initial-scale=1.0">
 <meta name="generator" content="libasciidoc">
 <title>a document title</title>
+<style>
+{{.DefaultStylesheet}}
+</style>
 <body class="article">
 <div id="header">
 <h1>a document title</h1>
@@ -173,13 +181,239 @@ Last updated {{.LastUpdated}}
 		})
 	})
 
+	Context("DocBook document", func() {
+
+		It("section level 1 with a paragraph", func() {
+			source := `= a document title
+
+== Section A
+
+a paragraph with *bold content*`
+			expectedContent := `<?xml version="1.0" encoding="UTF-8"?>
+<article xmlns="http://docbook.org/ns/docbook" xmlns:xl="http://www.w3.org/1999/xlink" version="5.0" xml:lang="en">
+<info>
+<title>a document title</title>
+</info>
+<section xml:id="_section_a">
+<title>Section A</title>
+<simpara>a paragraph with <emphasis role="strong">bold content</emphasis></simpara>
+</section>
+</article>`
+			resultWriter := bytes.NewBuffer(nil)
+			metadata, _, err := ConvertToDocBook(context.Background(), strings.NewReader(source), resultWriter, renderer.IncludeHeaderFooter(true))
+			require.NoError(GinkgoT(), err)
+			assert.Equal(GinkgoT(), expectedContent, resultWriter.String())
+			assert.Equal(GinkgoT(), "a document title", metadata["doctitle"])
+		})
+	})
+
+	Context("man page", func() {
+
+		It("name and synopsis", func() {
+			source := `= git-foo(1)
+:doctype: manpage
+
+== NAME
+
+git-foo - does foo
+
+== SYNOPSIS
+
+*git foo* _OPTION_...`
+			expectedContent := `'\" t
+.\"     Title: git-foo
+.\" Generator: libasciidoc
+.\"  Language: English
+.\"
+.TH "GIT\-FOO" "1" "" "" ""
+.nh
+.ad l
+.SH "NAME"
+git\-foo \- does foo
+.SH "SYNOPSIS"
+.sp
+\fBgit foo\fP \fIOPTION\fP...
+`
+			resultWriter := bytes.NewBuffer(nil)
+			metadata, diagnostics, err := ConvertToManpage(context.Background(), strings.NewReader(source), resultWriter, renderer.IncludeHeaderFooter(true))
+			require.NoError(GinkgoT(), err)
+			assert.Empty(GinkgoT(), diagnostics)
+			assert.Equal(GinkgoT(), expectedContent, resultWriter.String())
+			assert.Equal(GinkgoT(), "git-foo(1)", metadata["doctitle"])
+			assert.Equal(GinkgoT(), "git-foo", metadata["mantitle"])
+			assert.Equal(GinkgoT(), "does foo", metadata["manpurpose"])
+		})
+	})
+
+	Context("attributes set from outside of the document", func() {
+
+		It("section numbers and attribute substitution", func() {
+			source := `== Section A
+
+{product} {edition} edition`
+			expectedContent := `<div class="sect1">
+<h2 id="_section_a">1. Section A</h2>
+<div class="sectionbody">
+<div class="paragraph">
+<p>asciidoc community edition</p>
+</div>
+</div>
+</div>`
+			resultWriter := bytes.NewBuffer(nil)
+			metadata, _, err := ConvertToHTML(context.Background(), strings.NewReader(source), resultWriter, renderer.Attributes(map[string]string{
+				"sectnums": "",
+				"product":  "asciidoc",
+				"edition@": "community",
+			}))
+			require.NoError(GinkgoT(), err)
+			assert.Equal(GinkgoT(), expectedContent, resultWriter.String())
+			assert.Equal(GinkgoT(), "asciidoc", metadata["product"])
+		})
+	})
+
+	Context("safe modes", func() {
+
+		It("stylesheet always linked in secure mode", func() {
+			source := `= a document title
+:linkcss!:
+:safe-mode-name: unsafe`
+			resultWriter := bytes.NewBuffer(nil)
+			metadata, _, err := ConvertToHTML(context.Background(), strings.NewReader(source), resultWriter, renderer.IncludeHeaderFooter(true), renderer.Safe(renderer.SafeModeSecure))
+			require.NoError(GinkgoT(), err)
+			assert.Contains(GinkgoT(), resultWriter.String(), `<link rel="stylesheet" href="./libasciidoc.css">`)
+			assert.NotContains(GinkgoT(), resultWriter.String(), `<style>`)
+			assert.Equal(GinkgoT(), "secure", metadata["safe-mode-name"])
+			assert.Equal(GinkgoT(), "20", metadata["safe-mode-level"])
+		})
+
+		It("images not embedded in secure mode", func() {
+			source := `:data-uri:
+
+image::foo.png[]`
+			resultWriter := bytes.NewBuffer(nil)
+			_, diagnostics, err := ConvertToHTML(context.Background(), strings.NewReader(source), resultWriter, renderer.Safe(renderer.SafeModeSecure))
+			require.NoError(GinkgoT(), err)
+			assert.Contains(GinkgoT(), resultWriter.String(), `<img src="foo.png" alt="foo">`)
+			assert.Empty(GinkgoT(), diagnostics)
+		})
+	})
+
+	Context("parsed document", func() {
+
+		It("section level 1 with a paragraph", func() {
+			source := `== Section A
+
+a paragraph`
+			doc, err := Parse(context.Background(), strings.NewReader(source))
+			require.NoError(GinkgoT(), err)
+			require.Len(GinkgoT(), doc.Elements, 1)
+			section, ok := doc.Elements[0].(types.Section)
+			require.True(GinkgoT(), ok)
+			assert.Equal(GinkgoT(), 1, section.Level)
+			require.NotEmpty(GinkgoT(), section.Elements)
+			assert.IsType(GinkgoT(), types.Paragraph{}, section.Elements[len(section.Elements)-1])
+		})
+	})
+
+	Context("diagnostics", func() {
+
+		It("problems reported with their location", func() {
+			source := `= a document title
+:toc: left
+
+== Section A
+
+a reference to <<unknown>> and to an {undefined} attribute.
+
+== Section A
+
+include::unknown.adoc[]`
+			resultWriter := bytes.NewBuffer(nil)
+			_, diagnostics, err := ConvertToHTML(context.Background(), strings.NewReader(source), resultWriter, renderer.IncludeHeaderFooter(false))
+			require.NoError(GinkgoT(), err)
+			result := []string{}
+			for _, d := range diagnostics {
+				result = append(result, d.String())
+			}
+			assert.ElementsMatch(GinkgoT(), []string{
+				"10:1: warning: unable to include 'unknown.adoc' in '': open unknown.adoc: no such file or directory [unresolved-include]",
+				"warning: invalid value for 'toc' attribute: 'left' [invalid-toc]",
+				"8:1: warning: duplicate ID: '_section_a' [duplicate-id]",
+				"6:16: warning: unable to resolve cross reference to 'unknown' [dangling-xref]",
+				"6:38: warning: unknown attribute: 'undefined' [unknown-attribute]",
+			}, result)
+		})
+
+		It("no problem reported", func() {
+			source := `== Section A
+
+a reference to <<_section_a>>.`
+			resultWriter := bytes.NewBuffer(nil)
+			_, diagnostics, err := ConvertToDocBook(context.Background(), strings.NewReader(source), resultWriter)
+			require.NoError(GinkgoT(), err)
+			assert.Empty(GinkgoT(), diagnostics)
+		})
+	})
+
+	Context("document with included files", func() {
+
+		It("include file relative to the document", func() {
+			dir, err := ioutil.TempDir("", "libasciidoc")
+			require.NoError(GinkgoT(), err)
+			defer os.RemoveAll(dir)
+			err = ioutil.WriteFile(filepath.Join(dir, "index.adoc"), []byte("= a document title\n\ninclude::chapters/chapter.adoc[leveloffset=+1]"), 0644)
+			require.NoError(GinkgoT(), err)
+			err = os.Mkdir(filepath.Join(dir, "chapters"), 0755)
+			require.NoError(GinkgoT(), err)
+			err = ioutil.WriteFile(filepath.Join(dir, "chapters", "chapter.adoc"), []byte("= Chapter\n\na paragraph"), 0644)
+			require.NoError(GinkgoT(), err)
+			expectedContent := `<div class="sect1">
+<h2 id="_chapter">Chapter</h2>
+<div class="sectionbody">
+<div class="paragraph">
+<p>a paragraph</p>
+</div>
+</div>
+</div>`
+			resultWriter := bytes.NewBuffer(nil)
+			_, _, err = ConvertFileToHTML(context.Background(), filepath.Join(dir, "index.adoc"), resultWriter, renderer.IncludeHeaderFooter(false))
+			require.NoError(GinkgoT(), err)
+			assert.Equal(GinkgoT(), expectedContent, resultWriter.String())
+		})
+
+		It("locations of the elements in the included file", func() {
+			dir, err := ioutil.TempDir("", "libasciidoc")
+			require.NoError(GinkgoT(), err)
+			defer os.RemoveAll(dir)
+			err = ioutil.WriteFile(filepath.Join(dir, "index.adoc"), []byte("ifdef::foo[]\nskipped\nendif::[]\n\ninclude::chapter.adoc[]"), 0644)
+			require.NoError(GinkgoT(), err)
+			err = ioutil.WriteFile(filepath.Join(dir, "chapter.adoc"), []byte("== Chapter\n\nfirst line\nwith *bold content*"), 0644)
+			require.NoError(GinkgoT(), err)
+			doc, err := ParseFile(context.Background(), filepath.Join(dir, "index.adoc"))
+			require.NoError(GinkgoT(), err)
+			require.NotEmpty(GinkgoT(), doc.Elements)
+			section, ok := doc.Elements[len(doc.Elements)-1].(types.Section)
+			require.True(GinkgoT(), ok)
+			assert.Equal(GinkgoT(), filepath.Join(dir, "chapter.adoc")+":1:1", section.Location.String())
+			paragraph, ok := section.Elements[len(section.Elements)-1].(types.Paragraph)
+			require.True(GinkgoT(), ok)
+			assert.Equal(GinkgoT(), types.Location{
+				File:  filepath.Join(dir, "chapter.adoc"),
+				Start: types.Position{Line: 3, Col: 1, Offset: 12},
+				End:   types.Position{Line: 4, Col: 20, Offset: 42},
+			}, paragraph.Location)
+			bold, ok := paragraph.Lines[1][1].(types.QuotedText)
+			require.True(GinkgoT(), ok)
+			assert.Equal(GinkgoT(), filepath.Join(dir, "chapter.adoc")+":4:6", bold.Location.String())
+		})
+	})
 })
 
 func verifyDocumentBody(t GinkgoTInterface, expectedRenderedTitle *string, expectedContent, source string) {
 	t.Logf("processing '%s'", source)
 	sourceReader := strings.NewReader(source)
 	resultWriter := bytes.NewBuffer(nil)
-	metadata, err := ConvertToHTML(context.Background(), sourceReader, resultWriter, renderer.IncludeHeaderFooter(false))
+	metadata, _, err := ConvertToHTML(context.Background(), sourceReader, resultWriter, renderer.IncludeHeaderFooter(false))
 	require.Nil(t, err, "Error found while parsing the document")
 	require.NotNil(t, metadata)
 	t.Log("Done processing document")
@@ -202,13 +436,14 @@ func verifyCompleteDocument(t GinkgoTInterface, expectedContent, source string)
 	sourceReader := strings.NewReader(source)
 	resultWriter := bytes.NewBuffer(nil)
 	lastUpdated := time.Now()
-	_, err := ConvertToHTML(context.Background(), sourceReader, resultWriter, renderer.IncludeHeaderFooter(true), renderer.LastUpdated(lastUpdated))
+	_, _, err := ConvertToHTML(context.Background(), sourceReader, resultWriter, renderer.IncludeHeaderFooter(true), renderer.LastUpdated(lastUpdated))
 	require.Nil(t, err, "Error found while parsing the document")
 	t.Log("Done processing document")
 	result := resultWriter.String()
 	t.Logf("** Actual output:\n`%s`\n", result)
 	require.Nil(t, err)
 	expectedContent = strings.Replace(expectedContent, "{{.LastUpdated}}", lastUpdated.Format(renderer.LastUpdatedFormat), 1)
+	expectedContent = strings.Replace(expectedContent, "{{.DefaultStylesheet}}", html5.DefaultStylesheet, 1)
 	t.Logf("** expectedContent output:\n`%s`\n", expectedContent)
 	assert.Equal(t, expectedContent, result)
 }
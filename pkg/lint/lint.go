@@ -0,0 +1,278 @@
+package lint
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"unicode"
+
+	"github.com/bytesparadise/libasciidoc/pkg/parser"
+	"github.com/bytesparadise/libasciidoc/pkg/preprocessor"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// Rule a rule checked by the linter
+type Rule struct {
+	ID          string
+	Severity    types.Severity
+	Description string
+}
+
+// Rules the rules checked by the linter
+var Rules = []Rule{
+	{ID: "duplicate-id", Severity: types.SeverityError, Description: "Element IDs must be unique in the document"},
+	{ID: "dangling-xref", Severity: types.SeverityError, Description: "Cross references must target an existing element"},
+	{ID: "skipped-section-level", Severity: types.SeverityWarning, Description: "Sections must be nested one level below their parent section"},
+	{ID: "missing-alt", Severity: types.SeverityWarning, Description: "Images must have an alternate text"},
+	{ID: "unbalanced-quotes", Severity: types.SeverityWarning, Description: "Quote punctuation (`*`, `_` and backticks) must be balanced on each line (the `#`, `^` and `~` marks are not supported by the parser, and hence not checked)"},
+	{ID: "unknown-attribute", Severity: types.SeverityWarning, Description: "Attribute substitutions must refer to a declared attribute"},
+}
+
+// LintFile preprocesses, parses and checks the content of the given file
+func LintFile(ctx context.Context, filename string) (types.Diagnostics, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error opening %s", filename)
+	}
+	defer file.Close()
+	return LintReader(ctx, filename, file)
+}
+
+// LintReader preprocesses, parses and checks the content of the given reader `r` read from the given `filename` (which may be empty).
+// The returned diagnostics include the problems reported while preprocessing and parsing the content (eg: unresolved includes)
+func LintReader(ctx context.Context, filename string, r io.Reader) (types.Diagnostics, error) {
+	rendererCtx := renderer.Wrap(ctx, types.Document{})
+	r, err := preprocessor.Process(rendererCtx, filename, r)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error while preprocessing the document")
+	}
+	doc, err := parser.ParseReader(filename, r, parser.SourceMap(rendererCtx.SourceMap()), parser.Diagnostics(rendererCtx.Diagnostics()))
+	if err != nil {
+		return nil, errors.Wrapf(err, "error while parsing the document")
+	}
+	diagnostics, err := Lint(doc.(types.Document))
+	if err != nil {
+		return nil, err
+	}
+	result := types.Diagnostics{}
+	for _, d := range *rendererCtx.Diagnostics() {
+		if _, found := severities[d.Rule]; found {
+			// the problem is also reported by the linter itself
+			continue
+		}
+		result = append(result, d)
+	}
+	return append(result, diagnostics...), nil
+}
+
+// Lint checks the given document against the linter rules and returns the problems found,
+// in the order of the elements in the document
+func Lint(doc types.Document) (types.Diagnostics, error) {
+	l := newLinter(doc)
+	if err := types.Walk(l, doc.Elements...); err != nil {
+		return nil, errors.Wrapf(err, "error while linting the document")
+	}
+	return l.diagnostics, nil
+}
+
+// linter the visitor which checks the elements of a document
+type linter struct {
+	references    types.ElementReferences
+	attributes    types.DocumentAttributes // the attributes declared so far
+	ids           map[string]types.Location
+	sectionLevels []int // the levels of the enclosing sections
+	diagnostics   types.Diagnostics
+}
+
+func newLinter(doc types.Document) *linter {
+	attributes := types.DocumentAttributes{}
+	for k, v := range doc.Attributes {
+		attributes[k] = v
+	}
+	return &linter{
+		references: doc.ElementReferences,
+		attributes: attributes,
+		ids:        map[string]types.Location{},
+	}
+}
+
+var severities = map[string]types.Severity{}
+
+func init() {
+	for _, r := range Rules {
+		severities[r.ID] = r.Severity
+	}
+}
+
+// report appends a new diagnostic for the given rule.
+// Diagnostics are not logged, since they are the output of the linter.
+func (l *linter) report(rule string, location types.Location, format string, args ...interface{}) {
+	l.diagnostics = append(l.diagnostics, types.Diagnostic{
+		Severity: severities[rule],
+		Rule:     rule,
+		Message:  fmt.Sprintf(format, args...),
+		Location: location,
+	})
+}
+
+// BeforeVisit Implements Visitable#BeforeVisit()
+func (l *linter) BeforeVisit(element types.Visitable) error {
+	if s, ok := element.(types.Section); ok {
+		parentLevel := 0
+		if len(l.sectionLevels) > 0 {
+			parentLevel = l.sectionLevels[len(l.sectionLevels)-1]
+		}
+		if s.Level > parentLevel+1 {
+			l.report("skipped-section-level", s.Location, "section level %d is out of sequence (expected level %d)", s.Level, parentLevel+1)
+		}
+		l.sectionLevels = append(l.sectionLevels, s.Level)
+	}
+	return nil
+}
+
+// Visit Implements Visitable#Visit()
+func (l *linter) Visit(element types.Visitable) error {
+	log.Debugf("linting element of type %T", element)
+	switch e := element.(type) {
+	case types.Section:
+		l.checkID(e.Title.Attributes, e.Location)
+	case types.Paragraph:
+		l.checkID(e.Attributes, e.Location)
+		for i, line := range e.Lines {
+			l.checkQuotes(line, lineLocation(e, i))
+		}
+	case types.OrderedList:
+		l.checkID(e.Attributes, e.Location)
+	case types.OrderedListItem:
+		l.checkID(e.Attributes, e.Location)
+	case types.UnorderedList:
+		l.checkID(e.Attributes, e.Location)
+	case types.LabeledList:
+		l.checkID(e.Attributes, e.Location)
+	case types.CalloutList:
+		l.checkID(e.Attributes, e.Location)
+	case types.DelimitedBlock:
+		l.checkID(e.Attributes, e.Location)
+	case types.Table:
+		l.checkID(e.Attributes, e.Location)
+	case types.BlockImage:
+		l.checkID(e.Attributes, e.Location)
+		l.checkAlt(e.Macro, e.Location)
+	case types.InlineImage:
+		l.checkAlt(e.Macro, e.Location)
+	case types.InlineAnchor:
+		l.checkUniqueID(e.ID, e.Location)
+	case types.CrossReference:
+		if e.IsInterDocument() {
+			// the targets in other documents are checked when rendering these documents together (see `renderer.References`)
+			break
+		}
+		if _, found := l.references[e.ID]; !found {
+			l.report("dangling-xref", e.Location, "cross reference to an unknown element: '%s'", e.ID)
+		}
+	case types.DocumentAttributeDeclaration:
+		l.attributes.AddAttribute(e)
+	case types.DocumentAttributeReset:
+		l.attributes.Reset(e)
+	case types.DocumentAttributeSubstitution:
+		if _, found := l.attributes[e.Name]; !found {
+			l.report("unknown-attribute", e.Location, "unknown attribute: '%s'", e.Name)
+		}
+	}
+	return nil
+}
+
+// AfterVisit Implements Visitable#AfterVisit()
+func (l *linter) AfterVisit(element types.Visitable) error {
+	if _, ok := element.(types.Section); ok {
+		l.sectionLevels = l.sectionLevels[:len(l.sectionLevels)-1]
+	}
+	return nil
+}
+
+func (l *linter) checkID(attributes map[string]interface{}, location types.Location) {
+	if id, ok := attributes[types.AttrID].(string); ok && id != "" {
+		l.checkUniqueID(id, location)
+	}
+}
+
+func (l *linter) checkUniqueID(id string, location types.Location) {
+	if first, found := l.ids[id]; found {
+		if first.IsZero() {
+			l.report("duplicate-id", location, "duplicate ID: '%s'", id)
+		} else {
+			l.report("duplicate-id", location, "duplicate ID: '%s' (first defined at %s)", id, first)
+		}
+		return
+	}
+	l.ids[id] = location
+}
+
+func (l *linter) checkAlt(macro types.ImageMacro, location types.Location) {
+	if !macro.HasAlt() {
+		l.report("missing-alt", location, "missing alternate text for image '%s'", macro.Path)
+	}
+}
+
+// checkQuotes reports the quote punctuation of the given line which was not parsed as quoted text,
+// i.e., which remains in an odd number in the plain text of the line. Only the marks of the quoted texts
+// supported by the parser are checked.
+func (l *linter) checkQuotes(line types.InlineElements, location types.Location) {
+	text := strings.Builder{}
+	for _, element := range line {
+		if s, ok := element.(types.StringElement); ok {
+			text.WriteString(s.Content)
+		}
+	}
+	for _, mark := range []rune{'*', '_', '`'} {
+		if count := countQuoteMarks(text.String(), mark); count%2 != 0 {
+			l.report("unbalanced-quotes", location, "unbalanced '%c' quote punctuation", mark)
+		}
+	}
+}
+
+// lineLocation returns the location of the start of the line at the given index in the paragraph.
+// Lines are counted backwards from the end of the paragraph, since it may start with attributes or a title.
+func lineLocation(p types.Paragraph, index int) types.Location {
+	if p.Location.IsZero() {
+		return types.Location{}
+	}
+	last := p.Location.End.Line
+	if p.Location.End.Col == 1 {
+		// the paragraph ends with a newline
+		last--
+	}
+	return types.Location{
+		File: p.Location.File,
+		Start: types.Position{
+			Line: last - len(p.Lines) + 1 + index,
+			Col:  1,
+		},
+	}
+}
+
+// countQuoteMarks counts the occurrences of the given mark in the given text. Underscores within words
+// (eg: `snake_case`) are ignored, since they can't be used as constrained quotes.
+func countQuoteMarks(text string, mark rune) int {
+	count := 0
+	runes := []rune(text)
+	for i, r := range runes {
+		if r != mark {
+			continue
+		}
+		if mark == '_' && i > 0 && i < len(runes)-1 && isWordChar(runes[i-1]) && isWordChar(runes[i+1]) {
+			continue
+		}
+		count++
+	}
+	return count
+}
+
+func isWordChar(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
@@ -0,0 +1,15 @@
+package lint_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+
+	_ "github.com/bytesparadise/libasciidoc/pkg/log"
+)
+
+func TestLint(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Lint Suite")
+}
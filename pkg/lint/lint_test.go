@@ -0,0 +1,144 @@
+package lint_test
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/lint"
+	. "github.com/onsi/ginkgo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var _ = Describe("lint", func() {
+
+	It("no problem", func() {
+		actualContent := `= Title
+:version: 1.0
+
+== Section A
+
+A reference to <<_section_b>> in version {version}, with *bold* and _italic_ content in a snake_case name.
+
+image::foo.png[the foo image]
+
+=== Section A.1
+
+== Section B`
+		verify(GinkgoT(), []string{}, actualContent)
+	})
+
+	It("duplicate section IDs", func() {
+		actualContent := `== Section A
+
+[#custom]
+== Section B
+
+[[custom]]
+== Section C
+
+== Section A`
+		verify(GinkgoT(), []string{
+			"6:1: error: duplicate ID: 'custom' (first defined at 3:1) [duplicate-id]",
+			"9:1: error: duplicate ID: '_section_a' (first defined at 1:1) [duplicate-id]",
+		}, actualContent)
+	})
+
+	It("broken cross references", func() {
+		actualContent := `== Section A
+
+a reference to <<_section_a>> and another one to <<unknown>>.`
+		verify(GinkgoT(), []string{
+			"3:50: error: cross reference to an unknown element: 'unknown' [dangling-xref]",
+		}, actualContent)
+	})
+
+	It("skipped section levels", func() {
+		actualContent := `=== Section A
+
+== Section B
+
+==== Section B.1.1`
+		verify(GinkgoT(), []string{
+			"1:1: warning: section level 2 is out of sequence (expected level 1) [skipped-section-level]",
+			"5:1: warning: section level 3 is out of sequence (expected level 2) [skipped-section-level]",
+		}, actualContent)
+	})
+
+	It("missing image alt text", func() {
+		actualContent := `image::images/foo.png[]
+
+an inline image:bar.png[] and another one: image:baz.png[baz image].`
+		verify(GinkgoT(), []string{
+			"1:1: warning: missing alternate text for image 'images/foo.png' [missing-alt]",
+			"3:11: warning: missing alternate text for image 'bar.png' [missing-alt]",
+		}, actualContent)
+	})
+
+	It("unbalanced quote punctuation", func() {
+		actualContent := "[#paragraph]\n.a title\nsome *bold content\nsome `code` and _italic content\n2 * 3 * 4\n\nsome `code"
+		verify(GinkgoT(), []string{
+			"3:1: warning: unbalanced '*' quote punctuation [unbalanced-quotes]",
+			"4:1: warning: unbalanced '_' quote punctuation [unbalanced-quotes]",
+			"7:1: warning: unbalanced '`' quote punctuation [unbalanced-quotes]",
+		}, actualContent)
+	})
+
+	It("problems reported by the parser", func() {
+		actualContent := `a reference to footnote:disclaimer[] before its definition footnote:disclaimer[a disclaimer]`
+		verify(GinkgoT(), []string{
+			"1:16: warning: footnote reference 'disclaimer' is used before being defined [footnote-ref-before-definition]",
+		}, actualContent)
+	})
+
+	It("undefined attribute substitutions", func() {
+		actualContent := `:foo: bar
+
+{foo} and {unknown} and {baz}
+
+:baz: cheesecake
+
+{baz}
+
+:!foo:
+
+{foo}`
+		verify(GinkgoT(), []string{
+			"3:11: warning: unknown attribute: 'unknown' [unknown-attribute]",
+			"3:25: warning: unknown attribute: 'baz' [unknown-attribute]",
+			"11:1: warning: unknown attribute: 'foo' [unknown-attribute]",
+		}, actualContent)
+	})
+
+	It("problems in an included file", func() {
+		dir, err := ioutil.TempDir("", "libasciidoc")
+		require.NoError(GinkgoT(), err)
+		defer os.RemoveAll(dir)
+		err = ioutil.WriteFile(filepath.Join(dir, "index.adoc"), []byte("== Section A\n\ninclude::chapter.adoc[]\n\ninclude::unknown.adoc[]"), 0644)
+		require.NoError(GinkgoT(), err)
+		err = ioutil.WriteFile(filepath.Join(dir, "chapter.adoc"), []byte("==== Section A.1.1\n\na reference to <<unknown>>."), 0644)
+		require.NoError(GinkgoT(), err)
+		diagnostics, err := lint.LintFile(context.Background(), filepath.Join(dir, "index.adoc"))
+		require.NoError(GinkgoT(), err)
+		require.Len(GinkgoT(), diagnostics, 3)
+		assert.Equal(GinkgoT(), "unresolved-include", diagnostics[0].Rule)
+		assert.Equal(GinkgoT(), filepath.Join(dir, "index.adoc")+":5:1", diagnostics[0].Location.String())
+		assert.Equal(GinkgoT(), "skipped-section-level", diagnostics[1].Rule)
+		assert.Equal(GinkgoT(), filepath.Join(dir, "chapter.adoc")+":1:1", diagnostics[1].Location.String())
+		assert.Equal(GinkgoT(), "dangling-xref", diagnostics[2].Rule)
+		assert.Equal(GinkgoT(), filepath.Join(dir, "chapter.adoc")+":3:16", diagnostics[2].Location.String())
+	})
+})
+
+func verify(t GinkgoTInterface, expected []string, content string) {
+	diagnostics, err := lint.LintReader(context.Background(), "", strings.NewReader(content))
+	require.NoError(t, err)
+	actual := make([]string, len(diagnostics))
+	for i, d := range diagnostics {
+		actual[i] = d.String()
+	}
+	assert.Equal(t, expected, actual)
+}
@@ -0,0 +1,210 @@
+package lint
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+)
+
+// Formats the names of the supported output formats of the reports
+var Formats = []string{"text", "json", "sarif"}
+
+// Report writes the given diagnostics in the given format (`text`, `json` or `sarif`)
+func Report(w io.Writer, format string, diagnostics types.Diagnostics) error {
+	switch format {
+	case "text":
+		return reportText(w, diagnostics)
+	case "json":
+		return reportJSON(w, diagnostics)
+	case "sarif":
+		return reportSARIF(w, diagnostics)
+	default:
+		return errors.Errorf("unsupported format: '%s'", format)
+	}
+}
+
+// reportText writes a diagnostic per line, in the `file:line:col: severity: message [rule]` format
+func reportText(w io.Writer, diagnostics types.Diagnostics) error {
+	for _, d := range diagnostics {
+		if _, err := fmt.Fprintln(w, d.String()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// jsonDiagnostic the JSON representation of a diagnostic
+type jsonDiagnostic struct {
+	File     string `json:"file,omitempty"`
+	Line     int    `json:"line,omitempty"`
+	Column   int    `json:"column,omitempty"`
+	Severity string `json:"severity"`
+	Rule     string `json:"rule"`
+	Message  string `json:"message"`
+}
+
+// reportJSON writes the diagnostics in a JSON array
+func reportJSON(w io.Writer, diagnostics types.Diagnostics) error {
+	result := make([]jsonDiagnostic, len(diagnostics))
+	for i, d := range diagnostics {
+		result[i] = jsonDiagnostic{
+			File:     d.Location.File,
+			Line:     d.Location.Start.Line,
+			Column:   d.Location.Start.Col,
+			Severity: d.Severity.String(),
+			Rule:     d.Rule,
+			Message:  d.Message,
+		}
+	}
+	return writeJSON(w, result)
+}
+
+// ------------------------------------------
+// SARIF
+// ------------------------------------------
+
+const (
+	sarifVersion = "2.1.0"
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+)
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID                   string             `json:"id"`
+	ShortDescription     sarifMessage       `json:"shortDescription"`
+	DefaultConfiguration sarifConfiguration `json:"defaultConfiguration"`
+}
+
+type sarifConfiguration struct {
+	Level string `json:"level"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations,omitempty"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           sarifRegion           `json:"region"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine   int `json:"startLine"`
+	StartColumn int `json:"startColumn"`
+	EndLine     int `json:"endLine,omitempty"`
+	EndColumn   int `json:"endColumn,omitempty"`
+}
+
+// reportSARIF writes the diagnostics in the Static Analysis Results Interchange Format (SARIF) v2.1.0
+func reportSARIF(w io.Writer, diagnostics types.Diagnostics) error {
+	rules := make([]sarifRule, len(Rules))
+	for i, r := range Rules {
+		rules[i] = sarifRule{
+			ID:                   r.ID,
+			ShortDescription:     sarifMessage{Text: r.Description},
+			DefaultConfiguration: sarifConfiguration{Level: sarifLevel(r.Severity)},
+		}
+	}
+	results := make([]sarifResult, len(diagnostics))
+	for i, d := range diagnostics {
+		results[i] = sarifResult{
+			RuleID:  d.Rule,
+			Level:   sarifLevel(d.Severity),
+			Message: sarifMessage{Text: d.Message},
+		}
+		// the physical locations require an artifact, which is unknown when the document was read from STDIN
+		if !d.Location.IsZero() && d.Location.File != "" {
+			region := sarifRegion{
+				StartLine:   d.Location.Start.Line,
+				StartColumn: d.Location.Start.Col,
+			}
+			if d.Location.End.Line > 0 {
+				region.EndLine = d.Location.End.Line
+				region.EndColumn = d.Location.End.Col
+			}
+			results[i].Locations = []sarifLocation{
+				{
+					PhysicalLocation: sarifPhysicalLocation{
+						ArtifactLocation: sarifArtifactLocation{URI: d.Location.File},
+						Region:           region,
+					},
+				},
+			}
+		}
+	}
+	return writeJSON(w, sarifLog{
+		Schema:  sarifSchema,
+		Version: sarifVersion,
+		Runs: []sarifRun{
+			{
+				Tool: sarifTool{
+					Driver: sarifDriver{
+						Name:           "libasciidoc",
+						InformationURI: "https://github.com/bytesparadise/libasciidoc",
+						Rules:          rules,
+					},
+				},
+				Results: results,
+			},
+		},
+	})
+}
+
+// sarifLevel returns the SARIF level matching the given severity
+func sarifLevel(s types.Severity) string {
+	switch s {
+	case types.SeverityError:
+		return "error"
+	case types.SeverityWarning:
+		return "warning"
+	default:
+		return "note"
+	}
+}
+
+func writeJSON(w io.Writer, v interface{}) error {
+	result, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "unable to marshal the report")
+	}
+	_, err = w.Write(append(result, '\n'))
+	return err
+}
@@ -0,0 +1,93 @@
+package lint_test
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/bytesparadise/libasciidoc/pkg/lint"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	. "github.com/onsi/ginkgo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var _ = Describe("reports", func() {
+
+	diagnostics := types.Diagnostics{
+		{
+			Severity: types.SeverityError,
+			Rule:     "dangling-xref",
+			Message:  "cross reference to an unknown element: 'foo'",
+			Location: types.Location{
+				File:  "index.adoc",
+				Start: types.Position{Line: 3, Col: 16, Offset: 29},
+				End:   types.Position{Line: 3, Col: 23, Offset: 36},
+			},
+		},
+		{
+			Severity: types.SeverityWarning,
+			Rule:     "unknown-attribute",
+			Message:  "unknown attribute: 'bar'",
+		},
+	}
+
+	It("text report", func() {
+		buf := new(bytes.Buffer)
+		err := lint.Report(buf, "text", diagnostics)
+		require.NoError(GinkgoT(), err)
+		assert.Equal(GinkgoT(), `index.adoc:3:16: error: cross reference to an unknown element: 'foo' [dangling-xref]
+warning: unknown attribute: 'bar' [unknown-attribute]
+`, buf.String())
+	})
+
+	It("JSON report", func() {
+		buf := new(bytes.Buffer)
+		err := lint.Report(buf, "json", diagnostics)
+		require.NoError(GinkgoT(), err)
+		assert.JSONEq(GinkgoT(), `[
+			{"file": "index.adoc", "line": 3, "column": 16, "severity": "error", "rule": "dangling-xref", "message": "cross reference to an unknown element: 'foo'"},
+			{"severity": "warning", "rule": "unknown-attribute", "message": "unknown attribute: 'bar'"}
+		]`, buf.String())
+	})
+
+	It("SARIF report", func() {
+		buf := new(bytes.Buffer)
+		err := lint.Report(buf, "sarif", diagnostics)
+		require.NoError(GinkgoT(), err)
+		report := map[string]interface{}{}
+		err = json.Unmarshal(buf.Bytes(), &report)
+		require.NoError(GinkgoT(), err)
+		assert.Equal(GinkgoT(), "2.1.0", report["version"])
+		run := report["runs"].([]interface{})[0].(map[string]interface{})
+		rules := run["tool"].(map[string]interface{})["driver"].(map[string]interface{})["rules"].([]interface{})
+		assert.Len(GinkgoT(), rules, len(lint.Rules))
+		results, err := json.Marshal(run["results"])
+		require.NoError(GinkgoT(), err)
+		assert.JSONEq(GinkgoT(), `[
+			{
+				"ruleId": "dangling-xref",
+				"level": "error",
+				"message": {"text": "cross reference to an unknown element: 'foo'"},
+				"locations": [
+					{
+						"physicalLocation": {
+							"artifactLocation": {"uri": "index.adoc"},
+							"region": {"startLine": 3, "startColumn": 16, "endLine": 3, "endColumn": 23}
+						}
+					}
+				]
+			},
+			{
+				"ruleId": "unknown-attribute",
+				"level": "warning",
+				"message": {"text": "unknown attribute: 'bar'"}
+			}
+		]`, string(results))
+	})
+
+	It("unsupported format", func() {
+		buf := new(bytes.Buffer)
+		err := lint.Report(buf, "yaml", diagnostics)
+		require.Error(GinkgoT(), err)
+	})
+})
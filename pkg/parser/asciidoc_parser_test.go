@@ -1,9 +1,11 @@
 package parser_test
 
 import (
+	"reflect"
 	"strings"
 
 	"github.com/bytesparadise/libasciidoc/pkg/parser"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
 	"github.com/davecgh/go-spew/spew"
 	. "github.com/onsi/ginkgo"
 	log "github.com/sirupsen/logrus"
@@ -12,17 +14,90 @@ import (
 )
 
 func verify(t GinkgoTInterface, expectedResult interface{}, content string, options ...parser.Option) {
+	result := parseContent(t, content, options...)
+	t.Logf("expected document: `%s`", spew.Sdump(expectedResult))
+	assert.EqualValues(t, expectedResult, result)
+}
+
+// verifyWithoutLocations verifies the result of the parsing regardless of the locations of the elements,
+// for the tests which do not focus on the locations (which are verified in dedicated tests)
+func verifyWithoutLocations(t GinkgoTInterface, expectedResult interface{}, content string, options ...parser.Option) {
+	result := parseContent(t, content, options...)
+	t.Logf("expected document: `%s`", spew.Sdump(expectedResult))
+	assert.EqualValues(t, expectedResult, withoutLocations(result))
+}
+
+func parseContent(t GinkgoTInterface, content string, options ...parser.Option) interface{} {
 	log.Debugf("processing: %s", content)
 	reader := strings.NewReader(content)
-	allOptions := append(options)
-	result, err := parser.ParseReader("", reader, allOptions...) //, Debug(true))
+	result, err := parser.ParseReader("", reader, options...) //, Debug(true))
 	if err != nil {
 		log.WithError(err).Error("Error found while parsing the document")
 	}
 	require.NoError(t, err)
 	t.Logf("actual document: `%s`", spew.Sdump(result))
-	t.Logf("expected document: `%s`", spew.Sdump(expectedResult))
-	assert.EqualValues(t, expectedResult, result)
+	return result
+}
+
+// withoutLocations returns a copy of the given element in which all the locations are reset
+func withoutLocations(element interface{}) interface{} {
+	if element == nil {
+		return nil
+	}
+	return resetLocations(reflect.ValueOf(element)).Interface()
+}
+
+var locationType = reflect.TypeOf(types.Location{})
+
+func resetLocations(v reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.Interface:
+		if v.IsNil() {
+			return v
+		}
+		result := reflect.New(v.Type()).Elem()
+		result.Set(resetLocations(v.Elem()))
+		return result
+	case reflect.Ptr:
+		if v.IsNil() {
+			return v
+		}
+		result := reflect.New(v.Type().Elem())
+		result.Elem().Set(resetLocations(v.Elem()))
+		return result
+	case reflect.Struct:
+		if v.Type() == locationType {
+			return reflect.Zero(locationType)
+		}
+		result := reflect.New(v.Type()).Elem()
+		result.Set(v)
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).PkgPath == "" { // exported field
+				result.Field(i).Set(resetLocations(v.Field(i)))
+			}
+		}
+		return result
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		result := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			result.Index(i).Set(resetLocations(v.Index(i)))
+		}
+		return result
+	case reflect.Map:
+		if v.IsNil() {
+			return v
+		}
+		result := reflect.MakeMapWithSize(v.Type(), v.Len())
+		for _, k := range v.MapKeys() {
+			result.SetMapIndex(k, resetLocations(v.MapIndex(k)))
+		}
+		return result
+	default:
+		return v
+	}
 }
 
 func expectError(t GinkgoTInterface, content string, options ...parser.Option) {
@@ -33,7 +33,7 @@ second paragraph`
 				},
 			},
 		}
-		verify(GinkgoT(), expectedResult, actualDocument)
+		verifyWithoutLocations(GinkgoT(), expectedResult, actualDocument)
 	})
 	It("blank line with spaces and tabs between 2 paragraphs and after second paragraph", func() {
 		actualDocument := `first paragraph
@@ -67,7 +67,7 @@ second paragraph
 				},
 			},
 		}
-		verify(GinkgoT(), expectedResult, actualDocument)
+		verifyWithoutLocations(GinkgoT(), expectedResult, actualDocument)
 	})
 
 })
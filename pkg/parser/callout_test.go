@@ -0,0 +1,213 @@
+package parser_test
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/parser"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	. "github.com/onsi/ginkgo"
+)
+
+var _ = Describe("callouts", func() {
+
+	Context("callouts in blocks", func() {
+
+		It("listing block with callouts", func() {
+			actualContent := `----
+import <lib> <1>
+server.start() <2> <3>
+server.stop()
+----`
+			expectedResult := types.DelimitedBlock{
+				Attributes: map[string]interface{}{
+					types.AttrBlockKind: types.Listing,
+				},
+				Elements: []interface{}{
+					types.VerbatimLine{
+						Content: "import <lib> ",
+						Callouts: []types.Callout{
+							{Ref: 1},
+						},
+					},
+					types.VerbatimLine{
+						Content: "server.start() ",
+						Callouts: []types.Callout{
+							{Ref: 2},
+							{Ref: 3},
+						},
+					},
+					types.VerbatimLine{
+						Content: "server.stop()",
+					},
+				},
+			}
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
+		})
+
+		It("fenced block with callout", func() {
+			actualContent := "```\nname: foo <1>\n```"
+			expectedResult := types.DelimitedBlock{
+				Attributes: map[string]interface{}{
+					types.AttrBlockKind: types.Fenced,
+				},
+				Elements: []interface{}{
+					types.VerbatimLine{
+						Content: "name: foo ",
+						Callouts: []types.Callout{
+							{Ref: 1},
+						},
+					},
+				},
+			}
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
+		})
+
+		It("source block with callout", func() {
+			actualContent := `[source,go]
+----
+fmt.Println("hello") <1>
+----`
+			expectedResult := types.DelimitedBlock{
+				Attributes: map[string]interface{}{
+					types.AttrBlockKind: types.Source,
+					types.AttrLanguage:  "go",
+				},
+				Elements: []interface{}{
+					types.VerbatimLine{
+						Content: `fmt.Println("hello") `,
+						Callouts: []types.Callout{
+							{Ref: 1},
+						},
+					},
+				},
+			}
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
+		})
+
+		It("callout-like markers not at the end of a line", func() {
+			actualContent := `----
+a <1> b
+----`
+			expectedResult := types.DelimitedBlock{
+				Attributes: map[string]interface{}{
+					types.AttrBlockKind: types.Listing,
+				},
+				Elements: []interface{}{
+					types.Paragraph{
+						Attributes: map[string]interface{}{},
+						Lines: []types.InlineElements{
+							{
+								types.StringElement{Content: "a <1> b"},
+							},
+						},
+					},
+				},
+			}
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
+		})
+	})
+
+	Context("callout lists", func() {
+
+		It("callout list after a listing block", func() {
+			actualContent := `----
+import <lib> <1>
+server.start() <2>
+----
+<1> imports the library
+<2> starts the server
+on the default port`
+			expectedResult := types.Document{
+				Attributes:        map[string]interface{}{},
+				ElementReferences: map[string]interface{}{},
+				Elements: []interface{}{
+					types.DelimitedBlock{
+						Attributes: map[string]interface{}{
+							types.AttrBlockKind: types.Listing,
+						},
+						Elements: []interface{}{
+							types.VerbatimLine{
+								Content: "import <lib> ",
+								Callouts: []types.Callout{
+									{Ref: 1},
+								},
+							},
+							types.VerbatimLine{
+								Content: "server.start() ",
+								Callouts: []types.Callout{
+									{Ref: 2},
+								},
+							},
+						},
+					},
+					types.CalloutList{
+						Attributes: map[string]interface{}{},
+						Items: []types.CalloutListItem{
+							{
+								Ref: 1,
+								Elements: []interface{}{
+									types.Paragraph{
+										Attributes: map[string]interface{}{},
+										Lines: []types.InlineElements{
+											{
+												types.StringElement{Content: "imports the library"},
+											},
+										},
+									},
+								},
+							},
+							{
+								Ref: 2,
+								Elements: []interface{}{
+									types.Paragraph{
+										Attributes: map[string]interface{}{},
+										Lines: []types.InlineElements{
+											{
+												types.StringElement{Content: "starts the server"},
+											},
+											{
+												types.StringElement{Content: "on the default port"},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("callout list with title and rich content", func() {
+			actualContent := `.Callouts
+<1> a *strong* description`
+			expectedResult := types.CalloutList{
+				Attributes: map[string]interface{}{
+					types.AttrTitle: "Callouts",
+				},
+				Items: []types.CalloutListItem{
+					{
+						Ref: 1,
+						Elements: []interface{}{
+							types.Paragraph{
+								Attributes: map[string]interface{}{},
+								Lines: []types.InlineElements{
+									{
+										types.StringElement{Content: "a "},
+										types.QuotedText{
+											Kind: types.Bold,
+											Elements: types.InlineElements{
+												types.StringElement{Content: "strong"},
+											},
+										},
+										types.StringElement{Content: " description"},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
+		})
+	})
+})
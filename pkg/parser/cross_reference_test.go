@@ -58,7 +58,85 @@ with some content linked to <<thetitle>>!`
 					},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("Document"))
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("Document"))
+		})
+	})
+	Context("custom text and anchors", func() {
+
+		It("xref with custom text", func() {
+			actualContent := "see <<thetitle, the title>>!"
+			expectedResult := types.InlineElements{
+				types.StringElement{Content: "see "},
+				types.CrossReference{ID: "thetitle", Label: "the title"},
+				types.StringElement{Content: "!"},
+			}
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineElements"))
+		})
+
+		It("xref macro with custom text", func() {
+			actualContent := "see xref:thetitle[the title]!"
+			expectedResult := types.InlineElements{
+				types.StringElement{Content: "see "},
+				types.CrossReference{ID: "thetitle", Label: "the title"},
+				types.StringElement{Content: "!"},
+			}
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineElements"))
+		})
+
+		It("xref macro without custom text", func() {
+			actualContent := "see xref:thetitle[]!"
+			expectedResult := types.InlineElements{
+				types.StringElement{Content: "see "},
+				types.CrossReference{ID: "thetitle"},
+				types.StringElement{Content: "!"},
+			}
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineElements"))
+		})
+
+		It("inline anchors", func() {
+			actualContent := "[[first]]one, [[second,Second]]two and anchor:third[Third]three"
+			expectedResult := types.InlineElements{
+				types.InlineAnchor{ID: "first"},
+				types.StringElement{Content: "one, "},
+				types.InlineAnchor{ID: "second", Label: "Second"},
+				types.StringElement{Content: "two and "},
+				types.InlineAnchor{ID: "third", Label: "Third"},
+				types.StringElement{Content: "three"},
+			}
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineElements"))
+		})
+	})
+	Context("inter-document references", func() {
+
+		It("xref to an element in another document", func() {
+			actualContent := "see <<other.adoc#section_a,Section A>> and xref:guide/other.adoc#intro[]"
+			expectedResult := types.InlineElements{
+				types.StringElement{Content: "see "},
+				types.CrossReference{Path: "other.adoc", ID: "section_a", Label: "Section A"},
+				types.StringElement{Content: " and "},
+				types.CrossReference{Path: "guide/other.adoc", ID: "intro"},
+			}
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineElements"))
+		})
+
+		It("xref to an element in another document without extension", func() {
+			actualContent := "see <<other#section_a>>"
+			expectedResult := types.InlineElements{
+				types.StringElement{Content: "see "},
+				types.CrossReference{Path: "other.adoc", ID: "section_a"},
+			}
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineElements"))
+		})
+
+		It("xref to another document", func() {
+			actualContent := "see <<other.adoc>> and xref:other.adoc[the other document]"
+			expectedResult := types.InlineElements{
+				types.StringElement{Content: "see "},
+				types.CrossReference{Path: "other.adoc"},
+				types.StringElement{Content: " and "},
+				types.CrossReference{Path: "other.adoc", Label: "the other document"},
+			}
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineElements"))
 		})
 	})
 })
@@ -30,7 +30,7 @@ var _ = Describe("delimited blocks", func() {
 					},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
 		})
 
 		It("fenced block with no line", func() {
@@ -41,7 +41,7 @@ var _ = Describe("delimited blocks", func() {
 				},
 				Elements: []interface{}{},
 			}
-			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
 		})
 
 		It("fenced block with multiple lines alone", func() {
@@ -79,7 +79,7 @@ var _ = Describe("delimited blocks", func() {
 					},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
 		})
 
 		It("fenced block with multiple lines then a paragraph", func() {
@@ -131,7 +131,7 @@ var _ = Describe("delimited blocks", func() {
 					},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent)
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent)
 		})
 
 		It("fenced block after a paragraph", func() {
@@ -168,7 +168,7 @@ var _ = Describe("delimited blocks", func() {
 					},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent)
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent)
 		})
 
 		It("fenced block with unclosed delimiter", func() {
@@ -190,7 +190,7 @@ var _ = Describe("delimited blocks", func() {
 					},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
 		})
 	})
 
@@ -217,7 +217,7 @@ some listing code
 					},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
 		})
 
 		It("listing block with no line", func() {
@@ -229,7 +229,7 @@ some listing code
 				},
 				Elements: []interface{}{},
 			}
-			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
 		})
 
 		It("listing block with multiple lines", func() {
@@ -272,7 +272,7 @@ in the middle
 					},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
 		})
 
 		It("listing block with multiple lines then a paragraph", func() {
@@ -330,7 +330,7 @@ then a normal paragraph.`
 					},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent)
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent)
 		})
 
 		It("listing block just after a paragraph", func() {
@@ -369,7 +369,7 @@ some listing code
 					},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent)
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent)
 		})
 
 		It("listing block with unclosed delimiter", func() {
@@ -392,7 +392,7 @@ End of file here.`
 					},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
 		})
 	})
 
@@ -403,7 +403,7 @@ End of file here.`
 			expectedResult := types.LiteralBlock{
 				Content: " some literal content",
 			}
-			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
 		})
 
 		It("literal block from paragraph with single space on first line", func() {
@@ -412,7 +412,7 @@ on 2 lines.`
 			expectedResult := types.LiteralBlock{
 				Content: " some literal content\non 2 lines.",
 			}
-			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
 		})
 
 		It("mixing literal block and paragraph", func() {
@@ -436,7 +436,7 @@ a normal paragraph.`
 					},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent)
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent)
 		})
 	})
 
@@ -464,7 +464,7 @@ a normal paragraph.`
 					},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent)
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent)
 		})
 
 	})
@@ -493,7 +493,7 @@ a normal paragraph.`
 					},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent)
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent)
 		})
 	})
 
@@ -520,7 +520,7 @@ some listing code
 					},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
 		})
 
 		It("example block with single line starrting with a dot", func() {
@@ -544,7 +544,7 @@ some listing code
 					},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
 		})
 
 		It("example block with multiple lines", func() {
@@ -612,7 +612,7 @@ with *bold content*
 					},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
 		})
 
 		It("example block with unclosed delimiter", func() {
@@ -635,7 +635,7 @@ End of file here`
 					},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
 		})
 	})
 
@@ -664,7 +664,7 @@ foo
 					},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
 
 		})
 
@@ -711,7 +711,7 @@ paragraphs
 					},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("Document"))
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("Document"))
 		})
 	})
 
@@ -742,7 +742,7 @@ ____
 					},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
 		})
 
 		It("multi-line verse with author only", func() {
@@ -782,7 +782,7 @@ ____
 					},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
 		})
 
 		It("multi-line verse with title only", func() {
@@ -822,7 +822,7 @@ ____
 					},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
 		})
 
 		It("multi-line verse without author and title", func() {
@@ -873,7 +873,7 @@ ____`
 					},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
 		})
 
 		It("empty verse without author and title", func() {
@@ -893,7 +893,320 @@ ____`
 					},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
+		})
+	})
+
+	Context("source blocks", func() {
+
+		It("source block with language", func() {
+			actualContent := `[source,go]
+----
+fmt.Println("hello")
+----`
+			expectedResult := types.DelimitedBlock{
+				Attributes: map[string]interface{}{
+					types.AttrBlockKind: types.Source,
+					types.AttrLanguage:  "go",
+				},
+				Elements: []interface{}{
+					types.VerbatimLine{
+						Content: `fmt.Println("hello")`,
+					},
+				},
+			}
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
+		})
+
+		It("source block with title and spaces around the language", func() {
+			actualContent := `.a title
+[source, java ]
+----
+some code
+----`
+			expectedResult := types.DelimitedBlock{
+				Attributes: map[string]interface{}{
+					types.AttrBlockKind: types.Source,
+					types.AttrLanguage:  "java",
+					types.AttrTitle:     "a title",
+				},
+				Elements: []interface{}{
+					types.VerbatimLine{
+						Content: "some code",
+					},
+				},
+			}
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
+		})
+
+		It("source block with language and other attributes", func() {
+			actualContent := `[source,ruby,linenums]
+----
+puts 'hello'
+----`
+			expectedResult := types.DelimitedBlock{
+				Attributes: map[string]interface{}{
+					types.AttrBlockKind: types.Source,
+					types.AttrLanguage:  "ruby",
+					"linenums":          nil,
+				},
+				Elements: []interface{}{
+					types.VerbatimLine{
+						Content: "puts 'hello'",
+					},
+				},
+			}
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
+		})
+
+		It("source block with source style only", func() {
+			actualContent := `[source]
+----
+some code
+----`
+			expectedResult := types.DelimitedBlock{
+				Attributes: map[string]interface{}{
+					types.AttrBlockKind: types.Source,
+				},
+				Elements: []interface{}{
+					types.VerbatimLine{
+						Content: "some code",
+					},
+				},
+			}
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
+		})
+
+		It("fenced block with language", func() {
+			actualContent := "```go\nfmt.Println(\"hello\")\n```"
+			expectedResult := types.DelimitedBlock{
+				Attributes: map[string]interface{}{
+					types.AttrBlockKind: types.Source,
+					types.AttrLanguage:  "go",
+				},
+				Elements: []interface{}{
+					types.VerbatimLine{
+						Content: `fmt.Println("hello")`,
+					},
+				},
+			}
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
+		})
+
+		It("source block with blank lines and comments kept verbatim", func() {
+			actualContent := `[source,go]
+----
+// a comment
+func main() {
+
+	*bold*
+}
+----`
+			expectedResult := types.DelimitedBlock{
+				Attributes: map[string]interface{}{
+					types.AttrBlockKind: types.Source,
+					types.AttrLanguage:  "go",
+				},
+				Elements: []interface{}{
+					types.VerbatimLine{
+						Content: "// a comment",
+					},
+					types.VerbatimLine{
+						Content: "func main() {",
+					},
+					types.VerbatimLine{
+						Content: "",
+					},
+					types.VerbatimLine{
+						Content: "\t*bold*",
+					},
+					types.VerbatimLine{
+						Content: "}",
+					},
+				},
+			}
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
+		})
+	})
+
+	Context("quote blocks", func() {
+
+		It("single-line quote with author and title", func() {
+			actualContent := `[quote, john doe, quote title]
+____
+some *quote* content
+____`
+			expectedResult := types.DelimitedBlock{
+				Attributes: map[string]interface{}{
+					types.AttrBlockKind:   types.Quote,
+					types.AttrQuoteAuthor: "john doe",
+					types.AttrQuoteTitle:  "quote title",
+				},
+				Elements: []interface{}{
+					types.Paragraph{
+						Attributes: map[string]interface{}{},
+						Lines: []types.InlineElements{
+							{
+								types.StringElement{Content: "some "},
+								types.QuotedText{
+									Kind: types.Bold,
+									Elements: types.InlineElements{
+										types.StringElement{Content: "quote"},
+									},
+								},
+								types.StringElement{Content: " content"},
+							},
+						},
+					},
+				},
+			}
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
+		})
+
+		It("multi-element quote with ID and title, without style", func() {
+			actualContent := `[#quote-id]
+.a title
+____
+some content
+
+* an item
+____`
+			expectedResult := types.DelimitedBlock{
+				Attributes: map[string]interface{}{
+					types.AttrBlockKind: types.Quote,
+					types.AttrID:        "quote-id",
+					types.AttrTitle:     "a title",
+				},
+				Elements: []interface{}{
+					types.Paragraph{
+						Attributes: map[string]interface{}{},
+						Lines: []types.InlineElements{
+							{
+								types.StringElement{Content: "some content"},
+							},
+						},
+					},
+					types.BlankLine{},
+					types.UnorderedList{
+						Attributes: map[string]interface{}{},
+						Items: []types.UnorderedListItem{
+							{
+								Level:       1,
+								BulletStyle: types.OneAsterisk,
+								Elements: []interface{}{
+									types.Paragraph{
+										Attributes: map[string]interface{}{},
+										Lines: []types.InlineElements{
+											{
+												types.StringElement{Content: "an item"},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
+		})
+	})
+
+	Context("sidebar blocks", func() {
+
+		It("sidebar with title and nested listing block", func() {
+			actualContent := `.a title
+****
+some content
+----
+some code
+----
+****`
+			expectedResult := types.DelimitedBlock{
+				Attributes: map[string]interface{}{
+					types.AttrBlockKind: types.Sidebar,
+					types.AttrTitle:     "a title",
+				},
+				Elements: []interface{}{
+					types.Paragraph{
+						Attributes: map[string]interface{}{},
+						Lines: []types.InlineElements{
+							{
+								types.StringElement{Content: "some content"},
+							},
+						},
+					},
+					types.DelimitedBlock{
+						Attributes: map[string]interface{}{
+							types.AttrBlockKind: types.Listing,
+						},
+						Elements: []interface{}{
+							types.Paragraph{
+								Attributes: map[string]interface{}{},
+								Lines: []types.InlineElements{
+									{
+										types.StringElement{Content: "some code"},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
+		})
+	})
+
+	Context("open blocks", func() {
+
+		It("open block with ID", func() {
+			actualContent := `[[open-id]]
+--
+some content
+-- not a delimiter
+--`
+			expectedResult := types.DelimitedBlock{
+				Attributes: map[string]interface{}{
+					types.AttrBlockKind: types.Open,
+					types.AttrID:        "open-id",
+				},
+				Elements: []interface{}{
+					types.Paragraph{
+						Attributes: map[string]interface{}{},
+						Lines: []types.InlineElements{
+							{
+								types.StringElement{Content: "some content"},
+							},
+							{
+								types.StringElement{Content: "-- not a delimiter"},
+							},
+						},
+					},
+				},
+			}
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
+		})
+	})
+
+	Context("passthrough blocks", func() {
+
+		It("passthrough block with raw content", func() {
+			actualContent := `++++
+<video src="*foo*.mp4">
+
+</video>
+++++`
+			expectedResult := types.DelimitedBlock{
+				Attributes: map[string]interface{}{
+					types.AttrBlockKind: types.PassthroughBlock,
+				},
+				Elements: []interface{}{
+					types.StringElement{Content: `<video src="*foo*.mp4">`},
+					types.StringElement{Content: ""},
+					types.StringElement{Content: "</video>"},
+				},
+			}
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
 		})
 	})
 })
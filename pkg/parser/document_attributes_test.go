@@ -37,7 +37,7 @@ This journey begins on a bleary Monday morning.`
 					},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent)
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent)
 		})
 
 		Context("document authors", func() {
@@ -541,7 +541,7 @@ a paragraph`
 						},
 					},
 				}
-				verify(GinkgoT(), expectedResult, actualContent)
+				verifyWithoutLocations(GinkgoT(), expectedResult, actualContent)
 			})
 
 			It("contiguous attributes and paragraph with blank line in-between", func() {
@@ -568,7 +568,7 @@ a paragraph`
 						},
 					},
 				}
-				verify(GinkgoT(), expectedResult, actualContent)
+				verifyWithoutLocations(GinkgoT(), expectedResult, actualContent)
 			})
 
 			It("splitted attributes and paragraph with blank line in-between", func() {
@@ -597,7 +597,7 @@ a paragraph`
 						},
 					},
 				}
-				verify(GinkgoT(), expectedResult, actualContent)
+				verifyWithoutLocations(GinkgoT(), expectedResult, actualContent)
 			})
 
 			It("no header and attributes in body", func() {
@@ -624,7 +624,143 @@ a paragraph`
 						types.DocumentAttributeDeclaration{Name: "author", Value: "Xavier"},
 					},
 				}
-				verify(GinkgoT(), expectedResult, actualContent)
+				verifyWithoutLocations(GinkgoT(), expectedResult, actualContent)
+			})
+		})
+
+		Context("attributes set from outside of the document", func() {
+
+			It("attribute set in the header and from outside of the document", func() {
+				actualContent := `= Title
+:product: libasciidoc`
+				expectedResult := types.Document{
+					Attributes: map[string]interface{}{
+						"doctitle": types.SectionTitle{
+							Attributes: map[string]interface{}{
+								types.AttrID: "_title",
+							},
+							Content: types.InlineElements{
+								types.StringElement{Content: "Title"},
+							},
+						},
+						"product": "asciidoc",
+					},
+					ElementReferences: map[string]interface{}{},
+					Elements:          []interface{}{},
+				}
+				verify(GinkgoT(), expectedResult, actualContent, parser.AttributeOverrides(types.NewAttributeOverrides(map[string]string{
+					"product": "asciidoc",
+				})))
+			})
+
+			It("attribute soft set in the header and from outside of the document", func() {
+				actualContent := `= Title
+:product: libasciidoc`
+				expectedResult := types.Document{
+					Attributes: map[string]interface{}{
+						"doctitle": types.SectionTitle{
+							Attributes: map[string]interface{}{
+								types.AttrID: "_title",
+							},
+							Content: types.InlineElements{
+								types.StringElement{Content: "Title"},
+							},
+						},
+						"product": "libasciidoc",
+						"edition": "community",
+					},
+					ElementReferences: map[string]interface{}{},
+					Elements:          []interface{}{},
+				}
+				verify(GinkgoT(), expectedResult, actualContent, parser.AttributeOverrides(types.NewAttributeOverrides(map[string]string{
+					"product":  "asciidoc@",
+					"edition@": "community",
+				})))
+			})
+
+			It("attribute declared in the header and unset from outside of the document", func() {
+				actualContent := `= Title
+:product: libasciidoc`
+				expectedResult := types.Document{
+					Attributes: map[string]interface{}{
+						"doctitle": types.SectionTitle{
+							Attributes: map[string]interface{}{
+								types.AttrID: "_title",
+							},
+							Content: types.InlineElements{
+								types.StringElement{Content: "Title"},
+							},
+						},
+					},
+					ElementReferences: map[string]interface{}{},
+					Elements:          []interface{}{},
+				}
+				verify(GinkgoT(), expectedResult, actualContent, parser.AttributeOverrides(types.NewAttributeOverrides(map[string]string{
+					"product!": "",
+				})))
+			})
+
+			It("attribute declared and reset in the body and set from outside of the document", func() {
+				actualContent := `= Title
+
+a paragraph
+
+:product: libasciidoc
+:edition: enterprise
+:product!:`
+				expectedResult := types.Document{
+					Attributes: map[string]interface{}{
+						"doctitle": types.SectionTitle{
+							Attributes: map[string]interface{}{
+								types.AttrID: "_title",
+							},
+							Content: types.InlineElements{
+								types.StringElement{Content: "Title"},
+							},
+						},
+						"product": "asciidoc",
+					},
+					ElementReferences: map[string]interface{}{},
+					Elements: []interface{}{
+						types.Paragraph{
+							Attributes: map[string]interface{}{},
+							Lines: []types.InlineElements{
+								{
+									types.StringElement{Content: "a paragraph"},
+								},
+							},
+						},
+						types.BlankLine{},
+						types.DocumentAttributeDeclaration{Name: "edition", Value: "enterprise"},
+					},
+				}
+				verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.AttributeOverrides(types.NewAttributeOverrides(map[string]string{
+					"product": "asciidoc",
+				})))
+			})
+
+			It("table of contents set from outside of the document", func() {
+				actualContent := `= Title`
+				expectedResult := types.Document{
+					Attributes: map[string]interface{}{
+						"doctitle": types.SectionTitle{
+							Attributes: map[string]interface{}{
+								types.AttrID: "_title",
+							},
+							Content: types.InlineElements{
+								types.StringElement{Content: "Title"},
+							},
+						},
+						"toc": "",
+					},
+					ElementReferences: map[string]interface{}{},
+					Elements: []interface{}{
+						types.TableOfContentsMacro{},
+					},
+				}
+				verify(GinkgoT(), expectedResult, actualContent, parser.AttributeOverrides(types.NewAttributeOverrides(map[string]string{
+					"toc": "",
+				})))
 			})
 		})
 
@@ -652,7 +788,7 @@ a paragraph written by {author}.`
 						},
 					},
 				}
-				verify(GinkgoT(), expectedResult, actualContent)
+				verifyWithoutLocations(GinkgoT(), expectedResult, actualContent)
 			})
 
 			It("paragraph with attribute resets", func() {
@@ -681,7 +817,7 @@ a paragraph written by {author}.`
 						},
 					},
 				}
-				verify(GinkgoT(), expectedResult, actualContent)
+				verifyWithoutLocations(GinkgoT(), expectedResult, actualContent)
 			})
 		})
 
@@ -734,7 +870,7 @@ This journey begins on a bleary Monday morning.`
 					},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent)
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent)
 		})
 
 		It("header section inline with bold quote", func() {
@@ -795,7 +931,187 @@ a paragraph with *bold content*`
 					},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent)
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent)
+		})
+	})
+
+	Context("manpage doctype", func() {
+
+		It("manpage with name section", func() {
+			actualContent := `= git-foo(1)
+:doctype: manpage
+
+== NAME
+
+git-foo - does foo`
+			doctitle := types.SectionTitle{
+				Attributes: map[string]interface{}{
+					types.AttrID: "_git_foo_1",
+				},
+				Content: types.InlineElements{
+					types.StringElement{Content: "git-foo(1)"},
+				},
+			}
+			nameTitle := types.SectionTitle{
+				Attributes: map[string]interface{}{
+					types.AttrID: "_name",
+				},
+				Content: types.InlineElements{
+					types.StringElement{Content: "NAME"},
+				},
+			}
+			expectedResult := types.Document{
+				Attributes: map[string]interface{}{
+					"doctitle":   doctitle,
+					"doctype":    "manpage",
+					"mantitle":   "git-foo",
+					"manvolnum":  "1",
+					"manname":    "git-foo",
+					"manpurpose": "does foo",
+				},
+				ElementReferences: map[string]interface{}{
+					"_name": nameTitle,
+				},
+				Elements: []interface{}{
+					types.Preamble{
+						Elements: []interface{}{
+							types.BlankLine{},
+						},
+					},
+					types.Section{
+						Level: 1,
+						Title: nameTitle,
+						Elements: []interface{}{
+							types.BlankLine{},
+							types.Paragraph{
+								Attributes: map[string]interface{}{},
+								Lines: []types.InlineElements{
+									{
+										types.StringElement{Content: "git-foo - does foo"},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("manpage without volume in title", func() {
+			actualContent := `= git-foo
+:doctype: manpage
+
+== NAME
+
+git-foo - does foo`
+			doctitle := types.SectionTitle{
+				Attributes: map[string]interface{}{
+					types.AttrID: "_git_foo",
+				},
+				Content: types.InlineElements{
+					types.StringElement{Content: "git-foo"},
+				},
+			}
+			nameTitle := types.SectionTitle{
+				Attributes: map[string]interface{}{
+					types.AttrID: "_name",
+				},
+				Content: types.InlineElements{
+					types.StringElement{Content: "NAME"},
+				},
+			}
+			expectedResult := types.Document{
+				Attributes: map[string]interface{}{
+					"doctitle": doctitle,
+					"doctype":  "article",
+				},
+				ElementReferences: map[string]interface{}{
+					"_name": nameTitle,
+				},
+				Elements: []interface{}{
+					types.Preamble{
+						Elements: []interface{}{
+							types.BlankLine{},
+						},
+					},
+					types.Section{
+						Level: 1,
+						Title: nameTitle,
+						Elements: []interface{}{
+							types.BlankLine{},
+							types.Paragraph{
+								Attributes: map[string]interface{}{},
+								Lines: []types.InlineElements{
+									{
+										types.StringElement{Content: "git-foo - does foo"},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("manpage without name and purpose", func() {
+			actualContent := `= git-foo(1)
+:doctype: manpage
+
+== NAME
+
+git-foo`
+			doctitle := types.SectionTitle{
+				Attributes: map[string]interface{}{
+					types.AttrID: "_git_foo_1",
+				},
+				Content: types.InlineElements{
+					types.StringElement{Content: "git-foo(1)"},
+				},
+			}
+			nameTitle := types.SectionTitle{
+				Attributes: map[string]interface{}{
+					types.AttrID: "_name",
+				},
+				Content: types.InlineElements{
+					types.StringElement{Content: "NAME"},
+				},
+			}
+			expectedResult := types.Document{
+				Attributes: map[string]interface{}{
+					"doctitle":  doctitle,
+					"doctype":   "article",
+					"mantitle":  "git-foo",
+					"manvolnum": "1",
+				},
+				ElementReferences: map[string]interface{}{
+					"_name": nameTitle,
+				},
+				Elements: []interface{}{
+					types.Preamble{
+						Elements: []interface{}{
+							types.BlankLine{},
+						},
+					},
+					types.Section{
+						Level: 1,
+						Title: nameTitle,
+						Elements: []interface{}{
+							types.BlankLine{},
+							types.Paragraph{
+								Attributes: map[string]interface{}{},
+								Lines: []types.InlineElements{
+									{
+										types.StringElement{Content: "git-foo"},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent)
 		})
 	})
 
@@ -829,7 +1145,7 @@ a paragraph with *bold content*`
 					},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent)
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent)
 		})
 
 		It("invalid attribute names", func() {
@@ -852,7 +1168,7 @@ a paragraph with *bold content*`
 					},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent)
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent)
 		})
 	})
 })
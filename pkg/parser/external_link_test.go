@@ -26,7 +26,7 @@ var _ = Describe("links", func() {
 					},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
 		})
 
 		It("external link with empty text", func() {
@@ -45,7 +45,7 @@ var _ = Describe("links", func() {
 					},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
 		})
 
 		It("external link with text", func() {
@@ -64,7 +64,7 @@ var _ = Describe("links", func() {
 					},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
 		})
 	})
 
@@ -81,7 +81,7 @@ var _ = Describe("links", func() {
 					},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineElements"))
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineElements"))
 		})
 
 		It("relative link to doc with text", func() {
@@ -95,7 +95,7 @@ var _ = Describe("links", func() {
 					},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineElements"))
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineElements"))
 		})
 
 		It("relative link to external URL with text", func() {
@@ -109,7 +109,7 @@ var _ = Describe("links", func() {
 					},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineElements"))
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineElements"))
 		})
 
 		It("invalid relative link to doc", func() {
@@ -0,0 +1,247 @@
+package parser_test
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/parser"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	. "github.com/onsi/ginkgo"
+)
+
+var _ = Describe("footnotes", func() {
+
+	Context("footnotes in paragraphs", func() {
+
+		It("footnote with single-line content", func() {
+			actualContent := `foo footnote:[a note for foo]`
+			expectedResult := types.Paragraph{
+				Attributes: map[string]interface{}{},
+				Lines: []types.InlineElements{
+					{
+						types.StringElement{Content: "foo "},
+						types.Footnote{
+							Elements: types.InlineElements{
+								types.StringElement{Content: "a note for foo"},
+							},
+						},
+					},
+				},
+			}
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
+		})
+
+		It("footnote right after a word, with rich content", func() {
+			actualContent := `a sentence.footnote:[a *strong* note with a https://foo.com[link]] and more`
+			expectedResult := types.Paragraph{
+				Attributes: map[string]interface{}{},
+				Lines: []types.InlineElements{
+					{
+						types.StringElement{Content: "a sentence."},
+						types.Footnote{
+							Elements: types.InlineElements{
+								types.StringElement{Content: "a "},
+								types.QuotedText{
+									Kind: types.Bold,
+									Elements: types.InlineElements{
+										types.StringElement{Content: "strong"},
+									},
+								},
+								types.StringElement{Content: " note with a "},
+								types.Link{
+									URL: "https://foo.com",
+									Attributes: map[string]interface{}{
+										"text": "link",
+									},
+								},
+							},
+						},
+						types.StringElement{Content: " and more"},
+					},
+				},
+			}
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
+		})
+
+		It("footnote with reference", func() {
+			actualContent := `foo footnote:disclaimer[a disclaimer] and bar footnote:disclaimer[]`
+			expectedResult := types.Paragraph{
+				Attributes: map[string]interface{}{},
+				Lines: []types.InlineElements{
+					{
+						types.StringElement{Content: "foo "},
+						types.Footnote{
+							Ref: "disclaimer",
+							Elements: types.InlineElements{
+								types.StringElement{Content: "a disclaimer"},
+							},
+						},
+						types.StringElement{Content: " and bar "},
+						types.Footnote{
+							Ref: "disclaimer",
+						},
+					},
+				},
+			}
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
+		})
+
+		It("footnoteref macros", func() {
+			actualContent := `foo footnoteref:[disclaimer,a disclaimer] and bar footnoteref:[disclaimer]`
+			expectedResult := types.Paragraph{
+				Attributes: map[string]interface{}{},
+				Lines: []types.InlineElements{
+					{
+						types.StringElement{Content: "foo "},
+						types.Footnote{
+							Ref: "disclaimer",
+							Elements: types.InlineElements{
+								types.StringElement{Content: "a disclaimer"},
+							},
+						},
+						types.StringElement{Content: " and bar "},
+						types.Footnote{
+							Ref: "disclaimer",
+						},
+					},
+				},
+			}
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
+		})
+	})
+
+	Context("footnotes in document", func() {
+
+		It("footnotes collected in document", func() {
+			actualContent := `foo footnote:[a note for foo]
+
+== section 1
+
+bar footnote:disclaimer[a disclaimer] and baz footnote:disclaimer[]`
+			footnote1 := types.Footnote{
+				ID: 1,
+				Elements: types.InlineElements{
+					types.StringElement{Content: "a note for foo"},
+				},
+			}
+			footnote2 := types.Footnote{
+				ID:  2,
+				Ref: "disclaimer",
+				Elements: types.InlineElements{
+					types.StringElement{Content: "a disclaimer"},
+				},
+			}
+			footnote3 := types.Footnote{
+				ID:  2,
+				Ref: "disclaimer",
+			}
+			expectedResult := types.Document{
+				Attributes: map[string]interface{}{},
+				ElementReferences: map[string]interface{}{
+					"_section_1": types.SectionTitle{
+						Attributes: map[string]interface{}{
+							types.AttrID: "_section_1",
+						},
+						Content: types.InlineElements{
+							types.StringElement{Content: "section 1"},
+						},
+					},
+				},
+				Footnotes: types.Footnotes{
+					footnote1,
+					footnote2,
+				},
+				FootnoteReferences: types.FootnoteReferences{
+					"disclaimer": footnote2,
+				},
+				Elements: []interface{}{
+					types.Preamble{
+						Elements: []interface{}{
+							types.Paragraph{
+								Attributes: map[string]interface{}{},
+								Lines: []types.InlineElements{
+									{
+										types.StringElement{Content: "foo "},
+										footnote1,
+									},
+								},
+							},
+							types.BlankLine{},
+						},
+					},
+					types.Section{
+						Level: 1,
+						Title: types.SectionTitle{
+							Attributes: map[string]interface{}{
+								types.AttrID: "_section_1",
+							},
+							Content: types.InlineElements{
+								types.StringElement{Content: "section 1"},
+							},
+						},
+						Elements: []interface{}{
+							types.BlankLine{},
+							types.Paragraph{
+								Attributes: map[string]interface{}{},
+								Lines: []types.InlineElements{
+									{
+										types.StringElement{Content: "bar "},
+										footnote2,
+										types.StringElement{Content: " and baz "},
+										footnote3,
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("footnote in section title", func() {
+			actualContent := `== section footnote:[a note for the section]
+
+foo`
+			footnote := types.Footnote{
+				ID: 1,
+				Elements: types.InlineElements{
+					types.StringElement{Content: "a note for the section"},
+				},
+			}
+			sectionTitle := types.SectionTitle{
+				Attributes: map[string]interface{}{
+					types.AttrID: "_section",
+				},
+				Content: types.InlineElements{
+					types.StringElement{Content: "section "},
+					footnote,
+				},
+			}
+			expectedResult := types.Document{
+				Attributes: map[string]interface{}{},
+				ElementReferences: map[string]interface{}{
+					"_section": sectionTitle,
+				},
+				Footnotes: types.Footnotes{
+					footnote,
+				},
+				Elements: []interface{}{
+					types.Section{
+						Level: 1,
+						Title: sectionTitle,
+						Elements: []interface{}{
+							types.BlankLine{},
+							types.Paragraph{
+								Attributes: map[string]interface{}{},
+								Lines: []types.InlineElements{
+									{
+										types.StringElement{Content: "foo"},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent)
+		})
+	})
+})
@@ -25,7 +25,7 @@ var _ = Describe("images", func() {
 						},
 					},
 				}
-				verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
+				verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
 			})
 
 			It("block image with empty alt and trailing spaces", func() {
@@ -41,7 +41,7 @@ var _ = Describe("images", func() {
 						},
 					},
 				}
-				verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
+				verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
 			})
 
 			It("block image with line return", func() {
@@ -59,7 +59,7 @@ var _ = Describe("images", func() {
 						},
 					},
 				}
-				verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
+				verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
 			})
 
 			It("block image with 1 empty blank line", func() {
@@ -77,7 +77,7 @@ var _ = Describe("images", func() {
 						},
 					},
 				}
-				verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
+				verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
 			})
 
 			It("block image with 2 blank lines with spaces and tabs", func() {
@@ -94,7 +94,7 @@ var _ = Describe("images", func() {
 						},
 					},
 				}
-				verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
+				verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
 			})
 
 			It("block image with alt", func() {
@@ -110,7 +110,7 @@ var _ = Describe("images", func() {
 						},
 					},
 				}
-				verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
+				verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
 			})
 
 			It("block image with dimensions and id link title meta", func() {
@@ -133,7 +133,62 @@ var _ = Describe("images", func() {
 						},
 					},
 				}
-				verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
+				verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
+			})
+			It("block image with named attributes only", func() {
+				actualContent := "image::images/foo.png[link=https://example.com, title=A nice foo,align=center]"
+				expectedResult := types.BlockImage{
+					Attributes: map[string]interface{}{},
+					Macro: types.ImageMacro{
+						Path: "images/foo.png",
+						Attributes: map[string]interface{}{
+							types.AttrImageAlt:    "foo",
+							types.AttrImageWidth:  "",
+							types.AttrImageHeight: "",
+							types.AttrImageLink:   "https://example.com",
+							types.AttrImageTitle:  "A nice foo",
+							types.AttrImageAlign:  "center",
+						},
+					},
+				}
+				verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
+			})
+
+			It("block image with alt, dimensions and named attributes", func() {
+				actualContent := `image::images/foo.png[the foo.png image, 600, 400, title="foo, bar", float=right, role=thumb, scaledwidth=50%]`
+				expectedResult := types.BlockImage{
+					Attributes: map[string]interface{}{},
+					Macro: types.ImageMacro{
+						Path: "images/foo.png",
+						Attributes: map[string]interface{}{
+							types.AttrImageAlt:         "the foo.png image",
+							types.AttrImageWidth:       "600",
+							types.AttrImageHeight:      "400",
+							types.AttrImageTitle:       "foo, bar",
+							types.AttrImageFloat:       "right",
+							types.AttrImageRole:        "thumb",
+							types.AttrImageScaledWidth: "50%",
+						},
+					},
+				}
+				verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
+			})
+
+			It("block image with alt and named attributes", func() {
+				actualContent := "image::images/foo.png[the foo.png image,link=https://example.com]"
+				expectedResult := types.BlockImage{
+					Attributes: map[string]interface{}{},
+					Macro: types.ImageMacro{
+						Path: "images/foo.png",
+						Attributes: map[string]interface{}{
+							types.AttrImageAlt:    "the foo.png image",
+							types.AttrImageWidth:  "",
+							types.AttrImageHeight: "",
+							types.AttrImageLink:   "https://example.com",
+						},
+					},
+				}
+				verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
 			})
 		})
 
@@ -154,7 +209,7 @@ var _ = Describe("images", func() {
 							},
 						},
 					}
-					verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("Paragraph"))
+					verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("Paragraph"))
 				})
 			})
 
@@ -176,7 +231,7 @@ var _ = Describe("images", func() {
 							},
 						},
 					}
-					verify(GinkgoT(), expectedResult, actualContent)
+					verifyWithoutLocations(GinkgoT(), expectedResult, actualContent)
 				})
 			})
 		})
@@ -200,7 +255,7 @@ var _ = Describe("images", func() {
 						},
 					},
 				}
-				verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineElements"))
+				verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineElements"))
 			})
 
 			It("inline image with empty alt and trailing spaces", func() {
@@ -225,7 +280,7 @@ var _ = Describe("images", func() {
 						},
 					},
 				}
-				verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("Paragraph"))
+				verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("Paragraph"))
 			})
 
 			It("inline image surrounded with test", func() {
@@ -248,7 +303,7 @@ var _ = Describe("images", func() {
 						Content: " bar...",
 					},
 				}
-				verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineElements"))
+				verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineElements"))
 			})
 
 			It("inline image with alt", func() {
@@ -265,7 +320,27 @@ var _ = Describe("images", func() {
 						},
 					},
 				}
-				verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineElements"))
+				verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineElements"))
+			})
+
+			It("inline image with named attributes", func() {
+				actualContent := "image:images/foo.png[the foo.png image, link=https://example.com, title=The foo, role=icon]"
+				expectedResult := types.InlineElements{
+					types.InlineImage{
+						Macro: types.ImageMacro{
+							Path: "images/foo.png",
+							Attributes: map[string]interface{}{
+								types.AttrImageAlt:    "the foo.png image",
+								types.AttrImageWidth:  "",
+								types.AttrImageHeight: "",
+								types.AttrImageLink:   "https://example.com",
+								types.AttrImageTitle:  "The foo",
+								types.AttrImageRole:   "icon",
+							},
+						},
+					},
+				}
+				verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineElements"))
 			})
 		})
 		Context("errors", func() {
@@ -282,7 +357,7 @@ var _ = Describe("images", func() {
 						},
 					},
 				}
-				verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("Paragraph"))
+				verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("Paragraph"))
 			})
 		})
 	})
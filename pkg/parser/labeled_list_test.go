@@ -33,7 +33,7 @@ on 2 lines`
 				},
 			},
 		}
-		verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
+		verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
 	})
 
 	It("labeled list with a single term and no description", func() {
@@ -46,7 +46,7 @@ on 2 lines`
 				},
 			},
 		}
-		verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
+		verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
 	})
 
 	It("labeled list with a horizontal layout attribute", func() {
@@ -72,7 +72,7 @@ Item1:: foo`
 				},
 			},
 		}
-		verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
+		verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
 	})
 
 	It("labeled list with a single term and a blank line", func() {
@@ -86,7 +86,7 @@ Item1:: foo`
 				},
 			},
 		}
-		verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
+		verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
 	})
 
 	It("labeled list with multiple items", func() {
@@ -140,7 +140,7 @@ Item 3 description`
 				},
 			},
 		}
-		verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
+		verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
 	})
 
 	It("labeled list with nested list", func() {
@@ -205,7 +205,7 @@ Item with description:: something simple`
 			},
 		}
 
-		verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
+		verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
 	})
 
 	It("labeled list with a single item and paragraph", func() {
@@ -250,7 +250,7 @@ a normal paragraph.`
 				},
 			},
 		}
-		verify(GinkgoT(), expectedResult, actualContent)
+		verifyWithoutLocations(GinkgoT(), expectedResult, actualContent)
 	})
 
 	It("labeled list with item continuation", func() {
@@ -328,7 +328,7 @@ another fenced block
 			},
 		}
 
-		verify(GinkgoT(), expectedResult, actualContent)
+		verifyWithoutLocations(GinkgoT(), expectedResult, actualContent)
 	})
 
 	It("labeled list without item continuation", func() {
@@ -407,7 +407,7 @@ another fenced block
 			},
 		}
 
-		verify(GinkgoT(), expectedResult, actualContent)
+		verifyWithoutLocations(GinkgoT(), expectedResult, actualContent)
 	})
 
 })
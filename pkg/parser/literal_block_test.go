@@ -15,7 +15,7 @@ var _ = Describe("literal blocks", func() {
 			expectedResult := types.LiteralBlock{
 				Content: " some literal content",
 			}
-			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
 		})
 
 		It("literal block from paragraph with single space on first line", func() {
@@ -24,7 +24,7 @@ on 2 lines.`
 			expectedResult := types.LiteralBlock{
 				Content: " some literal content\non 2 lines.",
 			}
-			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
 		})
 
 		It("mixing literal block and paragraph ", func() {
@@ -48,7 +48,7 @@ a normal paragraph.`
 					},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent)
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent)
 		})
 	})
 
@@ -76,7 +76,7 @@ a normal paragraph.`
 					},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent)
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent)
 		})
 
 	})
@@ -105,7 +105,7 @@ a normal paragraph.`
 					},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent)
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent)
 		})
 	})
 
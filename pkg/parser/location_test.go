@@ -0,0 +1,87 @@
+package parser_test
+
+import (
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/parser"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	. "github.com/onsi/ginkgo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var _ = Describe("locations", func() {
+
+	It("section with a paragraph", func() {
+		actualContent := "== Section A\n\na paragraph with *bold* content"
+		doc := parse(GinkgoT(), actualContent)
+		section := doc.Elements[0].(types.Section)
+		assert.Equal(GinkgoT(), types.Location{
+			Start: types.Position{Line: 1, Col: 1, Offset: 0},
+			End:   types.Position{Line: 3, Col: 32, Offset: 45},
+		}, section.Location)
+		paragraph := section.Elements[1].(types.Paragraph)
+		assert.Equal(GinkgoT(), types.Location{
+			Start: types.Position{Line: 3, Col: 1, Offset: 14},
+			End:   types.Position{Line: 3, Col: 32, Offset: 45},
+		}, paragraph.Location)
+		bold := paragraph.Lines[0][1].(types.QuotedText)
+		assert.Equal(GinkgoT(), types.Location{
+			Start: types.Position{Line: 3, Col: 18, Offset: 31},
+			End:   types.Position{Line: 3, Col: 24, Offset: 37},
+		}, bold.Location)
+	})
+
+	It("nested list items", func() {
+		actualContent := "* item 1\n* item 2\n** nested item"
+		doc := parse(GinkgoT(), actualContent)
+		list := doc.Elements[0].(types.UnorderedList)
+		assert.Equal(GinkgoT(), "1:1", list.Location.String())
+		assert.Equal(GinkgoT(), "1:1", list.Items[0].Location.String())
+		assert.Equal(GinkgoT(), "2:1", list.Items[1].Location.String())
+		nested := list.Items[1].Elements[1].(types.UnorderedList)
+		assert.Equal(GinkgoT(), types.Location{
+			Start: types.Position{Line: 3, Col: 1, Offset: 18},
+			End:   types.Position{Line: 3, Col: 15, Offset: 32},
+		}, nested.Location)
+	})
+
+	It("delimited block with inline elements on a multibyte line", func() {
+		actualContent := "----\nsome code\n----\n\nvoilà une référence à <<foo>>"
+		doc := parse(GinkgoT(), actualContent)
+		block := doc.Elements[0].(types.DelimitedBlock)
+		assert.Equal(GinkgoT(), "1:1", block.Location.String())
+		paragraph := doc.Elements[2].(types.Paragraph)
+		xref := paragraph.Lines[0][1].(types.CrossReference)
+		assert.Equal(GinkgoT(), types.Location{
+			Start: types.Position{Line: 5, Col: 23, Offset: 47},
+			End:   types.Position{Line: 5, Col: 30, Offset: 54},
+		}, xref.Location)
+	})
+
+	It("locations resolved with a source map", func() {
+		actualContent := "first paragraph\n\nsecond paragraph"
+		sourceMap := types.SourceMap{
+			{File: "index.adoc", Line: 1, Offset: 0, TargetOffset: 0},
+			{File: "index.adoc", Line: 2, Offset: 16, TargetOffset: 16},
+			{File: "chapter.adoc", Line: 5, Offset: 40, TargetOffset: 17},
+		}
+		doc := parse(GinkgoT(), actualContent, parser.SourceMap(sourceMap))
+		first := doc.Elements[0].(types.Paragraph)
+		assert.Equal(GinkgoT(), "index.adoc:1:1", first.Location.String())
+		second := doc.Elements[2].(types.Paragraph)
+		assert.Equal(GinkgoT(), types.Location{
+			File:  "chapter.adoc",
+			Start: types.Position{Line: 5, Col: 1, Offset: 40},
+			End:   types.Position{Line: 5, Col: 17, Offset: 56},
+		}, second.Location)
+	})
+})
+
+func parse(t GinkgoTInterface, content string, options ...parser.Option) types.Document {
+	result, err := parser.ParseReader("", strings.NewReader(content), options...)
+	require.NoError(t, err)
+	doc, ok := result.(types.Document)
+	require.True(t, ok)
+	return doc
+}
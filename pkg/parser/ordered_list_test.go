@@ -30,7 +30,7 @@ var _ = Describe("ordered lists", func() {
 				Attributes:     map[string]interface{}{},
 				Elements:       elements,
 			}
-			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("OrderedListItem"))
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("OrderedListItem"))
 		})
 
 		It("ordered list item with arabic numbering style", func() {
@@ -42,7 +42,7 @@ var _ = Describe("ordered lists", func() {
 				Attributes:     map[string]interface{}{},
 				Elements:       elements,
 			}
-			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("OrderedListItem"))
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("OrderedListItem"))
 		})
 
 		It("ordered list item with lower alpha numbering style", func() {
@@ -54,7 +54,7 @@ var _ = Describe("ordered lists", func() {
 				Attributes:     map[string]interface{}{},
 				Elements:       elements,
 			}
-			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("OrderedListItem"))
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("OrderedListItem"))
 		})
 
 		It("ordered list item with upper alpha numbering style", func() {
@@ -66,7 +66,7 @@ var _ = Describe("ordered lists", func() {
 				Attributes:     map[string]interface{}{},
 				Elements:       elements,
 			}
-			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("OrderedListItem"))
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("OrderedListItem"))
 		})
 
 		It("ordered list item with lower roman numbering style", func() {
@@ -78,7 +78,7 @@ var _ = Describe("ordered lists", func() {
 				Attributes:     map[string]interface{}{},
 				Elements:       elements,
 			}
-			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("OrderedListItem"))
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("OrderedListItem"))
 		})
 
 		It("ordered list item with upper roman numbering style", func() {
@@ -90,7 +90,7 @@ var _ = Describe("ordered lists", func() {
 				Attributes:     map[string]interface{}{},
 				Elements:       elements,
 			}
-			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("OrderedListItem"))
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("OrderedListItem"))
 		})
 
 		It("ordered list item with explicit numbering type only", func() {
@@ -105,7 +105,7 @@ var _ = Describe("ordered lists", func() {
 				},
 				Elements: elements,
 			}
-			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("OrderedListItem"))
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("OrderedListItem"))
 		})
 
 		It("ordered list item with explicit start only", func() {
@@ -120,7 +120,7 @@ var _ = Describe("ordered lists", func() {
 				},
 				Elements: elements,
 			}
-			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("OrderedListItem"))
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("OrderedListItem"))
 		})
 
 		It("ordered list item with explicit quoted numbering and start", func() {
@@ -136,7 +136,7 @@ var _ = Describe("ordered lists", func() {
 				},
 				Elements: elements,
 			}
-			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("OrderedListItem"))
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("OrderedListItem"))
 		})
 
 	})
@@ -184,7 +184,7 @@ var _ = Describe("ordered lists", func() {
 					},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
 		})
 
 		It("ordered list with unnumbered items", func() {
@@ -228,7 +228,7 @@ var _ = Describe("ordered lists", func() {
 					},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
 		})
 
 		It("ordered list with custom numbering on child items", func() {
@@ -375,7 +375,7 @@ var _ = Describe("ordered lists", func() {
 					},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
 		})
 
 		It("ordered list with all default styles", func() {
@@ -490,7 +490,7 @@ var _ = Describe("ordered lists", func() {
 				},
 			}
 
-			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
 		})
 	})
 
@@ -536,7 +536,7 @@ var _ = Describe("ordered lists", func() {
 					},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
 		})
 
 		It("ordered list with numbered items", func() {
@@ -623,7 +623,7 @@ b. item 2.a`
 					},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
 		})
 	})
 
@@ -739,7 +739,7 @@ b. item 2.a`
 					},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("List"))
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("List"))
 		})
 
 		It("ordered list mixed with unordered list - complex case 1", func() {
@@ -966,7 +966,7 @@ b. item 2.a`
 					},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("List"))
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("List"))
 		})
 
 		It("ordered list mixed with unordered list - complex case 2", func() {
@@ -1364,7 +1364,7 @@ b. item 2.a`
 					},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("List"))
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("List"))
 		})
 	})
 
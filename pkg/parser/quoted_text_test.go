@@ -18,7 +18,7 @@ var _ = Describe("quoted texts", func() {
 					types.StringElement{Content: "hello"},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("QuotedText"))
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("QuotedText"))
 		})
 
 		It("bold text of 2 words", func() {
@@ -29,7 +29,7 @@ var _ = Describe("quoted texts", func() {
 					types.StringElement{Content: "bold    content"},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("QuotedText"))
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("QuotedText"))
 		})
 
 		It("bold text of 3 words", func() {
@@ -40,7 +40,7 @@ var _ = Describe("quoted texts", func() {
 					types.StringElement{Content: "some bold content"},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("QuotedText"))
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("QuotedText"))
 		})
 
 		It("italic text with3 words", func() {
@@ -51,7 +51,7 @@ var _ = Describe("quoted texts", func() {
 					types.StringElement{Content: "some italic content"},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("QuotedText"))
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("QuotedText"))
 		})
 
 		It("monospace text with3 words", func() {
@@ -62,7 +62,7 @@ var _ = Describe("quoted texts", func() {
 					types.StringElement{Content: "some monospace content"},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("QuotedText"))
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("QuotedText"))
 		})
 
 		It("bold text within italic text", func() {
@@ -80,7 +80,7 @@ var _ = Describe("quoted texts", func() {
 					types.StringElement{Content: " content"},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("QuotedText"))
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("QuotedText"))
 		})
 
 		It("monospace text within bold text within italic quote", func() {
@@ -103,7 +103,7 @@ var _ = Describe("quoted texts", func() {
 					},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("QuotedText"))
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("QuotedText"))
 		})
 
 		It("italic text within italic text", func() {
@@ -121,7 +121,7 @@ var _ = Describe("quoted texts", func() {
 					types.StringElement{Content: " content"},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("QuotedText"))
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("QuotedText"))
 		})
 	})
 
@@ -135,7 +135,7 @@ var _ = Describe("quoted texts", func() {
 					types.StringElement{Content: "hello"},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("QuotedText"))
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("QuotedText"))
 		})
 
 		It("italic text with3 words", func() {
@@ -146,7 +146,7 @@ var _ = Describe("quoted texts", func() {
 					types.StringElement{Content: "some italic content"},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("QuotedText"))
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("QuotedText"))
 		})
 
 		It("monospace text with3 words", func() {
@@ -157,7 +157,7 @@ var _ = Describe("quoted texts", func() {
 					types.StringElement{Content: "some monospace content"},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("QuotedText"))
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("QuotedText"))
 		})
 
 		It("bold text within italic text", func() {
@@ -175,7 +175,7 @@ var _ = Describe("quoted texts", func() {
 					types.StringElement{Content: " content"},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("QuotedText"))
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("QuotedText"))
 		})
 
 		It("monospace text within bold text within italic quote", func() {
@@ -198,7 +198,7 @@ var _ = Describe("quoted texts", func() {
 					},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("QuotedText"))
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("QuotedText"))
 		})
 
 	})
@@ -216,7 +216,7 @@ var _ = Describe("quoted texts", func() {
 					},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineElements"))
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineElements"))
 		})
 
 		It("inline with invalid bold text1", func() {
@@ -255,7 +255,7 @@ var _ = Describe("quoted texts", func() {
 				},
 				types.StringElement{Content: "."},
 			}
-			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineElements"))
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineElements"))
 		})
 
 		It("italic text within invalid bold text", func() {
@@ -270,6 +270,28 @@ var _ = Describe("quoted texts", func() {
 				},
 				types.StringElement{Content: " together *."},
 			}
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineElements"))
+		})
+		It("italic text after a punctuation", func() {
+			actualContent := "--out-file=_OUT_FILE_."
+			expectedResult := types.InlineElements{
+				types.StringElement{Content: "--out-file="},
+				types.QuotedText{
+					Kind: types.Italic,
+					Elements: []interface{}{
+						types.StringElement{Content: "OUT_FILE"},
+					},
+				},
+				types.StringElement{Content: "."},
+			}
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineElements"))
+		})
+
+		It("bold punctuation followed by a letter", func() {
+			actualContent := "some *a*b content"
+			expectedResult := types.InlineElements{
+				types.StringElement{Content: "some *a*b content"},
+			}
 			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineElements"))
 		})
 	})
@@ -295,7 +317,7 @@ var _ = Describe("quoted texts", func() {
 				},
 				types.StringElement{Content: "."},
 			}
-			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineElements"))
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineElements"))
 		})
 
 		It("simple-quote bold within simple-quote bold text", func() {
@@ -316,7 +338,7 @@ var _ = Describe("quoted texts", func() {
 					},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineElements"))
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineElements"))
 		})
 
 		It("double-quote bold within double-quote bold text", func() {
@@ -337,7 +359,7 @@ var _ = Describe("quoted texts", func() {
 					},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineElements"))
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineElements"))
 		})
 
 		It("simple-quote bold within double-quote bold text", func() {
@@ -358,7 +380,7 @@ var _ = Describe("quoted texts", func() {
 					},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineElements"))
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineElements"))
 		})
 
 		It("double-quote bold within simple-quote bold text", func() {
@@ -379,7 +401,7 @@ var _ = Describe("quoted texts", func() {
 					},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineElements"))
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineElements"))
 		})
 
 		It("simple-quote italic within simple-quote italic text", func() {
@@ -400,7 +422,7 @@ var _ = Describe("quoted texts", func() {
 					},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineElements"))
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineElements"))
 		})
 
 		It("double-quote italic within double-quote italic text", func() {
@@ -421,7 +443,7 @@ var _ = Describe("quoted texts", func() {
 					},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineElements"))
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineElements"))
 		})
 
 		It("simple-quote italic within double-quote italic text", func() {
@@ -442,7 +464,7 @@ var _ = Describe("quoted texts", func() {
 					},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineElements"))
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineElements"))
 		})
 
 		It("double-quote italic within simple-quote italic text", func() {
@@ -463,7 +485,7 @@ var _ = Describe("quoted texts", func() {
 					},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineElements"))
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineElements"))
 		})
 
 		It("simple-quote monospace within simple-quote monospace text", func() {
@@ -484,7 +506,7 @@ var _ = Describe("quoted texts", func() {
 					},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineElements"))
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineElements"))
 		})
 
 		It("double-quote monospace within double-quote monospace text", func() {
@@ -505,7 +527,7 @@ var _ = Describe("quoted texts", func() {
 					},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineElements"))
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineElements"))
 		})
 
 		It("simple-quote monospace within double-quote monospace text", func() {
@@ -526,7 +548,7 @@ var _ = Describe("quoted texts", func() {
 					},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineElements"))
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineElements"))
 		})
 
 		It("double-quote monospace within simple-quote monospace text", func() {
@@ -547,7 +569,7 @@ var _ = Describe("quoted texts", func() {
 					},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineElements"))
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineElements"))
 		})
 
 	})
@@ -566,7 +588,7 @@ var _ = Describe("quoted texts", func() {
 						},
 					},
 				}
-				verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineElements"))
+				verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineElements"))
 			})
 
 			It("unbalanced bold text - extra on right", func() {
@@ -580,7 +602,7 @@ var _ = Describe("quoted texts", func() {
 					},
 					types.StringElement{Content: "*"},
 				}
-				verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineElements"))
+				verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineElements"))
 			})
 		})
 
@@ -596,7 +618,7 @@ var _ = Describe("quoted texts", func() {
 						},
 					},
 				}
-				verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineElements"))
+				verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineElements"))
 			})
 
 			It("unbalanced italic text - extra on right", func() {
@@ -610,7 +632,7 @@ var _ = Describe("quoted texts", func() {
 					},
 					types.StringElement{Content: "_"},
 				}
-				verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineElements"))
+				verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineElements"))
 			})
 		})
 
@@ -626,7 +648,7 @@ var _ = Describe("quoted texts", func() {
 						},
 					},
 				}
-				verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineElements"))
+				verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineElements"))
 			})
 
 			It("unbalanced monospace text - extra on right", func() {
@@ -640,7 +662,7 @@ var _ = Describe("quoted texts", func() {
 					},
 					types.StringElement{Content: "`"},
 				}
-				verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineElements"))
+				verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineElements"))
 			})
 		})
 
@@ -654,7 +676,7 @@ var _ = Describe("quoted texts", func() {
 					},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("Paragraph"))
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("Paragraph"))
 		})
 
 	})
@@ -673,7 +695,7 @@ var _ = Describe("quoted texts", func() {
 						},
 					},
 				}
-				verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("Paragraph"))
+				verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("Paragraph"))
 			})
 
 			It("escaped bold text with simple quote and more backslashes", func() {
@@ -686,7 +708,7 @@ var _ = Describe("quoted texts", func() {
 						},
 					},
 				}
-				verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("Paragraph"))
+				verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("Paragraph"))
 			})
 
 			It("escaped bold text with double quote", func() {
@@ -699,7 +721,7 @@ var _ = Describe("quoted texts", func() {
 						},
 					},
 				}
-				verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("Paragraph"))
+				verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("Paragraph"))
 			})
 
 			It("escaped bold text with double quote and more backslashes", func() {
@@ -712,7 +734,7 @@ var _ = Describe("quoted texts", func() {
 						},
 					},
 				}
-				verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("Paragraph"))
+				verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("Paragraph"))
 			})
 
 			It("escaped bold text with unbalanced double quote", func() {
@@ -725,7 +747,7 @@ var _ = Describe("quoted texts", func() {
 						},
 					},
 				}
-				verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("Paragraph"))
+				verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("Paragraph"))
 			})
 
 			It("escaped bold text with unbalanced double quote and more backslashes", func() {
@@ -738,7 +760,7 @@ var _ = Describe("quoted texts", func() {
 						},
 					},
 				}
-				verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("Paragraph"))
+				verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("Paragraph"))
 			})
 		})
 
@@ -754,7 +776,7 @@ var _ = Describe("quoted texts", func() {
 						},
 					},
 				}
-				verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("Paragraph"))
+				verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("Paragraph"))
 			})
 
 			It("escaped italic text with simple quote and more backslashes", func() {
@@ -767,7 +789,7 @@ var _ = Describe("quoted texts", func() {
 						},
 					},
 				}
-				verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("Paragraph"))
+				verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("Paragraph"))
 			})
 
 			It("escaped italic text with double quote", func() {
@@ -780,7 +802,7 @@ var _ = Describe("quoted texts", func() {
 						},
 					},
 				}
-				verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("Paragraph"))
+				verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("Paragraph"))
 			})
 
 			It("escaped italic text with double quote and more backslashes", func() {
@@ -793,7 +815,7 @@ var _ = Describe("quoted texts", func() {
 						},
 					},
 				}
-				verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("Paragraph"))
+				verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("Paragraph"))
 			})
 
 			It("escaped italic text with unbalanced double quote", func() {
@@ -806,7 +828,7 @@ var _ = Describe("quoted texts", func() {
 						},
 					},
 				}
-				verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("Paragraph"))
+				verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("Paragraph"))
 			})
 
 			It("escaped italic text with unbalanced double quote and more backslashes", func() {
@@ -819,7 +841,7 @@ var _ = Describe("quoted texts", func() {
 						},
 					},
 				}
-				verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("Paragraph"))
+				verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("Paragraph"))
 			})
 		})
 
@@ -835,7 +857,7 @@ var _ = Describe("quoted texts", func() {
 						},
 					},
 				}
-				verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("Paragraph"))
+				verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("Paragraph"))
 			})
 
 			It("escaped monospace text with simple quote and more backslashes", func() {
@@ -848,7 +870,7 @@ var _ = Describe("quoted texts", func() {
 						},
 					},
 				}
-				verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("Paragraph"))
+				verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("Paragraph"))
 			})
 
 			It("escaped monospace text with double quote", func() {
@@ -861,7 +883,7 @@ var _ = Describe("quoted texts", func() {
 						},
 					},
 				}
-				verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("Paragraph"))
+				verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("Paragraph"))
 			})
 
 			It("escaped monospace text with double quote and more backslashes", func() {
@@ -874,7 +896,7 @@ var _ = Describe("quoted texts", func() {
 						},
 					},
 				}
-				verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("Paragraph"))
+				verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("Paragraph"))
 			})
 
 			It("escaped monospace text with unbalanced double quote", func() {
@@ -887,7 +909,7 @@ var _ = Describe("quoted texts", func() {
 						},
 					},
 				}
-				verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("Paragraph"))
+				verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("Paragraph"))
 			})
 
 			It("escaped monospace text with unbalanced double quote and more backslashes", func() {
@@ -900,7 +922,7 @@ var _ = Describe("quoted texts", func() {
 						},
 					},
 				}
-				verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("Paragraph"))
+				verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("Paragraph"))
 			})
 		})
 
@@ -922,7 +944,7 @@ var _ = Describe("quoted texts", func() {
 						},
 					},
 				}
-				verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("Paragraph"))
+				verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("Paragraph"))
 			})
 		})
 	})
@@ -58,7 +58,7 @@ and a paragraph`
 					},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent)
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent)
 		})
 
 		It("section level 1 alone", func() {
@@ -84,7 +84,7 @@ and a paragraph`
 					},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent)
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent)
 		})
 
 		It("section level 1 with quoted text", func() {
@@ -114,7 +114,7 @@ and a paragraph`
 					},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent)
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent)
 		})
 
 		It("section level 0 with nested section level 1", func() {
@@ -152,7 +152,7 @@ and a paragraph`
 					},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent)
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent)
 		})
 
 		It("section level 0 with preamble and section level 1", func() {
@@ -205,7 +205,7 @@ a short preamble
 					},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent)
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent)
 		})
 
 		It("section level 0 with nested section level 2", func() {
@@ -243,7 +243,7 @@ a short preamble
 					},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent)
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent)
 		})
 
 		It("section level 1 with immediate paragraph", func() {
@@ -279,7 +279,7 @@ and a paragraph`
 					},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent)
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent)
 		})
 
 		It("section level 1 with a paragraph separated by empty line", func() {
@@ -317,7 +317,7 @@ and a paragraph`
 					},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent)
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent)
 		})
 
 		It("section level 1 with a paragraph separated by non-empty line", func() {
@@ -353,7 +353,7 @@ and a paragraph`
 					},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent)
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent)
 		})
 
 		It("section levels 1, 2, 3, 2", func() {
@@ -455,7 +455,7 @@ a paragraph`
 					},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent)
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent)
 		})
 
 		It("single section with custom IDs", func() {
@@ -482,7 +482,7 @@ a paragraph`
 					},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent)
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent)
 		})
 
 		It("multiple sections with custom IDs", func() {
@@ -550,7 +550,7 @@ a paragraph`
 					},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent)
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent)
 		})
 	})
 
@@ -570,7 +570,7 @@ a paragraph`
 						},
 					},
 				}}
-			verify(GinkgoT(), expectedResult, actualContent)
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent)
 		})
 
 		It("header invalid - header space", func() {
@@ -584,7 +584,7 @@ a paragraph`
 					},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent)
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent)
 		})
 
 		It("header with invalid section1", func() {
@@ -609,7 +609,7 @@ a paragraph`
 					},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent)
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent)
 		})
 
 		It("multiple sections level 0", func() {
@@ -676,7 +676,7 @@ bar`
 					},
 				},
 			}
-			verify(GinkgoT(), expectedResult, actualContent)
+			verifyWithoutLocations(GinkgoT(), expectedResult, actualContent)
 		})
 	})
 })
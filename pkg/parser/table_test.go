@@ -0,0 +1,376 @@
+package parser_test
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/parser"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	. "github.com/onsi/ginkgo"
+)
+
+var _ = Describe("tables", func() {
+
+	It("1-line table with 2 cells", func() {
+		actualContent := `|===
+| *cookie* cake | pasta
+|===`
+		expectedResult := types.Table{
+			Attributes: map[string]interface{}{},
+			Columns: []types.TableColumn{
+				{Width: 1},
+				{Width: 1},
+			},
+			Rows: []types.TableRow{
+				{
+					Cells: []types.TableCell{
+						{
+							ColSpan: 1,
+							RowSpan: 1,
+							Lines: []types.InlineElements{
+								{
+									types.QuotedText{
+										Kind: types.Bold,
+										Elements: types.InlineElements{
+											types.StringElement{Content: "cookie"},
+										},
+									},
+									types.StringElement{Content: " cake"},
+								},
+							},
+						},
+						{
+							ColSpan: 1,
+							RowSpan: 1,
+							Lines: []types.InlineElements{
+								{
+									types.StringElement{Content: "pasta"},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+		verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
+	})
+
+	It("table with implicit header and cells on multiple lines", func() {
+		actualContent := `|===
+| Column 1 | Column 2
+
+| A
+| B
+|===`
+		expectedResult := types.Table{
+			Attributes: map[string]interface{}{},
+			Columns: []types.TableColumn{
+				{Width: 1},
+				{Width: 1},
+			},
+			Header: types.TableRow{
+				Cells: []types.TableCell{
+					{
+						ColSpan: 1,
+						RowSpan: 1,
+						Lines: []types.InlineElements{
+							{
+								types.StringElement{Content: "Column 1"},
+							},
+						},
+					},
+					{
+						ColSpan: 1,
+						RowSpan: 1,
+						Lines: []types.InlineElements{
+							{
+								types.StringElement{Content: "Column 2"},
+							},
+						},
+					},
+				},
+			},
+			Rows: []types.TableRow{
+				{
+					Cells: []types.TableCell{
+						{
+							ColSpan: 1,
+							RowSpan: 1,
+							Lines: []types.InlineElements{
+								{
+									types.StringElement{Content: "A"},
+								},
+							},
+						},
+						{
+							ColSpan: 1,
+							RowSpan: 1,
+							Lines: []types.InlineElements{
+								{
+									types.StringElement{Content: "B"},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+		verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
+	})
+
+	It("table with title, cols attribute, footer and cell specs", func() {
+		actualContent := `.table title
+[cols="2*^,<.>3m", options="footer"]
+|===
+2+>| A | B
+| C
+continued | D | E
+|===`
+		expectedResult := types.Table{
+			Attributes: map[string]interface{}{
+				types.AttrTitle:   "table title",
+				types.AttrCols:    "2*^,<.>3m",
+				types.AttrOptions: "footer",
+			},
+			Columns: []types.TableColumn{
+				{Width: 1, HAlign: types.HAlignCenter},
+				{Width: 1, HAlign: types.HAlignCenter},
+				{Width: 3, HAlign: types.HAlignLeft, VAlign: types.VAlignBottom, Style: types.MonospaceCellStyle},
+			},
+			Rows: []types.TableRow{
+				{
+					Cells: []types.TableCell{
+						{
+							ColSpan: 2,
+							RowSpan: 1,
+							HAlign:  types.HAlignRight,
+							Lines: []types.InlineElements{
+								{
+									types.StringElement{Content: "A"},
+								},
+							},
+						},
+						{
+							ColSpan: 1,
+							RowSpan: 1,
+							HAlign:  types.HAlignLeft,
+							VAlign:  types.VAlignBottom,
+							Style:   types.MonospaceCellStyle,
+							Lines: []types.InlineElements{
+								{
+									types.StringElement{Content: "B"},
+								},
+							},
+						},
+					},
+				},
+			},
+			Footer: types.TableRow{
+				Cells: []types.TableCell{
+					{
+						ColSpan: 1,
+						RowSpan: 1,
+						HAlign:  types.HAlignCenter,
+						Lines: []types.InlineElements{
+							{
+								types.StringElement{Content: "C"},
+							},
+							{
+								types.StringElement{Content: "continued"},
+							},
+						},
+					},
+					{
+						ColSpan: 1,
+						RowSpan: 1,
+						HAlign:  types.HAlignCenter,
+						Lines: []types.InlineElements{
+							{
+								types.StringElement{Content: "D"},
+							},
+						},
+					},
+					{
+						ColSpan: 1,
+						RowSpan: 1,
+						HAlign:  types.HAlignLeft,
+						VAlign:  types.VAlignBottom,
+						Style:   types.MonospaceCellStyle,
+						Lines: []types.InlineElements{
+							{
+								types.StringElement{Content: "E"},
+							},
+						},
+					},
+				},
+			},
+		}
+		verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
+	})
+
+	It("table with row span and header option", func() {
+		actualContent := `[%header]
+|===
+| A | B
+.2+| C | D
+| E
+|===`
+		expectedResult := types.Table{
+			Attributes: map[string]interface{}{
+				"%header": nil,
+			},
+			Columns: []types.TableColumn{
+				{Width: 1},
+				{Width: 1},
+			},
+			Header: types.TableRow{
+				Cells: []types.TableCell{
+					{
+						ColSpan: 1,
+						RowSpan: 1,
+						Lines: []types.InlineElements{
+							{
+								types.StringElement{Content: "A"},
+							},
+						},
+					},
+					{
+						ColSpan: 1,
+						RowSpan: 1,
+						Lines: []types.InlineElements{
+							{
+								types.StringElement{Content: "B"},
+							},
+						},
+					},
+				},
+			},
+			Rows: []types.TableRow{
+				{
+					Cells: []types.TableCell{
+						{
+							ColSpan: 1,
+							RowSpan: 2,
+							Lines: []types.InlineElements{
+								{
+									types.StringElement{Content: "C"},
+								},
+							},
+						},
+						{
+							ColSpan: 1,
+							RowSpan: 1,
+							Lines: []types.InlineElements{
+								{
+									types.StringElement{Content: "D"},
+								},
+							},
+						},
+					},
+				},
+				{
+					Cells: []types.TableCell{
+						{
+							ColSpan: 1,
+							RowSpan: 1,
+							Lines: []types.InlineElements{
+								{
+									types.StringElement{Content: "E"},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+		verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
+	})
+
+	It("table with single-letter cells which are not cell specs", func() {
+		actualContent := `|===
+|a|b
+|===`
+		expectedResult := types.Table{
+			Attributes: map[string]interface{}{},
+			Columns: []types.TableColumn{
+				{Width: 1},
+				{Width: 1},
+			},
+			Rows: []types.TableRow{
+				{
+					Cells: []types.TableCell{
+						{
+							ColSpan: 1,
+							RowSpan: 1,
+							Lines: []types.InlineElements{
+								{
+									types.StringElement{Content: "a"},
+								},
+							},
+						},
+						{
+							ColSpan: 1,
+							RowSpan: 1,
+							Lines: []types.InlineElements{
+								{
+									types.StringElement{Content: "b"},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+		verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
+	})
+
+	It("table with footnote in cell", func() {
+		actualContent := `|===
+| cookie.footnote:[a note for the cookie] | pasta
+|===`
+		expectedResult := types.Table{
+			Attributes: map[string]interface{}{},
+			Columns: []types.TableColumn{
+				{Width: 1},
+				{Width: 1},
+			},
+			Rows: []types.TableRow{
+				{
+					Cells: []types.TableCell{
+						{
+							ColSpan: 1,
+							RowSpan: 1,
+							Lines: []types.InlineElements{
+								{
+									types.StringElement{Content: "cookie."},
+									types.Footnote{
+										Elements: types.InlineElements{
+											types.StringElement{Content: "a note for the cookie"},
+										},
+									},
+								},
+							},
+						},
+						{
+							ColSpan: 1,
+							RowSpan: 1,
+							Lines: []types.InlineElements{
+								{
+									types.StringElement{Content: "pasta"},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+		verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
+	})
+
+	It("empty table", func() {
+		actualContent := `|===
+|===`
+		expectedResult := types.Table{
+			Attributes: map[string]interface{}{},
+			Rows:       []types.TableRow{},
+		}
+		verifyWithoutLocations(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DocumentBlock"))
+	})
+})
@@ -0,0 +1,159 @@
+package preprocessor
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+)
+
+type conditionalKind string
+
+const (
+	ifdef  conditionalKind = "ifdef"
+	ifndef conditionalKind = "ifndef"
+	ifeval conditionalKind = "ifeval"
+	endif  conditionalKind = "endif"
+)
+
+var conditionalDirectiveRegexp = regexp.MustCompile(`^(ifdef|ifndef|ifeval|endif)::(\S*?)\[(.*)\]\s*$`)
+
+// conditionalDirective an `ifdef::names[content]`, `ifndef::names[content]`, `ifeval::[expression]`
+// or `endif::[]` directive
+type conditionalDirective struct {
+	kind    conditionalKind
+	names   string
+	content string
+}
+
+// newConditionalDirective returns the conditional directive in the given line, if it matches
+func newConditionalDirective(line string) (conditionalDirective, bool) {
+	match := conditionalDirectiveRegexp.FindStringSubmatch(line)
+	if match == nil {
+		return conditionalDirective{}, false
+	}
+	kind := conditionalKind(match[1])
+	if kind == ifeval && match[2] != "" {
+		// ifeval only supports the expression between the brackets
+		return conditionalDirective{}, false
+	}
+	if (kind == ifdef || kind == ifndef) && match[2] == "" {
+		return conditionalDirective{}, false
+	}
+	return conditionalDirective{
+		kind:    kind,
+		names:   match[2],
+		content: match[3],
+	}, true
+}
+
+// evaluate evaluates the condition of the given directive against the attributes declared so far.
+// In the `ifdef` directives, the names separated with `,` are satisfied if any of them is defined
+// while the names separated with `+` are satisfied if all of them are defined (and conversely in
+// the `ifndef` directives).
+func (p processor) evaluate(d conditionalDirective, location types.Location) bool {
+	switch d.kind {
+	case ifdef:
+		return p.defined(d.names)
+	case ifndef:
+		return !p.defined(d.names)
+	case ifeval:
+		return p.evaluateExpression(d.content, location)
+	}
+	return false
+}
+
+// defined returns true if any (`,`) or all (`+`) of the given attribute names are defined
+func (p processor) defined(names string) bool {
+	if strings.Contains(names, "+") {
+		for _, name := range strings.Split(names, "+") {
+			if _, found := p.attributes[name]; !found {
+				return false
+			}
+		}
+		return true
+	}
+	for _, name := range strings.Split(names, ",") {
+		if _, found := p.attributes[name]; found {
+			return true
+		}
+	}
+	return false
+}
+
+var expressionRegexp = regexp.MustCompile(`^\s*(.+?)\s*(==|!=|<=|>=|<|>)\s*(.+?)\s*$`)
+
+// evaluateExpression evaluates an expression such as `{sectnumlevels} > 2` or `"{backend}" == "html5"`
+func (p processor) evaluateExpression(expr string, location types.Location) bool {
+	match := expressionRegexp.FindStringSubmatch(expr)
+	if match == nil {
+		p.ctx.Diagnostics().Warnf("invalid-ifeval", location, "invalid expression in ifeval directive: '%s'", expr)
+		return false
+	}
+	left, right := p.operand(match[1]), p.operand(match[3])
+	var cmp int
+	l, lerr := strconv.ParseFloat(left, 64)
+	r, rerr := strconv.ParseFloat(right, 64)
+	switch {
+	case lerr == nil && rerr == nil && l < r:
+		cmp = -1
+	case lerr == nil && rerr == nil && l > r:
+		cmp = 1
+	case lerr == nil && rerr == nil:
+		cmp = 0
+	default:
+		cmp = strings.Compare(left, right)
+	}
+	switch match[2] {
+	case "==":
+		return cmp == 0
+	case "!=":
+		return cmp != 0
+	case "<":
+		return cmp < 0
+	case "<=":
+		return cmp <= 0
+	case ">":
+		return cmp > 0
+	default: // ">="
+		return cmp >= 0
+	}
+}
+
+// operand returns the value of the given operand, once its attributes were substituted and its quotes were removed
+func (p processor) operand(s string) string {
+	s = p.substituteAttributes(s)
+	if len(s) >= 2 && (s[0] == '"' || s[0] == '\'') && s[len(s)-1] == s[0] {
+		return s[1 : len(s)-1]
+	}
+	return s
+}
+
+var attributeReferenceRegexp = regexp.MustCompile(`\{([\w][\w-]*)\}`)
+
+// substituteAttributes replaces the references to the attributes declared so far with their value
+func (p processor) substituteAttributes(s string) string {
+	return attributeReferenceRegexp.ReplaceAllStringFunc(s, func(ref string) string {
+		if value, found := p.attributes[ref[1:len(ref)-1]]; found {
+			return value
+		}
+		return ref
+	})
+}
+
+var attributeEntryRegexp = regexp.MustCompile(`^:(!?)([\w][\w-]*)(!?):(?:\s+(.*?))?\s*$`)
+
+// recordAttribute records the attribute declared or reset in the given line, if any,
+// unless the attribute was locked from outside of the document
+func (p processor) recordAttribute(line string) {
+	match := attributeEntryRegexp.FindStringSubmatch(line)
+	if match == nil || p.ctx.Attributes().IsLocked(match[2]) {
+		return
+	}
+	if match[1] == "!" || match[3] == "!" {
+		delete(p.attributes, match[2])
+		return
+	}
+	p.attributes[match[2]] = p.substituteAttributes(match[4])
+}
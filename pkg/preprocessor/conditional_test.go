@@ -0,0 +1,215 @@
+package preprocessor_test
+
+import (
+	"context"
+	"io/ioutil"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/preprocessor"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	. "github.com/onsi/ginkgo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var _ = Describe("conditional directives", func() {
+
+	Context("ifdef", func() {
+
+		It("ifdef with attribute declared in the document", func() {
+			actualContent := `:foo:
+
+ifdef::foo[]
+foo is defined
+endif::[]
+ifdef::bar[]
+bar is defined
+endif::[]`
+			expectedResult := `:foo:
+
+foo is defined`
+			verifyConditionals(GinkgoT(), nil, expectedResult, actualContent)
+		})
+
+		It("ifdef with attribute supplied by the caller", func() {
+			actualContent := `ifdef::foo[]
+foo is defined
+endif::foo[]`
+			expectedResult := `foo is defined`
+			verifyConditionals(GinkgoT(), types.DocumentAttributes{"foo": "bar"}, expectedResult, actualContent)
+		})
+
+		It("ifdef with attribute reset in the document", func() {
+			actualContent := `:!foo:
+ifdef::foo[]
+foo is defined
+endif::[]`
+			expectedResult := `:!foo:`
+			verifyConditionals(GinkgoT(), types.DocumentAttributes{"foo": "bar"}, expectedResult, actualContent)
+		})
+
+		It("ifdef with any attribute", func() {
+			actualContent := `:bar:
+ifdef::foo,bar[]
+foo or bar is defined
+endif::[]`
+			expectedResult := `:bar:
+foo or bar is defined`
+			verifyConditionals(GinkgoT(), nil, expectedResult, actualContent)
+		})
+
+		It("ifdef with all attributes", func() {
+			actualContent := `:bar:
+ifdef::foo+bar[]
+foo and bar are defined
+endif::[]`
+			expectedResult := `:bar:`
+			verifyConditionals(GinkgoT(), nil, expectedResult, actualContent)
+		})
+
+		It("single-line ifdef", func() {
+			actualContent := `:foo:
+ifdef::foo[foo is defined]
+ifdef::bar[bar is defined]`
+			expectedResult := `:foo:
+foo is defined`
+			verifyConditionals(GinkgoT(), nil, expectedResult, actualContent)
+		})
+
+		It("nested conditionals", func() {
+			actualContent := `:foo:
+ifdef::foo[]
+foo is defined
+ifdef::bar[]
+foo and bar are defined
+endif::bar[]
+ifndef::bar[]
+foo is defined but bar is not
+endif::bar[]
+endif::foo[]`
+			expectedResult := `:foo:
+foo is defined
+foo is defined but bar is not`
+			verifyConditionals(GinkgoT(), nil, expectedResult, actualContent)
+		})
+
+		It("attributes in skipped content are ignored", func() {
+			actualContent := `ifdef::foo[]
+:bar:
+endif::[]
+ifdef::bar[]
+bar is defined
+endif::[]`
+			expectedResult := ``
+			verifyConditionals(GinkgoT(), nil, expectedResult, actualContent)
+		})
+	})
+
+	Context("ifndef", func() {
+
+		It("ifndef with any attribute", func() {
+			actualContent := `:foo:
+ifndef::foo,bar[]
+neither foo nor bar is defined
+endif::[]`
+			expectedResult := `:foo:`
+			verifyConditionals(GinkgoT(), nil, expectedResult, actualContent)
+		})
+
+		It("ifndef with all attributes", func() {
+			actualContent := `:foo:
+ifndef::foo+bar[]
+foo and bar are not both defined
+endif::[]`
+			expectedResult := `:foo:
+foo and bar are not both defined`
+			verifyConditionals(GinkgoT(), nil, expectedResult, actualContent)
+		})
+	})
+
+	Context("ifeval", func() {
+
+		It("ifeval with numbers", func() {
+			actualContent := `:level: 3
+ifeval::[{level} > 2]
+level is greater than 2
+endif::[]
+ifeval::[{level} <= 2]
+level is lower than or equal to 2
+endif::[]`
+			expectedResult := `:level: 3
+level is greater than 2`
+			verifyConditionals(GinkgoT(), nil, expectedResult, actualContent)
+		})
+
+		It("ifeval with strings", func() {
+			actualContent := `ifeval::["{backend}" == "html5"]
+HTML output
+endif::[]
+ifeval::["{backend}" != "html5"]
+other output
+endif::[]`
+			expectedResult := `HTML output`
+			verifyConditionals(GinkgoT(), types.DocumentAttributes{"backend": "html5"}, expectedResult, actualContent)
+		})
+	})
+
+	Context("attributes set from outside of the document", func() {
+
+		It("ifdef with attribute set from outside of the document", func() {
+			actualContent := `ifdef::foo[]
+foo is defined
+endif::[]`
+			expectedResult := `foo is defined`
+			verifyConditionals(GinkgoT(), nil, expectedResult, actualContent, renderer.Attributes(map[string]string{"foo": ""}))
+		})
+
+		It("ifdef with attribute unset from outside of the document", func() {
+			actualContent := `:foo:
+ifdef::foo[]
+foo is defined
+endif::[]`
+			expectedResult := `:foo:`
+			verifyConditionals(GinkgoT(), nil, expectedResult, actualContent, renderer.Attributes(map[string]string{"foo!": ""}))
+		})
+
+		It("ifeval with attribute soft set from outside of the document", func() {
+			actualContent := `:level: 3
+ifeval::[{level} > 2]
+level is greater than 2
+endif::[]`
+			expectedResult := `:level: 3
+level is greater than 2`
+			verifyConditionals(GinkgoT(), nil, expectedResult, actualContent, renderer.Attributes(map[string]string{"level": "1@"}))
+		})
+	})
+
+	It("escaped conditional directive", func() {
+		actualContent := `\ifdef::foo[]`
+		expectedResult := `ifdef::foo[]`
+		verifyConditionals(GinkgoT(), nil, expectedResult, actualContent)
+	})
+
+	It("conditional directive in comment block", func() {
+		actualContent := `////
+ifdef::foo[]
+////`
+		expectedResult := `////
+ifdef::foo[]
+////`
+		verifyConditionals(GinkgoT(), nil, expectedResult, actualContent)
+	})
+})
+
+func verifyConditionals(t GinkgoTInterface, attributes types.DocumentAttributes, expectedResult, content string, options ...renderer.Option) {
+	t.Logf("processing '%s'", content)
+	ctx := renderer.Wrap(context.Background(), types.Document{Attributes: attributes}, options...)
+	r, err := preprocessor.Process(ctx, "", strings.NewReader(content))
+	require.NoError(t, err)
+	result, err := ioutil.ReadAll(r)
+	require.NoError(t, err)
+	t.Logf("actual result: `%s`", string(result))
+	t.Logf("expected result: `%s`", expectedResult)
+	assert.Equal(t, expectedResult, string(result))
+}
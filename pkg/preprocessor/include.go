@@ -0,0 +1,240 @@
+package preprocessor
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// maxIncludeDepth the maximum depth of nested inclusions, to avoid infinite loops
+const maxIncludeDepth = 64
+
+var includeDirectiveRegexp = regexp.MustCompile(`^include::(\S[^\[]*)\[(.*)\]\s*$`)
+
+// includeDirective an `include::target[attributes]` directive
+type includeDirective struct {
+	line       sourceLine
+	raw        string
+	target     string
+	attributes map[string]string
+}
+
+// newIncludeDirective returns the include directive in the given line, if it matches.
+// The attributes in the target are substituted with their current value.
+func (p processor) newIncludeDirective(line sourceLine) (includeDirective, bool) {
+	match := includeDirectiveRegexp.FindStringSubmatch(line.content)
+	if match == nil {
+		return includeDirective{}, false
+	}
+	return includeDirective{
+		line:       line,
+		raw:        line.content,
+		target:     p.substituteAttributes(strings.TrimSpace(match[1])),
+		attributes: parseAttributes(match[2]),
+	}, true
+}
+
+var directiveAttributeRegexp = regexp.MustCompile(`\s*([^=,\s]+)\s*(?:=\s*(?:"([^"]*)"|([^,]*)))?\s*(?:,|$)`)
+
+// parseAttributes parses the `key=value` attributes of a directive. Values may be wrapped
+// in double quotes, in which case they can contain commas.
+func parseAttributes(text string) map[string]string {
+	result := map[string]string{}
+	for _, match := range directiveAttributeRegexp.FindAllStringSubmatch(text, -1) {
+		result[match[1]] = match[2] + strings.TrimSpace(match[3])
+	}
+	return result
+}
+
+// include returns the lines of the file targeted by the given directive, once filtered and preprocessed
+func (p processor) include(filename string, d includeDirective, depth, levelOffset int) ([]sourceLine, error) {
+	if depth >= maxIncludeDepth {
+		return nil, errors.Errorf("maximum include depth of %d exceeded in '%s'", maxIncludeDepth, filename)
+	}
+	if p.ctx.SafeMode() >= renderer.SafeModeSecure {
+		// the include directives are replaced by a link to their target
+		return []sourceLine{d.line.withContent(fmt.Sprintf("link:%s[]", d.target))}, nil
+	}
+	path := d.target
+	if !filepath.IsAbs(path) && filename != "" {
+		path = filepath.Join(filepath.Dir(filename), path)
+	}
+	if p.ctx.SafeMode() >= renderer.SafeModeSafe && !p.withinBaseDir(path) {
+		p.ctx.Diagnostics().Warnf("unsafe-include", d.line.location(), "unable to include '%s' in '%s': the file is outside of the '%s' directory in the '%s' safe mode", path, filename, p.baseDir, p.ctx.SafeMode())
+		return []sourceLine{d.line.withContent(fmt.Sprintf("Unresolved directive in %s - %s", filepath.Base(filename), d.raw))}, nil
+	}
+	log.Debugf("including '%s' in '%s'", path, filename)
+	lines, err := p.readFile(path)
+	if err != nil {
+		p.ctx.Diagnostics().Warnf("unresolved-include", d.line.location(), "unable to include '%s' in '%s': %v", path, filename, err)
+		return []sourceLine{d.line.withContent(fmt.Sprintf("Unresolved directive in %s - %s", filepath.Base(filename), d.raw))}, nil
+	}
+	if lr, found := d.attributes["lines"]; found {
+		lines = p.filterLines(d, lines, lr)
+	} else if tags, found := d.attributes["tags"]; found {
+		lines = p.filterTags(lines, tags)
+	} else if tag, found := d.attributes["tag"]; found {
+		lines = p.filterTags(lines, tag)
+	}
+	if indent, found := d.attributes["indent"]; found {
+		if i, err := strconv.Atoi(indent); err == nil && i >= 0 {
+			lines = applyIndent(lines, i)
+		}
+	}
+	if lo, found := d.attributes["leveloffset"]; found {
+		if offset, err := strconv.Atoi(lo); err == nil {
+			if strings.HasPrefix(lo, "+") || strings.HasPrefix(lo, "-") {
+				levelOffset += offset
+			} else {
+				levelOffset = offset
+			}
+		}
+	}
+	return p.process(path, lines, depth+1, levelOffset)
+}
+
+// withinBaseDir returns true if the file at the given path is in the base directory of the processor (or in one of its subdirectories)
+func (p processor) withinBaseDir(path string) bool {
+	return renderer.WithinDir(p.baseDir, path)
+}
+
+// readFile reads the lines of the file at the given path using the resolver configured in the context
+func (p processor) readFile(path string) ([]sourceLine, error) {
+	f, err := p.ctx.IncludeResolver().Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return readLines(path, f)
+}
+
+// filterLines retains the lines in the given ranges, such as `1..5;10;12..-1`
+// (ranges may be separated with `;` or `,`, and `-1` or an empty end, as in `12..`, means the end of the file)
+func (p processor) filterLines(d includeDirective, lines []sourceLine, ranges string) []sourceLine {
+	result := []sourceLine{}
+	selected := make([]bool, len(lines))
+	for _, r := range strings.FieldsFunc(ranges, func(c rune) bool { return c == ';' || c == ',' }) {
+		bounds := strings.SplitN(strings.TrimSpace(r), "..", 2)
+		start, err := strconv.Atoi(bounds[0])
+		if err != nil {
+			p.ctx.Diagnostics().Warnf("invalid-line-range", d.line.location(), "invalid line range in include directive: '%s'", r)
+			continue
+		}
+		end := start
+		if len(bounds) == 2 && bounds[1] == "" {
+			end = len(lines)
+		} else if len(bounds) == 2 {
+			if end, err = strconv.Atoi(bounds[1]); err != nil {
+				p.ctx.Diagnostics().Warnf("invalid-line-range", d.line.location(), "invalid line range in include directive: '%s'", r)
+				continue
+			}
+			if end < 0 {
+				end = len(lines)
+			}
+		}
+		for i := start; i <= end && i <= len(lines); i++ {
+			if i >= 1 {
+				selected[i-1] = true
+			}
+		}
+	}
+	for i, l := range lines {
+		if selected[i] {
+			result = append(result, l)
+		}
+	}
+	return result
+}
+
+var tagDirectiveRegexp = regexp.MustCompile(`\b(tag|end)::(\S+?)\[\]`)
+
+// filterTags retains the lines in the regions delimited by the `tag::name[]` and `end::name[]` markers,
+// given a list of tags separated by `;` or `,`. A tag prefixed with `!` is excluded, `*` matches all the tags
+// and `**` matches all the lines (including the ones outside of any tagged region).
+// The lines containing the markers are never included.
+func (p processor) filterTags(lines []sourceLine, tags string) []sourceLine {
+	selection := map[string]bool{}
+	var wildcard *bool
+	base := true
+	for _, t := range strings.FieldsFunc(tags, func(c rune) bool { return c == ';' || c == ',' }) {
+		t = strings.TrimSpace(t)
+		include := !strings.HasPrefix(t, "!")
+		t = strings.TrimPrefix(t, "!")
+		switch t {
+		case "**":
+			base = include
+		case "*":
+			wildcard = &include
+			if include {
+				base = false
+			}
+		default:
+			selection[t] = include
+			if include {
+				base = false
+			}
+		}
+	}
+	result := []sourceLine{}
+	stack := []string{}
+	for _, l := range lines {
+		if match := tagDirectiveRegexp.FindStringSubmatch(l.content); match != nil {
+			if match[1] == "tag" {
+				stack = append(stack, match[2])
+			} else if len(stack) > 0 && stack[len(stack)-1] == match[2] {
+				stack = stack[:len(stack)-1]
+			} else {
+				p.ctx.Diagnostics().Warnf("mismatched-tag", l.location(), "mismatched end tag in included file: '%s'", match[2])
+			}
+			continue
+		}
+		if isTagSelected(stack, selection, wildcard, base) {
+			result = append(result, l)
+		}
+	}
+	return result
+}
+
+// isTagSelected returns true if the innermost tag in the given stack is selected. A tag which is not
+// explicitly (de)selected inherits the wildcard selection if any, otherwise the selection of its parent
+func isTagSelected(stack []string, selection map[string]bool, wildcard *bool, base bool) bool {
+	for i := len(stack) - 1; i >= 0; i-- {
+		if include, found := selection[stack[i]]; found {
+			return include
+		}
+		if wildcard != nil {
+			return *wildcard
+		}
+	}
+	return base
+}
+
+// applyIndent removes the common leading indentation of the given lines, then indents them with the given
+// number of spaces (blank lines are left empty)
+func applyIndent(lines []sourceLine, indent int) []sourceLine {
+	common := -1
+	for _, l := range lines {
+		if strings.TrimSpace(l.content) == "" {
+			continue
+		}
+		n := len(l.content) - len(strings.TrimLeft(l.content, " \t"))
+		if common < 0 || n < common {
+			common = n
+		}
+	}
+	result := make([]sourceLine, len(lines))
+	for i, l := range lines {
+		if strings.TrimSpace(l.content) == "" {
+			result[i] = l.withContent("")
+			continue
+		}
+		result[i] = l.withContent(strings.Repeat(" ", indent) + l.content[common:])
+	}
+	return result
+}
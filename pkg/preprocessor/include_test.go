@@ -0,0 +1,310 @@
+package preprocessor_test
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/preprocessor"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	. "github.com/onsi/ginkgo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var _ = Describe("include directives", func() {
+
+	files := inMemoryResolver{
+		"docs/chapter.adoc": `== Chapter
+
+a paragraph`,
+		"docs/includes/nested.adoc": `include::../chapter.adoc[leveloffset=+1]`,
+		"docs/lines.adoc": `line 1
+line 2
+line 3
+line 4
+line 5`,
+		"docs/tags.adoc": `first line
+// tag::a[]
+in a
+// tag::b[]
+in b
+// end::b[]
+// end::a[]
+// tag::c[]
+in c
+// end::c[]
+last line`,
+		"docs/crlf.adoc": "line 1\r\nline 2\r\nline 3\r\n",
+		"secret.adoc":    `secret content`,
+		"docs/code.go": `	func main() {
+		fmt.Println("hello")
+	}`,
+	}
+
+	It("include relative to the including file", func() {
+		actualContent := `= Title
+
+include::chapter.adoc[]`
+		expectedResult := `= Title
+
+== Chapter
+
+a paragraph`
+		verify(GinkgoT(), files, "docs/index.adoc", expectedResult, actualContent)
+	})
+
+	It("include with attribute in target", func() {
+		actualContent := `:chapters: .
+
+include::{chapters}/chapter.adoc[]`
+		expectedResult := `:chapters: .
+
+== Chapter
+
+a paragraph`
+		verify(GinkgoT(), files, "docs/index.adoc", expectedResult, actualContent)
+	})
+
+	It("nested include with level offset", func() {
+		actualContent := `include::includes/nested.adoc[leveloffset=+1]`
+		expectedResult := `==== Chapter
+
+a paragraph`
+		verify(GinkgoT(), files, "docs/index.adoc", expectedResult, actualContent)
+	})
+
+	It("include with absolute level offset", func() {
+		actualContent := `include::chapter.adoc[leveloffset=3]`
+		expectedResult := `===== Chapter
+
+a paragraph`
+		verify(GinkgoT(), files, "docs/index.adoc", expectedResult, actualContent)
+	})
+
+	It("include with line ranges", func() {
+		actualContent := `include::lines.adoc[lines="1..2,4..-1"]`
+		expectedResult := `line 1
+line 2
+line 4
+line 5`
+		verify(GinkgoT(), files, "docs/index.adoc", expectedResult, actualContent)
+	})
+
+	It("include with open-ended line range", func() {
+		actualContent := `include::lines.adoc[lines=1;3..]`
+		expectedResult := `line 1
+line 3
+line 4
+line 5`
+		verify(GinkgoT(), files, "docs/index.adoc", expectedResult, actualContent)
+	})
+
+	It("include with single tag", func() {
+		actualContent := `include::tags.adoc[tag=b]`
+		expectedResult := `in b`
+		verify(GinkgoT(), files, "docs/index.adoc", expectedResult, actualContent)
+	})
+
+	It("include with multiple tags and exclusion", func() {
+		actualContent := `include::tags.adoc[tags=a;!b;c]`
+		expectedResult := `in a
+in c`
+		verify(GinkgoT(), files, "docs/index.adoc", expectedResult, actualContent)
+	})
+
+	It("include with all lines but one tag", func() {
+		actualContent := `include::tags.adoc[tags=**;!a]`
+		expectedResult := `first line
+in c
+last line`
+		verify(GinkgoT(), files, "docs/index.adoc", expectedResult, actualContent)
+	})
+
+	It("include with indent", func() {
+		actualContent := `----
+include::code.go[indent=2]
+----`
+		expectedResult := `----
+  func main() {
+  	fmt.Println("hello")
+  }
+----`
+		verify(GinkgoT(), files, "docs/index.adoc", expectedResult, actualContent)
+	})
+
+	It("section titles in delimited blocks are not offset", func() {
+		actualContent := `include::block.adoc[leveloffset=+1]`
+		expectedResult := `== Section
+----
+= not a title
+----`
+		verify(GinkgoT(), inMemoryResolver{
+			"block.adoc": `= Section
+----
+= not a title
+----`,
+		}, "", expectedResult, actualContent)
+	})
+
+	It("escaped include and include in comment block", func() {
+		actualContent := `\include::chapter.adoc[]
+////
+include::chapter.adoc[]
+////`
+		expectedResult := `include::chapter.adoc[]
+////
+include::chapter.adoc[]
+////`
+		verify(GinkgoT(), files, "docs/index.adoc", expectedResult, actualContent)
+	})
+
+	It("unresolved include", func() {
+		actualContent := `include::unknown.adoc[]`
+		expectedResult := `Unresolved directive in index.adoc - include::unknown.adoc[]`
+		verify(GinkgoT(), files, "docs/index.adoc", expectedResult, actualContent)
+	})
+
+	It("unresolved include reported in diagnostics", func() {
+		actualContent := "first line\ninclude::unknown.adoc[]"
+		ctx := renderer.Wrap(context.Background(), types.Document{}, renderer.IncludeResolver(files))
+		_, err := preprocessor.Process(ctx, "docs/index.adoc", strings.NewReader(actualContent))
+		require.NoError(GinkgoT(), err)
+		diagnostics := *ctx.Diagnostics()
+		require.Len(GinkgoT(), diagnostics, 1)
+		assert.Equal(GinkgoT(), "unresolved-include", diagnostics[0].Rule)
+		assert.Equal(GinkgoT(), "docs/index.adoc:2:1", diagnostics[0].Location.String())
+	})
+
+	It("include file from the local file system by default", func() {
+		f, err := ioutil.TempFile("", "libasciidoc")
+		require.NoError(GinkgoT(), err)
+		defer os.Remove(f.Name())
+		_, err = f.WriteString("some content")
+		require.NoError(GinkgoT(), err)
+		require.NoError(GinkgoT(), f.Close())
+		actualContent := "include::" + f.Name() + "[]"
+		expectedResult := "some content"
+		verify(GinkgoT(), nil, "", expectedResult, actualContent)
+	})
+
+	Context("safe modes", func() {
+
+		It("include in the directory of the document in safe mode", func() {
+			actualContent := `include::includes/nested.adoc[]`
+			expectedResult := `=== Chapter
+
+a paragraph`
+			verify(GinkgoT(), files, "docs/index.adoc", expectedResult, actualContent, renderer.Safe(renderer.SafeModeSafe))
+		})
+
+		It("include outside of the directory of the document in safe mode", func() {
+			actualContent := "first line\ninclude::../secret.adoc[]"
+			ctx := renderer.Wrap(context.Background(), types.Document{}, renderer.IncludeResolver(files), renderer.Safe(renderer.SafeModeSafe))
+			r, err := preprocessor.Process(ctx, "docs/index.adoc", strings.NewReader(actualContent))
+			require.NoError(GinkgoT(), err)
+			result, err := ioutil.ReadAll(r)
+			require.NoError(GinkgoT(), err)
+			assert.Equal(GinkgoT(), "first line\nUnresolved directive in index.adoc - include::../secret.adoc[]", string(result))
+			diagnostics := *ctx.Diagnostics()
+			require.Len(GinkgoT(), diagnostics, 1)
+			assert.Equal(GinkgoT(), "unsafe-include", diagnostics[0].Rule)
+			assert.Equal(GinkgoT(), "docs/index.adoc:2:1", diagnostics[0].Location.String())
+		})
+
+		It("include outside of the directory of the document in unsafe mode", func() {
+			actualContent := `include::../secret.adoc[]`
+			expectedResult := `secret content`
+			verify(GinkgoT(), files, "docs/index.adoc", expectedResult, actualContent, renderer.Safe(renderer.SafeModeUnsafe))
+		})
+
+		It("include replaced by a link in secure mode", func() {
+			actualContent := `= Title
+
+include::chapter.adoc[leveloffset=+1]`
+			expectedResult := `= Title
+
+link:chapter.adoc[]`
+			verify(GinkgoT(), files, "docs/index.adoc", expectedResult, actualContent, renderer.Safe(renderer.SafeModeSecure))
+		})
+
+		It("safe mode attributes in conditional directives", func() {
+			actualContent := `ifdef::safe-mode-server[]
+server mode
+endif::[]
+ifeval::[{safe-mode-level} >= 10]
+level 10 or more
+endif::[]
+:safe-mode-name: unsafe
+ifeval::["{safe-mode-name}" == "unsafe"]
+unsafe mode
+endif::[]`
+			expectedResult := `server mode
+level 10 or more
+:safe-mode-name: unsafe`
+			verify(GinkgoT(), files, "docs/index.adoc", expectedResult, actualContent, renderer.Safe(renderer.SafeModeServer))
+		})
+	})
+
+	It("source map of the included lines", func() {
+		actualContent := `first line
+ifdef::foo[]
+skipped line
+endif::[]
+include::lines.adoc[lines=2..3]
+last line`
+		ctx := renderer.Wrap(context.Background(), types.Document{}, renderer.IncludeResolver(files))
+		_, err := preprocessor.Process(ctx, "docs/index.adoc", strings.NewReader(actualContent))
+		require.NoError(GinkgoT(), err)
+		assert.Equal(GinkgoT(), types.SourceMap{
+			{File: "docs/index.adoc", Line: 1, Offset: 0, TargetOffset: 0},
+			{File: "docs/lines.adoc", Line: 2, Offset: 7, TargetOffset: 11},
+			{File: "docs/lines.adoc", Line: 3, Offset: 14, TargetOffset: 18},
+			{File: "docs/index.adoc", Line: 6, Offset: 79, TargetOffset: 25},
+		}, ctx.SourceMap())
+	})
+
+	It("source map of the included lines with CRLF end-of-line markers", func() {
+		actualContent := "first line\r\ninclude::crlf.adoc[lines=2..]\r\nlast line"
+		ctx := renderer.Wrap(context.Background(), types.Document{}, renderer.IncludeResolver(files))
+		r, err := preprocessor.Process(ctx, "docs/index.adoc", strings.NewReader(actualContent))
+		require.NoError(GinkgoT(), err)
+		result, err := ioutil.ReadAll(r)
+		require.NoError(GinkgoT(), err)
+		assert.Equal(GinkgoT(), "first line\nline 2\nline 3\nlast line", string(result))
+		assert.Equal(GinkgoT(), types.SourceMap{
+			{File: "docs/index.adoc", Line: 1, Offset: 0, TargetOffset: 0},
+			{File: "docs/crlf.adoc", Line: 2, Offset: 8, TargetOffset: 11},
+			{File: "docs/crlf.adoc", Line: 3, Offset: 16, TargetOffset: 18},
+			{File: "docs/index.adoc", Line: 3, Offset: 43, TargetOffset: 25},
+		}, ctx.SourceMap())
+	})
+})
+
+// inMemoryResolver a FileResolver which serves the content of the files from memory
+type inMemoryResolver map[string]string
+
+func (r inMemoryResolver) Open(path string) (io.ReadCloser, error) {
+	if content, found := r[path]; found {
+		return ioutil.NopCloser(strings.NewReader(content)), nil
+	}
+	return nil, os.ErrNotExist
+}
+
+func verify(t GinkgoTInterface, resolver renderer.FileResolver, filename, expectedResult, content string, options ...renderer.Option) {
+	t.Logf("processing '%s'", content)
+	if resolver != nil {
+		options = append(options, renderer.IncludeResolver(resolver))
+	}
+	ctx := renderer.Wrap(context.Background(), types.Document{}, options...)
+	r, err := preprocessor.Process(ctx, filename, strings.NewReader(content))
+	require.NoError(t, err)
+	result, err := ioutil.ReadAll(r)
+	require.NoError(t, err)
+	t.Logf("actual result: `%s`", string(result))
+	t.Logf("expected result: `%s`", expectedResult)
+	assert.Equal(t, expectedResult, string(result))
+}
@@ -0,0 +1,244 @@
+package preprocessor
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// Process processes the preprocessor directives (such as `include::`) in the content of the given reader,
+// and returns a new reader on the resulting content, ready to be parsed.
+// The given filename is the path of the document being processed, used to resolve the relative paths
+// of the files to include. It can be empty if the content is not read from a file, in which case the
+// relative paths are resolved against the current working directory.
+func Process(ctx *renderer.Context, filename string, r io.Reader) (io.Reader, error) {
+	log.Debugf("preprocessing '%s'", filename)
+	lines, err := readLines(filename, r)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to preprocess '%s'", filename)
+	}
+	p := processor{
+		ctx:        ctx,
+		attributes: map[string]string{},
+		baseDir:    ".",
+	}
+	if filename != "" {
+		p.baseDir = filepath.Dir(filename)
+	}
+	attributes := map[string]interface{}{}
+	for k, v := range ctx.Document.Attributes {
+		attributes[k] = v
+	}
+	ctx.Attributes().Apply(attributes)
+	for k, v := range attributes {
+		if v == nil {
+			p.attributes[k] = ""
+		} else {
+			p.attributes[k] = fmt.Sprintf("%v", v)
+		}
+	}
+	result, err := p.process(filename, lines, 0, 0)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to preprocess '%s'", filename)
+	}
+	// keep track of the origin of each line, to resolve the locations of the elements once parsed
+	content := make([]string, len(result))
+	sourceMap := make(types.SourceMap, len(result))
+	offset := 0
+	for i, l := range result {
+		content[i] = l.content
+		sourceMap[i] = types.SourceLine{
+			File:         l.file,
+			Line:         l.number,
+			Offset:       l.offset,
+			TargetOffset: offset,
+		}
+		offset += len(l.content) + 1
+	}
+	ctx.SetSourceMap(sourceMap)
+	return strings.NewReader(strings.Join(content, "\n")), nil
+}
+
+// sourceLine a line read from a file, with its number and offset in the file
+type sourceLine struct {
+	content string
+	file    string
+	number  int
+	offset  int
+}
+
+// location returns the location of this line in its file
+func (l sourceLine) location() types.Location {
+	start := types.Position{Line: l.number, Col: 1, Offset: l.offset}
+	return types.Location{
+		File:  l.file,
+		Start: start,
+		End:   start.Advance([]byte(l.content)),
+	}
+}
+
+// withContent returns a copy of this line with the given content
+func (l sourceLine) withContent(content string) sourceLine {
+	l.content = content
+	return l
+}
+
+// readLines reads all the lines of the given reader, which contains the content of the given file
+func readLines(filename string, r io.Reader) ([]sourceLine, error) {
+	lines := []sourceLine{}
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), 1024*1024*1024)
+	// the number of bytes of the last scanned line, including its `\n` or `\r\n` end-of-line marker
+	// (which is dropped from the token returned by the scanner)
+	advance := 0
+	scanner.Split(func(data []byte, atEOF bool) (int, []byte, error) {
+		a, token, err := bufio.ScanLines(data, atEOF)
+		if token != nil {
+			advance = a
+		}
+		return a, token, err
+	})
+	offset := 0
+	for scanner.Scan() {
+		lines = append(lines, sourceLine{
+			content: scanner.Text(),
+			file:    filename,
+			number:  len(lines) + 1,
+			offset:  offset,
+		})
+		offset += advance
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return lines, nil
+}
+
+// processor the preprocessor of a document and its included files
+type processor struct {
+	ctx *renderer.Context
+	// attributes the attributes declared so far, used to evaluate the conditional directives
+	attributes map[string]string
+	// baseDir the directory of the root document, which contains the files that can be included in the `safe` mode (and higher)
+	baseDir string
+}
+
+// process processes the directives in the given lines, which were read from the given filename
+// at the given depth of inclusion, and with the given level offset to apply on the section titles
+func (p processor) process(filename string, lines []sourceLine, depth, levelOffset int) ([]sourceLine, error) {
+	result := make([]sourceLine, 0, len(lines))
+	var delimiter string // the delimiter of the current block, if any
+	conditions := []bool{} // the results of the enclosing conditional directives
+	for _, l := range lines {
+		line := l.content
+		if delimiter != commentBlockDelimiter {
+			if d, ok := newConditionalDirective(line); ok {
+				if d.kind == endif {
+					if len(conditions) == 0 {
+						p.ctx.Diagnostics().Warnf("unmatched-conditional", l.location(), "unmatched conditional directive in '%s': '%s'", filename, line)
+					} else {
+						conditions = conditions[:len(conditions)-1]
+					}
+					continue
+				}
+				selected := !skipping(conditions) && p.evaluate(d, l.location())
+				if d.content == "" || d.kind == ifeval {
+					conditions = append(conditions, selected)
+					continue
+				}
+				// single-line directive
+				if !selected {
+					continue
+				}
+				line = d.content
+			}
+		}
+		if skipping(conditions) {
+			continue
+		}
+		if delimiter == "" && isBlockDelimiter(line) {
+			delimiter = line
+		} else if line == delimiter {
+			delimiter = ""
+		}
+		if delimiter == commentBlockDelimiter {
+			result = append(result, l.withContent(line))
+			continue
+		}
+		if strings.HasPrefix(line, `\`) && (includeDirectiveRegexp.MatchString(line[1:]) || conditionalDirectiveRegexp.MatchString(line[1:])) {
+			// escaped directive
+			result = append(result, l.withContent(line[1:]))
+			continue
+		}
+		if d, ok := p.newIncludeDirective(l.withContent(line)); ok {
+			included, err := p.include(filename, d, depth, levelOffset)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, included...)
+			continue
+		}
+		if delimiter == "" {
+			p.recordAttribute(line)
+			if levelOffset != 0 {
+				line = applyLevelOffset(line, levelOffset)
+			}
+		}
+		result = append(result, l.withContent(line))
+	}
+	if len(conditions) > 0 {
+		p.ctx.Diagnostics().Warnf("unterminated-conditional", types.Location{}, "%d unterminated conditional directive(s) in '%s'", len(conditions), filename)
+	}
+	return result, nil
+}
+
+// skipping returns true if one of the given conditions is false
+func skipping(conditions []bool) bool {
+	for _, c := range conditions {
+		if !c {
+			return true
+		}
+	}
+	return false
+}
+
+const commentBlockDelimiter = "////"
+
+var blockDelimiters = []string{"----", "....", "```", commentBlockDelimiter, "++++", "|==="}
+
+// isBlockDelimiter returns true if the given line is the delimiter of a block in which the section titles
+// and the comments must be left unchanged
+func isBlockDelimiter(line string) bool {
+	for _, d := range blockDelimiters {
+		if line == d {
+			return true
+		}
+	}
+	return false
+}
+
+// applyLevelOffset applies the given offset on the level of the given line if it is a section title
+func applyLevelOffset(line string, offset int) string {
+	level := 0
+	for level < len(line) && line[level] == '=' {
+		level++
+	}
+	if level == 0 || level >= len(line) || (line[level] != ' ' && line[level] != '\t') {
+		return line
+	}
+	newLevel := level + offset
+	if newLevel < 1 {
+		newLevel = 1
+	}
+	buff := bytes.NewBufferString(strings.Repeat("=", newLevel))
+	buff.WriteString(line[level:])
+	return buff.String()
+}
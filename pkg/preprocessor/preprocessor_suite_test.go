@@ -0,0 +1,15 @@
+package preprocessor_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+
+	_ "github.com/bytesparadise/libasciidoc/pkg/log"
+)
+
+func TestPreprocessor(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Preprocessor Suite")
+}
@@ -0,0 +1,110 @@
+package renderer
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	log "github.com/sirupsen/logrus"
+)
+
+// ReferenceCatalog the titles and the element references of a set of documents, indexed by the absolute path of their source file.
+// The catalog is used to resolve and check the cross references between these documents when they are rendered.
+type ReferenceCatalog map[string]CatalogEntry
+
+// CatalogEntry the title, the element references and the section numbers of a document in a ReferenceCatalog
+type CatalogEntry struct {
+	Title             *types.SectionTitle // the title of the document, or nil if it has none
+	ElementReferences types.ElementReferences
+	SectionNumbers    SectionNumbers
+}
+
+// NewReferenceCatalog returns a new, empty catalog
+func NewReferenceCatalog() ReferenceCatalog {
+	return ReferenceCatalog{}
+}
+
+// Add adds the title, the element references and the section numbers of the given document parsed from the given file
+func (c ReferenceCatalog) Add(filename string, doc types.Document) {
+	entry := CatalogEntry{
+		ElementReferences: doc.ElementReferences,
+		SectionNumbers:    NumberSections(doc),
+	}
+	if title, err := doc.Attributes.GetTitle(); err == nil && len(title.Content) > 0 {
+		entry.Title = &title
+	}
+	c[catalogKey(filename)] = entry
+}
+
+// Lookup returns the entry of the document parsed from the given file
+func (c ReferenceCatalog) Lookup(filename string) (CatalogEntry, bool) {
+	entry, found := c[catalogKey(filename)]
+	return entry, found
+}
+
+func catalogKey(filename string) string {
+	path, err := filepath.Abs(filename)
+	if err != nil {
+		return filepath.Clean(filename)
+	}
+	return path
+}
+
+// TargetDocument returns the path of the document targeted by the given cross reference, relative to the current directory
+// (i.e., the path of the cross reference resolved against the directory of the document being rendered)
+func (ctx *Context) TargetDocument(xref types.CrossReference) string {
+	if filepath.IsAbs(xref.Path) {
+		return xref.Path
+	}
+	return filepath.Join(filepath.Dir(ctx.Filename()), filepath.FromSlash(xref.Path))
+}
+
+// IsCurrentDocument returns true if the given cross reference targets the document being rendered (eg: `<<doc.adoc#id>>` in `doc.adoc`)
+func (ctx *Context) IsCurrentDocument(xref types.CrossReference) bool {
+	return ctx.Filename() != "" && catalogKey(ctx.TargetDocument(xref)) == catalogKey(ctx.Filename())
+}
+
+// ResolveCrossReference returns the target of the given cross reference to another document, i.e., the referenced element
+// or the title of the document if the cross reference has no ID, or nil if the target is unknown or has no title,
+// along with the section numbers of the target document.
+// The cross references can only be resolved and checked if the context has a catalog (see the `References` option),
+// in which case a `dangling-xref` diagnostic is reported when the target document or element is unknown.
+func (ctx *Context) ResolveCrossReference(xref types.CrossReference) (interface{}, SectionNumbers) {
+	catalog := ctx.References()
+	if catalog == nil {
+		return nil, nil
+	}
+	entry, found := catalog.Lookup(ctx.TargetDocument(xref))
+	if !found {
+		ctx.Diagnostics().Warnf("dangling-xref", xref.Location, "unable to resolve cross reference to unknown document '%s'", xref.Path)
+		return nil, nil
+	}
+	if xref.ID == "" {
+		if entry.Title == nil {
+			return nil, entry.SectionNumbers
+		}
+		return *entry.Title, entry.SectionNumbers
+	}
+	target, found := entry.ElementReferences[xref.ID]
+	if !found {
+		ctx.Diagnostics().Warnf("dangling-xref", xref.Location, "unable to resolve cross reference to '%s' in document '%s'", xref.ID, xref.Path)
+		return nil, entry.SectionNumbers
+	}
+	return target, entry.SectionNumbers
+}
+
+// OutputPath returns the path of the output file of the document targeted by the given cross reference, i.e., its path with the
+// given extension (eg: `.html`) instead of the Asciidoc one, followed by the `#` and ID of the target element, if any.
+// The path remains relative to the current document, so it can be used as a link between the output files.
+func OutputPath(xref types.CrossReference, extension string) string {
+	path := xref.Path
+	if types.IsAsciidocFile(path) {
+		path = strings.TrimSuffix(path, filepath.Ext(path))
+	}
+	path += extension
+	if xref.ID != "" {
+		path += "#" + xref.ID
+	}
+	log.Debugf("output path of cross reference to '%s#%s': %s", xref.Path, xref.ID, path)
+	return path
+}
@@ -98,6 +98,104 @@ func (ctx *Context) WithinList() bool {
 	return false
 }
 
+const tableCounter string = "tableCounter"
+
+// GetAndIncrementTableCounter returns the current value for the table counter after internally incrementing it.
+// The first returned value is `1`.
+func (ctx *Context) GetAndIncrementTableCounter() int {
+	return ctx.getAndIncrementCounter(tableCounter)
+}
+
+const figureCounter string = "figureCounter"
+
+// GetAndIncrementFigureCounter returns the current value for the figure counter after internally incrementing it.
+// The first returned value is `1`.
+func (ctx *Context) GetAndIncrementFigureCounter() int {
+	return ctx.getAndIncrementCounter(figureCounter)
+}
+
+const footnoteCounter string = "footnoteCounter"
+
+// GetAndIncrementFootnoteCounter returns the current value for the footnote counter after internally incrementing it.
+// The first returned value is `1`.
+func (ctx *Context) GetAndIncrementFootnoteCounter() int {
+	return ctx.getAndIncrementCounter(footnoteCounter)
+}
+
+// FootnoteCounter returns the current value for the footnote counter, i.e., the number of footnotes rendered so far
+func (ctx *Context) FootnoteCounter() int {
+	counter, _ := ctx.options[footnoteCounter].(int)
+	return counter
+}
+
+const calloutBlockCounter string = "calloutBlockCounter"
+
+// GetAndIncrementCalloutBlockCounter returns the current value for the counter of blocks with callouts after internally incrementing it.
+// The first returned value is `1`.
+func (ctx *Context) GetAndIncrementCalloutBlockCounter() int {
+	return ctx.getAndIncrementCounter(calloutBlockCounter)
+}
+
+const calloutRefs string = "calloutRefs"
+
+// SetCalloutRefs sets the IDs of the callouts in the last rendered block, indexed by their reference number
+func (ctx *Context) SetCalloutRefs(refs map[int][]string) {
+	ctx.options[calloutRefs] = refs
+}
+
+// CalloutRefs returns the IDs of the callouts in the last rendered block, indexed by their reference number
+func (ctx *Context) CalloutRefs() map[int][]string {
+	refs, _ := ctx.options[calloutRefs].(map[int][]string)
+	return refs
+}
+
+const sourceMap string = "sourceMap"
+
+// SetSourceMap sets the origins of the lines of the preprocessed content of the document
+func (ctx *Context) SetSourceMap(m types.SourceMap) {
+	ctx.options[sourceMap] = m
+}
+
+// SourceMap returns the origins of the lines of the preprocessed content of the document (or nil if the
+// content was not preprocessed)
+func (ctx *Context) SourceMap() types.SourceMap {
+	m, _ := ctx.options[sourceMap].(types.SourceMap)
+	return m
+}
+
+const filename string = "filename"
+
+// SetFilename sets the name of the file from which the document was read
+func (ctx *Context) SetFilename(name string) {
+	ctx.options[filename] = name
+}
+
+// Filename returns the name of the file from which the document was read (or an empty string if it was read from another source)
+func (ctx *Context) Filename() string {
+	name, _ := ctx.options[filename].(string)
+	return name
+}
+
+const diagnostics string = "diagnostics"
+
+// Diagnostics returns the collector of the diagnostics reported while processing the document
+func (ctx *Context) Diagnostics() *types.Diagnostics {
+	d, found := ctx.options[diagnostics].(*types.Diagnostics)
+	if !found {
+		d = &types.Diagnostics{}
+		ctx.options[diagnostics] = d
+	}
+	return d
+}
+
+// getAndIncrementCounter returns the current value for the counter with the given name after internally incrementing it.
+func (ctx *Context) getAndIncrementCounter(name string) int {
+	counter, _ := ctx.options[name].(int)
+	counter++
+	ctx.options[name] = counter
+	return counter
+}
+
 // Deadline wrapper implementation of context.Context.Deadline()
 func (ctx *Context) Deadline() (deadline time.Time, ok bool) {
 	return ctx.context.Deadline()
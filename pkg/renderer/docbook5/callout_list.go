@@ -0,0 +1,63 @@
+package docbook5
+
+import (
+	"bytes"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+)
+
+var calloutListTmpl texttemplate.Template
+
+// initializes the templates
+func init() {
+	calloutListTmpl = newTextTemplate("callout list",
+		`{{ $ctx := .Context }}{{ with .Data }}<calloutlist{{ if .ID }} xml:id="{{ html .ID }}"{{ end }}>{{ if .Title }}
+<title>{{ .Title }}</title>{{ end }}{{ range .Items }}
+<callout arearefs="{{ .AreaRefs }}">
+{{ renderContent $ctx .Elements }}
+</callout>{{ end }}
+</calloutlist>{{ end }}`,
+		texttemplate.FuncMap{
+			"renderContent": renderContent,
+		})
+}
+
+type calloutListItem struct {
+	AreaRefs string
+	Elements []interface{}
+}
+
+func renderCalloutList(ctx *renderer.Context, l types.CalloutList) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	refs := ctx.CalloutRefs()
+	items := make([]calloutListItem, len(l.Items))
+	for i, item := range l.Items {
+		if _, found := refs[item.Ref]; !found {
+			ctx.Diagnostics().Warnf("unknown-callout", item.Location, "no callout found for the item #%d of the callout list", item.Ref)
+		}
+		items[i] = calloutListItem{
+			AreaRefs: strings.Join(refs[item.Ref], " "),
+			Elements: item.Elements,
+		}
+	}
+	err := calloutListTmpl.Execute(result, ContextualPipeline{
+		Context: ctx,
+		Data: struct {
+			ID    string
+			Title string
+			Items []calloutListItem
+		}{
+			ID:    getID(l.Attributes),
+			Title: escape(getTitle(l.Attributes)),
+			Items: items,
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render callout list")
+	}
+	return result.Bytes(), nil
+}
@@ -0,0 +1,37 @@
+package docbook5_test
+
+import . "github.com/onsi/ginkgo"
+
+var _ = Describe("callout lists", func() {
+
+	It("source block with callouts", func() {
+		actualContent := `[source,go]
+----
+import "fmt" // <1>
+
+func main() { // <2> <3>
+    fmt.Println("hello") // <2>
+}
+----
+<1> the import
+<2> the function
+<3> the main one`
+		expectedResult := `<programlisting language="go" linenumbering="unnumbered">import "fmt" // <co xml:id="CO1-1"/>
+
+func main() { // <co xml:id="CO1-2"/> <co xml:id="CO1-3"/>
+    fmt.Println("hello") // <co xml:id="CO1-4"/>
+}</programlisting>
+<calloutlist>
+<callout arearefs="CO1-1">
+<simpara>the import</simpara>
+</callout>
+<callout arearefs="CO1-2 CO1-4">
+<simpara>the function</simpara>
+</callout>
+<callout arearefs="CO1-3">
+<simpara>the main one</simpara>
+</callout>
+</calloutlist>`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+})
@@ -0,0 +1,52 @@
+package docbook5
+
+import (
+	"fmt"
+	"html"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+)
+
+// renderCrossReference renders the cross reference as an `xref` element, whose text is generated
+// by the DocBook toolchain from the target, or as a `link` element if the cross reference has a custom label
+func renderCrossReference(ctx *renderer.Context, xref types.CrossReference) ([]byte, error) {
+	if xref.IsInterDocument() && !ctx.IsCurrentDocument(xref) {
+		return renderInterDocumentCrossReference(ctx, xref)
+	}
+	if _, found := ctx.Document.ElementReferences[xref.ID]; !found {
+		ctx.Diagnostics().Warnf("dangling-xref", xref.Location, "unable to resolve cross reference to '%s'", xref.ID)
+	}
+	if xref.Label != "" {
+		return []byte(fmt.Sprintf(`<link linkend="%s">%s</link>`, html.EscapeString(xref.ID), escape(xref.Label))), nil
+	}
+	return []byte(fmt.Sprintf(`<xref linkend="%s"/>`, html.EscapeString(xref.ID))), nil
+}
+
+// renderInterDocumentCrossReference renders the cross reference to another document as a `link` element targeting
+// its DocBook output. The default text is the title of the target, if it is known from the catalog of the context,
+// or the path of the link otherwise.
+func renderInterDocumentCrossReference(ctx *renderer.Context, xref types.CrossReference) ([]byte, error) {
+	href := renderer.OutputPath(xref, ".xml")
+	text := escape(href)
+	target, _ := ctx.ResolveCrossReference(xref)
+	if xref.Label != "" {
+		text = escape(xref.Label)
+	} else if t, ok := target.(types.SectionTitle); ok {
+		content, err := renderElement(ctx, t.Content)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error while rendering cross reference")
+		}
+		text = string(content)
+	}
+	return []byte(fmt.Sprintf(`<link xl:href="%s">%s</link>`, html.EscapeString(href), text)), nil
+}
+
+// renderInlineAnchor renders the inline anchor as an `anchor` element, with its label as the text of the cross references
+func renderInlineAnchor(ctx *renderer.Context, a types.InlineAnchor) ([]byte, error) {
+	if a.Label != "" {
+		return []byte(fmt.Sprintf(`<anchor xml:id="%s" xreflabel="%s"/>`, html.EscapeString(a.ID), html.EscapeString(a.Label))), nil
+	}
+	return []byte(fmt.Sprintf(`<anchor xml:id="%s"/>`, html.EscapeString(a.ID))), nil
+}
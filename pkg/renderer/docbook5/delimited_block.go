@@ -0,0 +1,245 @@
+package docbook5
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+var listingBlockTmpl texttemplate.Template
+var exampleBlockTmpl texttemplate.Template
+var quoteBlockTmpl texttemplate.Template
+var sidebarBlockTmpl texttemplate.Template
+var literalBlockTmpl texttemplate.Template
+
+// initializes the templates
+func init() {
+	listingBlockTmpl = newTextTemplate("listing block",
+		`{{ if .Title }}<formalpara{{ if .ID }} xml:id="{{ html .ID }}"{{ end }}>
+<title>{{ .Title }}</title>
+<para>
+{{ template "listing" . }}
+</para>
+</formalpara>{{ else }}{{ template "listing" . }}{{ end }}{{ define "listing" }}{{ if .Language }}<programlisting{{ if and .ID (not .Title) }} xml:id="{{ html .ID }}"{{ end }} language="{{ html .Language }}" linenumbering="unnumbered">{{ .Content }}</programlisting>{{ else }}<screen{{ if and .ID (not .Title) }} xml:id="{{ html .ID }}"{{ end }}>{{ .Content }}</screen>{{ end }}{{ end }}`)
+	exampleBlockTmpl = newTextTemplate("example block",
+		`{{ if .Title }}<example{{ if .ID }} xml:id="{{ html .ID }}"{{ end }}>
+<title>{{ .Title }}</title>{{ else }}<informalexample{{ if .ID }} xml:id="{{ html .ID }}"{{ end }}>{{ end }}{{ if .Content }}
+{{ .Content }}{{ end }}
+</{{ if .Title }}example{{ else }}informalexample{{ end }}>`)
+	quoteBlockTmpl = newTextTemplate("quote block",
+		`<blockquote{{ if .ID }} xml:id="{{ html .ID }}"{{ end }}>{{ if .Title }}
+<title>{{ .Title }}</title>{{ end }}{{ if or .Author .CiteTitle }}
+<attribution>{{ if .Author }}
+{{ .Author }}{{ end }}{{ if .CiteTitle }}
+<citetitle>{{ .CiteTitle }}</citetitle>{{ end }}
+</attribution>{{ end }}{{ if .Content }}
+{{ .Content }}{{ end }}
+</blockquote>`)
+	sidebarBlockTmpl = newTextTemplate("sidebar block",
+		`<sidebar{{ if .ID }} xml:id="{{ html .ID }}"{{ end }}>{{ if .Title }}
+<title>{{ .Title }}</title>{{ end }}{{ if .Content }}
+{{ .Content }}{{ end }}
+</sidebar>`)
+	literalBlockTmpl = newTextTemplate("literal block",
+		`<literallayout class="monospaced">{{ . }}</literallayout>`)
+}
+
+// block the data of a delimited block, for the templates
+type block struct {
+	ID        string
+	Title     string
+	Language  string
+	Author    string
+	CiteTitle string
+	Content   string
+}
+
+func newBlock(attributes map[string]interface{}, content string) block {
+	return block{
+		ID:      getID(attributes),
+		Title:   escape(getTitle(attributes)),
+		Content: content,
+	}
+}
+
+func renderDelimitedBlock(ctx *renderer.Context, b types.DelimitedBlock) ([]byte, error) {
+	log.Debugf("rendering delimited block")
+	result := bytes.NewBuffer(nil)
+	var content []byte
+	var err error
+	elements := discardTrailingBlankLines(b.Elements)
+	kind := b.Attributes[types.AttrBlockKind]
+	switch kind {
+	case types.Fenced, types.Listing, types.Source:
+		var listing string
+		if isVerbatim(elements) {
+			listing = renderVerbatimLines(ctx, elements)
+		} else {
+			listing, err = renderListingContent(ctx, elements)
+			if err != nil {
+				return nil, errors.Wrapf(err, "unable to render delimited block")
+			}
+		}
+		data := newBlock(b.Attributes, listing)
+		if kind == types.Source {
+			data.Language, _ = b.Attributes[types.AttrLanguage].(string)
+		}
+		err = listingBlockTmpl.Execute(result, data)
+	case types.Example:
+		content, err = renderElements(ctx, elements)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render delimited block")
+		}
+		data := newBlock(b.Attributes, string(content))
+		if k, ok := b.Attributes[types.AttrAdmonitionKind].(types.AdmonitionKind); ok {
+			err = renderAdmonition(result, k, data.ID, data.Title, data.Content)
+		} else {
+			err = exampleBlockTmpl.Execute(result, data)
+		}
+	case types.Verse:
+		if len(elements) > 0 {
+			if p, ok := elements[0].(types.Paragraph); ok {
+				content, err = renderLines(ctx, p.Lines)
+				if err != nil {
+					return nil, errors.Wrapf(err, "unable to render delimited block")
+				}
+			}
+		}
+		data := newBlock(b.Attributes, fmt.Sprintf("<literallayout>%s</literallayout>", content))
+		data.Author, _ = b.Attributes[types.AttrVerseAuthor].(string)
+		data.CiteTitle, _ = b.Attributes[types.AttrVerseTitle].(string)
+		data.Author, data.CiteTitle = escape(data.Author), escape(data.CiteTitle)
+		err = quoteBlockTmpl.Execute(result, data)
+	case types.Quote:
+		content, err = renderElements(ctx, elements)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render delimited block")
+		}
+		data := newBlock(b.Attributes, string(content))
+		data.Author, _ = b.Attributes[types.AttrQuoteAuthor].(string)
+		data.CiteTitle, _ = b.Attributes[types.AttrQuoteTitle].(string)
+		data.Author, data.CiteTitle = escape(data.Author), escape(data.CiteTitle)
+		err = quoteBlockTmpl.Execute(result, data)
+	case types.Sidebar:
+		content, err = renderElements(ctx, elements)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render delimited block")
+		}
+		err = sidebarBlockTmpl.Execute(result, newBlock(b.Attributes, string(content)))
+	case types.Open:
+		// DocBook has no equivalent for open blocks, so only their content is rendered
+		content, err = renderElements(ctx, elements)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render delimited block")
+		}
+		result.Write(content)
+	case types.PassthroughBlock:
+		lines := make([]string, 0, len(elements))
+		for _, e := range elements {
+			if s, ok := e.(types.StringElement); ok {
+				if ctx.SafeMode() >= renderer.SafeModeServer {
+					// the safe mode does not allow raw content in the output
+					lines = append(lines, escape(s.Content))
+				} else {
+					// the content of the passthrough blocks is not escaped
+					lines = append(lines, s.Content)
+				}
+			}
+		}
+		result.WriteString(strings.Trim(strings.Join(lines, "\n"), "\n"))
+	case types.Comment:
+	default:
+		err = errors.Errorf("no template for block of kind %v", kind)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render delimited block")
+	}
+	return result.Bytes(), nil
+}
+
+func renderLiteralBlock(ctx *renderer.Context, b types.LiteralBlock) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	err := literalBlockTmpl.Execute(result, escape(b.Content))
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render literal block")
+	}
+	return result.Bytes(), nil
+}
+
+func renderListingContent(ctx *renderer.Context, elements []interface{}) (string, error) {
+	buff := bytes.NewBuffer(nil)
+	for _, e := range elements {
+		s, err := renderPlainString(ctx, e)
+		if err != nil {
+			return "", errors.Wrapf(err, "unable to render listing content")
+		}
+		buff.WriteString(s)
+	}
+	return buff.String(), nil
+}
+
+func isVerbatim(elements []interface{}) bool {
+	if len(elements) == 0 {
+		return false
+	}
+	_, ok := elements[0].(types.VerbatimLine)
+	return ok
+}
+
+// renderVerbatimLines renders the given lines, without their leading and trailing blank lines.
+// Each callout is rendered with a unique ID, which is kept in the context for the callout list that follows the block.
+func renderVerbatimLines(ctx *renderer.Context, elements []interface{}) string {
+	lines := make([]types.VerbatimLine, 0, len(elements))
+	for _, e := range elements {
+		if l, ok := e.(types.VerbatimLine); ok {
+			lines = append(lines, l)
+		}
+	}
+	for len(lines) > 0 && strings.TrimSpace(lines[0].Content) == "" && len(lines[0].Callouts) == 0 {
+		lines = lines[1:]
+	}
+	for len(lines) > 0 && strings.TrimSpace(lines[len(lines)-1].Content) == "" && len(lines[len(lines)-1].Callouts) == 0 {
+		lines = lines[:len(lines)-1]
+	}
+	if types.HasCallouts(elements) {
+		ctx.SetCalloutRefs(map[int][]string{})
+	}
+	var blockNumber, calloutNumber int
+	buff := bytes.NewBuffer(nil)
+	for i, l := range lines {
+		if i > 0 {
+			buff.WriteString("\n")
+		}
+		buff.WriteString(escape(l.Content))
+		for j, c := range l.Callouts {
+			if blockNumber == 0 {
+				blockNumber = ctx.GetAndIncrementCalloutBlockCounter()
+			}
+			calloutNumber++
+			id := fmt.Sprintf("CO%d-%d", blockNumber, calloutNumber)
+			ctx.CalloutRefs()[c.Ref] = append(ctx.CalloutRefs()[c.Ref], id)
+			if j > 0 {
+				buff.WriteString(" ")
+			}
+			buff.WriteString(fmt.Sprintf(`<co xml:id="%s"/>`, id))
+		}
+	}
+	return buff.String()
+}
+
+func discardTrailingBlankLines(elements []interface{}) []interface{} {
+	result := elements
+	for len(result) > 0 {
+		if _, ok := result[len(result)-1].(types.BlankLine); !ok {
+			break
+		}
+		result = result[:len(result)-1]
+	}
+	return result
+}
@@ -0,0 +1,128 @@
+package docbook5_test
+
+import . "github.com/onsi/ginkgo"
+
+var _ = Describe("delimited blocks", func() {
+
+	It("listing block with title", func() {
+		actualContent := `.a title
+----
+some <code>
+----`
+		expectedResult := `<formalpara>
+<title>a title</title>
+<para>
+<screen>some &lt;code&gt;</screen>
+</para>
+</formalpara>`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+
+	It("literal block", func() {
+		actualContent := `....
+some literal content
+....`
+		expectedResult := `<literallayout class="monospaced">some literal content</literallayout>`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+
+	It("example block with title", func() {
+		actualContent := `.an example
+====
+some content
+====`
+		expectedResult := `<example>
+<title>an example</title>
+<simpara>some content</simpara>
+</example>`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+
+	It("example block without title", func() {
+		actualContent := `====
+some content
+====`
+		expectedResult := `<informalexample>
+<simpara>some content</simpara>
+</informalexample>`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+
+	It("admonition block", func() {
+		actualContent := `[NOTE]
+====
+some content
+====`
+		expectedResult := `<note>
+<simpara>some content</simpara>
+</note>`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+
+	It("quote block with attribution", func() {
+		actualContent := `[quote, John Doe, Quote Title]
+____
+some *quote* content
+____`
+		expectedResult := `<blockquote>
+<attribution>
+John Doe
+<citetitle>Quote Title</citetitle>
+</attribution>
+<simpara>some <emphasis role="strong">quote</emphasis> content</simpara>
+</blockquote>`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+
+	It("verse block", func() {
+		actualContent := `[verse, John Doe]
+____
+some verse
+content
+____`
+		expectedResult := `<blockquote>
+<attribution>
+John Doe
+</attribution>
+<literallayout>some verse
+content</literallayout>
+</blockquote>`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+
+	It("sidebar block with title", func() {
+		actualContent := `.a sidebar
+****
+some content
+****`
+		expectedResult := `<sidebar>
+<title>a sidebar</title>
+<simpara>some content</simpara>
+</sidebar>`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+
+	It("open block", func() {
+		actualContent := `--
+some content
+--`
+		expectedResult := `<simpara>some content</simpara>`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+
+	It("passthrough block", func() {
+		actualContent := `++++
+<phrase>raw</phrase>
+++++`
+		expectedResult := `<phrase>raw</phrase>`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+
+	It("comment block", func() {
+		actualContent := `////
+a comment
+////`
+		expectedResult := ``
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+})
@@ -0,0 +1,15 @@
+package docbook5_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+
+	_ "github.com/bytesparadise/libasciidoc/pkg/log"
+)
+
+func TestDocbook5(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "DocBook5 Suite")
+}
@@ -0,0 +1,162 @@
+package docbook5
+
+import (
+	"fmt"
+	"io"
+	texttemplate "text/template"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+var documentTmpl texttemplate.Template
+
+func init() {
+	documentTmpl = newTextTemplate("root document",
+		`<?xml version="1.0" encoding="UTF-8"?>{{ if .TOC }}
+<?asciidoc-toc?>{{ end }}
+<{{ .Root }} xmlns="http://docbook.org/ns/docbook" xmlns:xl="http://www.w3.org/1999/xlink" version="5.0" xml:lang="en">
+<info>{{ if .Title }}
+<title>{{ .Title }}</title>{{ end }}{{ if .Date }}
+<date>{{ .Date }}</date>{{ end }}{{ if eq (len .Authors) 1 }}{{ with index .Authors 0 }}
+{{ template "author" . }}{{ end }}{{ if .AuthorInitials }}
+<authorinitials>{{ .AuthorInitials }}</authorinitials>{{ end }}{{ else if .Authors }}
+<authorgroup>{{ range .Authors }}
+{{ template "author" . }}{{ end }}
+</authorgroup>{{ end }}{{ if .RevNumber }}
+<revhistory>
+<revision>
+<revnumber>{{ .RevNumber }}</revnumber>{{ if .Date }}
+<date>{{ .Date }}</date>{{ end }}{{ if .AuthorInitials }}
+<authorinitials>{{ .AuthorInitials }}</authorinitials>{{ end }}{{ if .RevRemark }}
+<revremark>{{ .RevRemark }}</revremark>{{ end }}
+</revision>
+</revhistory>{{ end }}
+</info>{{ if .Content }}
+{{ .Content }}{{ end }}
+</{{ .Root }}>{{ define "author" }}<author>
+<personname>{{ if .FirstName }}
+<firstname>{{ .FirstName }}</firstname>{{ end }}{{ if .MiddleName }}
+<othername>{{ .MiddleName }}</othername>{{ end }}{{ if .LastName }}
+<surname>{{ .LastName }}</surname>{{ end }}
+</personname>{{ if .Email }}
+<email>{{ .Email }}</email>{{ end }}
+</author>{{ end }}`)
+}
+
+type documentAuthor struct {
+	FirstName  string
+	MiddleName string
+	LastName   string
+	Email      string
+}
+
+func renderDocument(ctx *renderer.Context, output io.Writer) (map[string]interface{}, error) {
+	metadata := make(map[string]interface{})
+	renderedTitle, err := renderDocumentTitle(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render full document")
+	}
+	renderedElements, err := renderElements(ctx, ctx.Document.Elements)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render full document")
+	}
+	if ctx.IncludeHeaderFooter() {
+		log.Debugf("Rendering full document...")
+		_, toc := ctx.Document.Attributes["toc"]
+		err = documentTmpl.Execute(output, struct {
+			Root           string
+			TOC            bool
+			Title          string
+			Date           string
+			Authors        []documentAuthor
+			AuthorInitials string
+			RevNumber      string
+			RevRemark      string
+			Content        string
+		}{
+			Root:           rootElement(ctx),
+			TOC:            toc,
+			Title:          renderedTitle,
+			Date:           getAttribute(ctx, "revdate"),
+			Authors:        documentAuthors(ctx),
+			AuthorInitials: getAttribute(ctx, "authorinitials"),
+			RevNumber:      getAttribute(ctx, "revnumber"),
+			RevRemark:      getAttribute(ctx, "revremark"),
+			Content:        string(renderedElements),
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render full document")
+		}
+	} else {
+		output.Write(renderedElements)
+	}
+	// copy all document attributes, and override the title with its rendered value instead of the `types.Section` struct
+	for k, v := range ctx.Document.Attributes {
+		switch k {
+		case "doctitle":
+			metadata[k] = renderedTitle
+		default:
+			metadata[k] = v
+		}
+	}
+	return metadata, nil
+}
+
+// rootElement returns the name of the root element of the document, depending on its `doctype`
+func rootElement(ctx *renderer.Context) string {
+	if isBook(ctx) {
+		return "book"
+	}
+	return "article"
+}
+
+// isBook returns true if the `doctype` attribute of the document is `book`
+func isBook(ctx *renderer.Context) bool {
+	return ctx.Document.Attributes.GetDocType() == types.DocTypeBook
+}
+
+// getAttribute returns the XML-escaped value of the document attribute with the given name, or an empty string
+func getAttribute(ctx *renderer.Context, name string) string {
+	value, _ := ctx.Document.Attributes[name].(string)
+	return escape(value)
+}
+
+// documentAuthors returns the authors declared in the document header
+func documentAuthors(ctx *renderer.Context) []documentAuthor {
+	result := []documentAuthor{}
+	for i := 1; ; i++ {
+		suffix := ""
+		if i > 1 {
+			suffix = fmt.Sprintf("_%d", i)
+		}
+		author := documentAuthor{
+			FirstName:  getAttribute(ctx, "firstname"+suffix),
+			MiddleName: getAttribute(ctx, "middlename"+suffix),
+			LastName:   getAttribute(ctx, "lastname"+suffix),
+			Email:      getAttribute(ctx, "email"+suffix),
+		}
+		if author.FirstName == "" && author.LastName == "" {
+			return result
+		}
+		result = append(result, author)
+	}
+}
+
+// renderDocumentTitle renders the document title
+func renderDocumentTitle(ctx *renderer.Context) (string, error) {
+	documentTitle, err := ctx.Document.Attributes.GetTitle()
+	if err != nil {
+		return "", errors.Wrapf(err, "unable to render document title")
+	}
+	if _, found := documentTitle.Attributes[types.AttrID]; found { // ignore if no ID was set, ie, title is not defined
+		title, err := renderPlainString(ctx, documentTitle)
+		if err != nil {
+			return "", errors.Wrapf(err, "unable to render document title")
+		}
+		return title, nil
+	}
+	return "", nil
+}
@@ -0,0 +1,118 @@
+package docbook5_test
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	. "github.com/onsi/ginkgo"
+)
+
+var _ = Describe("documents", func() {
+
+	It("article with header", func() {
+		actualContent := `= The Title
+Kismet R. Lee <kismet@asciidoctor.org>
+v1.0, 2019-01-01: First draft
+
+a paragraph`
+		expectedResult := `<?xml version="1.0" encoding="UTF-8"?>
+<article xmlns="http://docbook.org/ns/docbook" xmlns:xl="http://www.w3.org/1999/xlink" version="5.0" xml:lang="en">
+<info>
+<title>The Title</title>
+<date>2019-01-01</date>
+<author>
+<personname>
+<firstname>Kismet</firstname>
+<othername>R.</othername>
+<surname>Lee</surname>
+</personname>
+<email>kismet@asciidoctor.org</email>
+</author>
+<authorinitials>KRL</authorinitials>
+<revhistory>
+<revision>
+<revnumber>1.0</revnumber>
+<date>2019-01-01</date>
+<authorinitials>KRL</authorinitials>
+<revremark>First draft</revremark>
+</revision>
+</revhistory>
+</info>
+<simpara>a paragraph</simpara>
+</article>`
+		verify(GinkgoT(), expectedResult, actualContent, renderer.IncludeHeaderFooter(true))
+	})
+
+	It("article with multiple authors and a table of contents", func() {
+		actualContent := `= The Title
+Kismet Lee <kismet@asciidoctor.org>; Anne Smith <anne@asciidoctor.org>
+:toc:
+
+a paragraph`
+		expectedResult := `<?xml version="1.0" encoding="UTF-8"?>
+<?asciidoc-toc?>
+<article xmlns="http://docbook.org/ns/docbook" xmlns:xl="http://www.w3.org/1999/xlink" version="5.0" xml:lang="en">
+<info>
+<title>The Title</title>
+<authorgroup>
+<author>
+<personname>
+<firstname>Kismet</firstname>
+<surname>Lee</surname>
+</personname>
+<email>kismet@asciidoctor.org</email>
+</author>
+<author>
+<personname>
+<firstname>Anne</firstname>
+<surname>Smith</surname>
+</personname>
+<email>anne@asciidoctor.org</email>
+</author>
+</authorgroup>
+</info>
+<simpara>a paragraph</simpara>
+</article>`
+		verify(GinkgoT(), expectedResult, actualContent, renderer.IncludeHeaderFooter(true))
+	})
+
+	It("book with preamble and chapters", func() {
+		actualContent := `= The Book
+:doctype: book
+
+a preamble
+
+== Chapter One
+
+a paragraph
+
+=== Section A
+
+another paragraph`
+		expectedResult := `<?xml version="1.0" encoding="UTF-8"?>
+<book xmlns="http://docbook.org/ns/docbook" xmlns:xl="http://www.w3.org/1999/xlink" version="5.0" xml:lang="en">
+<info>
+<title>The Book</title>
+</info>
+<preface>
+<title></title>
+<simpara>a preamble</simpara>
+</preface>
+<chapter xml:id="_chapter_one">
+<title>Chapter One</title>
+<simpara>a paragraph</simpara>
+<section xml:id="_section_a">
+<title>Section A</title>
+<simpara>another paragraph</simpara>
+</section>
+</chapter>
+</book>`
+		verify(GinkgoT(), expectedResult, actualContent, renderer.IncludeHeaderFooter(true))
+	})
+
+	It("embedded document", func() {
+		actualContent := `= The Title
+
+a paragraph`
+		expectedResult := `<simpara>a paragraph</simpara>`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+})
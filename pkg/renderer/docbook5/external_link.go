@@ -0,0 +1,17 @@
+package docbook5
+
+import (
+	"fmt"
+	"html"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+)
+
+func renderLink(ctx *renderer.Context, l types.Link) ([]byte, error) {
+	text := l.Text()
+	if text == "" {
+		text = l.URL
+	}
+	return []byte(fmt.Sprintf(`<link xl:href="%s">%s</link>`, html.EscapeString(l.URL), escape(text))), nil
+}
@@ -0,0 +1,62 @@
+package docbook5
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	texttemplate "text/template"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+)
+
+var footnoteTmpl texttemplate.Template
+
+// initializes the templates
+func init() {
+	footnoteTmpl = newTextTemplate("footnote",
+		`<footnote{{ if .Ref }} xml:id="{{ html .Ref }}"{{ end }}><simpara>{{ .Content }}</simpara></footnote>`)
+}
+
+func renderFootnote(ctx *renderer.Context, f types.Footnote) ([]byte, error) {
+	ref := ""
+	if f.Ref != "" {
+		r, found := ctx.Document.FootnoteReferences[f.Ref]
+		if !found {
+			ctx.Diagnostics().Warnf("unresolved-footnote-ref", f.Location, "unable to resolve footnote reference '%s'", f.Ref)
+			return []byte(fmt.Sprintf("[%s]", escape(f.Ref))), nil
+		}
+		ref = footnoteID(f.Ref)
+		if r.ID <= ctx.FootnoteCounter() {
+			// the footnote was already rendered, so this is a reference to it
+			return []byte(fmt.Sprintf(`<footnoteref linkend="%s"/>`, html.EscapeString(ref))), nil
+		}
+		if len(f.Elements) == 0 {
+			// the footnote is defined later in the document, in which case the reference holds its content
+			f.Elements = r.Elements
+		}
+	}
+	ctx.GetAndIncrementFootnoteCounter()
+	content, err := renderInlineElements(ctx, f.Elements)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render footnote")
+	}
+	result := bytes.NewBuffer(nil)
+	err = footnoteTmpl.Execute(result, struct {
+		Ref     string
+		Content string
+	}{
+		Ref:     ref,
+		Content: string(content),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render footnote")
+	}
+	return result.Bytes(), nil
+}
+
+// footnoteID returns the ID of the footnote with the given reference
+func footnoteID(ref string) string {
+	return "_footnote_" + ref
+}
@@ -0,0 +1,95 @@
+package docbook5
+
+import (
+	"bytes"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+)
+
+var blockImageTmpl texttemplate.Template
+var inlineImageTmpl texttemplate.Template
+
+// imageObjectTmpl the `imageobject` and `textobject` elements, shared by the block and inline images.
+// The `scaledwidth` attribute takes precedence over the `width` attribute, since it applies to the printed formats.
+const imageObjectTmpl = `{{ define "image" }}<imageobject>
+<imagedata fileref="{{ html .Path }}"{{ if .Macro.ScaledWidth }} width="{{ html .Macro.ScaledWidth }}" scalefit="1"{{ else if .Macro.Width }} contentwidth="{{ html .Macro.Width }}"{{ end }}{{ if .Macro.Height }} contentdepth="{{ html .Macro.Height }}"{{ end }}{{ if .Macro.Align }} align="{{ html .Macro.Align }}"{{ end }}/>
+</imageobject>
+<textobject><phrase>{{ escape .Macro.Alt }}</phrase></textobject>{{ end }}`
+
+// imageObject the data of the `image` template
+type imageObject struct {
+	Path  string
+	Macro types.ImageMacro
+}
+
+// initializes the templates
+func init() {
+	blockImageTmpl = newTextTemplate("block image",
+		`{{ if .Title }}<figure{{ if .ID }} xml:id="{{ html .ID }}"{{ end }}>
+<title>{{ .Title }}</title>{{ else }}<informalfigure{{ if .ID }} xml:id="{{ html .ID }}"{{ end }}>{{ end }}
+<mediaobject>
+{{ template "image" .Image }}
+</mediaobject>
+</{{ if .Title }}figure{{ else }}informalfigure{{ end }}>`+imageObjectTmpl,
+		texttemplate.FuncMap{
+			"escape": escape,
+		})
+	inlineImageTmpl = newTextTemplate("inline image",
+		`<inlinemediaobject>
+{{ template "image" . }}
+</inlinemediaobject>`+imageObjectTmpl,
+		texttemplate.FuncMap{
+			"escape": escape,
+		})
+}
+
+func renderBlockImage(ctx *renderer.Context, img types.BlockImage) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	// the title of the block takes precedence over the `title` attribute of the macro
+	title := getTitle(img.Attributes)
+	if title == "" {
+		title = img.Macro.Title()
+	}
+	err := blockImageTmpl.Execute(result, struct {
+		ID    string
+		Title string
+		Image imageObject
+	}{
+		ID:    getID(img.Attributes),
+		Title: escape(title),
+		Image: imageObject{
+			Path:  imagePath(ctx, img.Macro.Path),
+			Macro: img.Macro,
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render block image")
+	}
+	return result.Bytes(), nil
+}
+
+func renderInlineImage(ctx *renderer.Context, img types.InlineImage) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	err := inlineImageTmpl.Execute(result, imageObject{
+		Path:  imagePath(ctx, img.Macro.Path),
+		Macro: img.Macro,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render inline image")
+	}
+	return result.Bytes(), nil
+}
+
+// imagePath returns the path of the image with the given target, relative to the `imagesdir` directory,
+// unless the target is an absolute path or a URL
+func imagePath(ctx *renderer.Context, target string) string {
+	dir := ctx.Document.Attributes.GetAsString("imagesdir")
+	if dir == nil || *dir == "" || strings.HasPrefix(target, "/") || strings.Contains(target, "://") || strings.HasPrefix(target, "data:") {
+		return target
+	}
+	return strings.TrimSuffix(*dir, "/") + "/" + target
+}
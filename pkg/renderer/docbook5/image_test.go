@@ -0,0 +1,62 @@
+package docbook5_test
+
+import . "github.com/onsi/ginkgo"
+
+var _ = Describe("images", func() {
+
+	It("block image with title and dimensions", func() {
+		actualContent := `[#img-foo]
+.A Foo
+image::images/foo.png[the foo, 600, 400]`
+		expectedResult := `<figure xml:id="img-foo">
+<title>A Foo</title>
+<mediaobject>
+<imageobject>
+<imagedata fileref="images/foo.png" contentwidth="600" contentdepth="400"/>
+</imageobject>
+<textobject><phrase>the foo</phrase></textobject>
+</mediaobject>
+</figure>`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+
+	It("block image without title", func() {
+		actualContent := `image::images/foo.png[]`
+		expectedResult := `<informalfigure>
+<mediaobject>
+<imageobject>
+<imagedata fileref="images/foo.png"/>
+</imageobject>
+<textobject><phrase>foo</phrase></textobject>
+</mediaobject>
+</informalfigure>`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+
+	It("block image with named attributes in images directory", func() {
+		actualContent := `:imagesdir: images
+
+image::foo.png[the foo, 600, 400, title=A Foo, scaledwidth=50%, align=center]`
+		expectedResult := `<figure>
+<title>A Foo</title>
+<mediaobject>
+<imageobject>
+<imagedata fileref="images/foo.png" width="50%" scalefit="1" contentdepth="400" align="center"/>
+</imageobject>
+<textobject><phrase>the foo</phrase></textobject>
+</mediaobject>
+</figure>`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+
+	It("inline image", func() {
+		actualContent := `an image:foo.png[the foo] here`
+		expectedResult := `<simpara>an <inlinemediaobject>
+<imageobject>
+<imagedata fileref="foo.png"/>
+</imageobject>
+<textobject><phrase>the foo</phrase></textobject>
+</inlinemediaobject> here</simpara>`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+})
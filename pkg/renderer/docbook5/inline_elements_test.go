@@ -0,0 +1,64 @@
+package docbook5_test
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	. "github.com/onsi/ginkgo"
+)
+
+var _ = Describe("inline elements", func() {
+
+	It("external links", func() {
+		actualContent := `see https://example.com[the site] or https://example.com?a=1&b=2`
+		expectedResult := `<simpara>see <link xl:href="https://example.com">the site</link> or <link xl:href="https://example.com?a=1&amp;b=2">https://example.com?a=1&amp;b=2</link></simpara>`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+
+	It("cross reference", func() {
+		actualContent := `== Section A
+
+see <<_section_a>>`
+		expectedResult := `<section xml:id="_section_a">
+<title>Section A</title>
+<simpara>see <xref linkend="_section_a"/></simpara>
+</section>`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+
+	It("cross reference with custom text and inline anchor", func() {
+		actualContent := `[[first,First]]one and anchor:second[]two, see <<first,the first one>> and xref:second[]`
+		expectedResult := `<simpara><anchor xml:id="first" xreflabel="First"/>one and <anchor xml:id="second"/>two, see <link linkend="first">the first one</link> and <xref linkend="second"/></simpara>`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+
+	It("cross reference to another document", func() {
+		actualContent := `see <<other.adoc#section_a,Section A>> and xref:other.adoc[]`
+		expectedResult := `<simpara>see <link xl:href="other.xml#section_a">Section A</link> and <link xl:href="other.xml">other.xml</link></simpara>`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+
+	It("footnotes", func() {
+		actualContent := `a note footnote:[the note] and a ref footnote:disclaimer[the disclaimer] again footnote:disclaimer[]`
+		expectedResult := `<simpara>a note <footnote><simpara>the note</simpara></footnote> and a ref <footnote xml:id="_footnote_disclaimer"><simpara>the disclaimer</simpara></footnote> again <footnoteref linkend="_footnote_disclaimer"/></simpara>`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+
+	It("passthroughs", func() {
+		actualContent := `+<b>escaped</b>+ and +++<b>raw</b>+++`
+		expectedResult := `<simpara>&lt;b&gt;escaped&lt;/b&gt; and <b>raw</b></simpara>`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+
+	It("passthroughs in server mode", func() {
+		actualContent := `+<b>escaped</b>+ and +++<b>raw</b>+++`
+		expectedResult := `<simpara>&lt;b&gt;escaped&lt;/b&gt; and &lt;b&gt;raw&lt;/b&gt;</simpara>`
+		verify(GinkgoT(), expectedResult, actualContent, renderer.Safe(renderer.SafeModeServer))
+	})
+
+	It("attribute substitution", func() {
+		actualContent := `:name: a & b
+
+the {name} value and {unknown}`
+		expectedResult := `<simpara>the a &amp; b value and {unknown}</simpara>`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+})
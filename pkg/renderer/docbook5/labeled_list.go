@@ -0,0 +1,51 @@
+package docbook5
+
+import (
+	"bytes"
+	texttemplate "text/template"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+)
+
+var labeledListTmpl texttemplate.Template
+
+// initializes the templates
+func init() {
+	labeledListTmpl = newTextTemplate("labeled list",
+		`{{ $ctx := .Context }}{{ with .Data }}<variablelist{{ if .ID }} xml:id="{{ html .ID }}"{{ end }}>{{ if .Title }}
+<title>{{ .Title }}</title>{{ end }}{{ range .Items }}
+<varlistentry>
+<term>{{ escape .Term }}</term>
+<listitem>{{ if .Elements }}
+{{ renderContent $ctx .Elements }}{{ else }}
+<simpara></simpara>{{ end }}
+</listitem>
+</varlistentry>{{ end }}
+</variablelist>{{ end }}`,
+		texttemplate.FuncMap{
+			"renderContent": renderContent,
+			"escape":        escape,
+		})
+}
+
+func renderLabeledList(ctx *renderer.Context, l types.LabeledList) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	err := labeledListTmpl.Execute(result, ContextualPipeline{
+		Context: ctx,
+		Data: struct {
+			ID    string
+			Title string
+			Items []types.LabeledListItem
+		}{
+			ID:    getID(l.Attributes),
+			Title: escape(getTitle(l.Attributes)),
+			Items: l.Items,
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render labeled list")
+	}
+	return result.Bytes(), nil
+}
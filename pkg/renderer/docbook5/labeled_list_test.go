@@ -0,0 +1,26 @@
+package docbook5_test
+
+import . "github.com/onsi/ginkgo"
+
+var _ = Describe("labeled lists", func() {
+
+	It("labeled list with and without description", func() {
+		actualContent := `Item 1::
+Item 2:: description 2`
+		expectedResult := `<variablelist>
+<varlistentry>
+<term>Item 1</term>
+<listitem>
+<simpara></simpara>
+</listitem>
+</varlistentry>
+<varlistentry>
+<term>Item 2</term>
+<listitem>
+<simpara>description 2</simpara>
+</listitem>
+</varlistentry>
+</variablelist>`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+})
@@ -0,0 +1,63 @@
+package docbook5
+
+import (
+	"bytes"
+	texttemplate "text/template"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+)
+
+var orderedListTmpl texttemplate.Template
+
+// initializes the templates
+func init() {
+	orderedListTmpl = newTextTemplate("ordered list",
+		`{{ $ctx := .Context }}{{ with .Data }}<orderedlist{{ if .ID }} xml:id="{{ html .ID }}"{{ end }} numeration="{{ .Numeration }}">{{ if .Title }}
+<title>{{ .Title }}</title>{{ end }}{{ range .Items }}
+<listitem>
+{{ renderContent $ctx .Elements }}
+</listitem>{{ end }}
+</orderedlist>{{ end }}`,
+		texttemplate.FuncMap{
+			"renderContent": renderContent,
+		})
+}
+
+func renderOrderedList(ctx *renderer.Context, l types.OrderedList) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	numeration := types.Arabic
+	if len(l.Items) > 0 {
+		numeration = l.Items[0].NumberingStyle
+	}
+	err := orderedListTmpl.Execute(result, ContextualPipeline{
+		Context: ctx,
+		Data: struct {
+			ID         string
+			Title      string
+			Numeration types.NumberingStyle
+			Items      []types.OrderedListItem
+		}{
+			ID:         getID(l.Attributes),
+			Title:      escape(getTitle(l.Attributes)),
+			Numeration: numerationOf(numeration),
+			Items:      l.Items,
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render ordered list")
+	}
+	return result.Bytes(), nil
+}
+
+// numerationOf returns the DocBook numeration for the given numbering style
+func numerationOf(s types.NumberingStyle) types.NumberingStyle {
+	switch s {
+	case types.LowerAlpha, types.UpperAlpha, types.LowerRoman, types.UpperRoman:
+		return s
+	default:
+		// DocBook has no equivalent for the other styles
+		return types.Arabic
+	}
+}
@@ -0,0 +1,28 @@
+package docbook5_test
+
+import . "github.com/onsi/ginkgo"
+
+var _ = Describe("ordered lists", func() {
+
+	It("ordered list with title and nested list", func() {
+		actualContent := `.Steps
+. item 1
+.. item 1.1
+. item 2`
+		expectedResult := `<orderedlist numeration="arabic">
+<title>Steps</title>
+<listitem>
+<simpara>item 1</simpara>
+<orderedlist numeration="loweralpha">
+<listitem>
+<simpara>item 1.1</simpara>
+</listitem>
+</orderedlist>
+</listitem>
+<listitem>
+<simpara>item 2</simpara>
+</listitem>
+</orderedlist>`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+})
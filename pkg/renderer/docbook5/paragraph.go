@@ -0,0 +1,84 @@
+package docbook5
+
+import (
+	"bytes"
+	texttemplate "text/template"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+)
+
+var paragraphTmpl texttemplate.Template
+var formalParagraphTmpl texttemplate.Template
+var admonitionTmpl texttemplate.Template
+
+// initializes the templates
+func init() {
+	paragraphTmpl = newTextTemplate("paragraph",
+		`<simpara{{ if .ID }} xml:id="{{ html .ID }}"{{ end }}>{{ .Content }}</simpara>`)
+	formalParagraphTmpl = newTextTemplate("formal paragraph",
+		`<formalpara{{ if .ID }} xml:id="{{ html .ID }}"{{ end }}>
+<title>{{ .Title }}</title>
+<para>{{ .Content }}</para>
+</formalpara>`)
+	admonitionTmpl = newTextTemplate("admonition",
+		`<{{ .Kind }}{{ if .ID }} xml:id="{{ html .ID }}"{{ end }}>{{ if .Title }}
+<title>{{ .Title }}</title>{{ end }}
+{{ .Content }}
+</{{ .Kind }}>`)
+}
+
+func renderParagraph(ctx *renderer.Context, p types.Paragraph) ([]byte, error) {
+	if len(p.Lines) == 0 {
+		return nil, nil
+	}
+	content, err := renderLines(ctx, p.Lines)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render paragraph")
+	}
+	if len(content) == 0 {
+		// eg: a paragraph with comment lines only
+		return nil, nil
+	}
+	result := bytes.NewBuffer(nil)
+	data := struct {
+		ID      string
+		Title   string
+		Content string
+	}{
+		ID:      getID(p.Attributes),
+		Title:   escape(getTitle(p.Attributes)),
+		Content: string(content),
+	}
+	if k, ok := p.Attributes[types.AttrAdmonitionKind].(types.AdmonitionKind); ok {
+		data.Content = "<simpara>" + data.Content + "</simpara>"
+		err = renderAdmonition(result, k, data.ID, data.Title, data.Content)
+	} else if data.Title != "" {
+		err = formalParagraphTmpl.Execute(result, data)
+	} else {
+		err = paragraphTmpl.Execute(result, data)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render paragraph")
+	}
+	return result.Bytes(), nil
+}
+
+// renderAdmonition renders an admonition of the given kind with the given (already rendered) content
+func renderAdmonition(result *bytes.Buffer, kind types.AdmonitionKind, id, title, content string) error {
+	if kind == types.Unknown {
+		return errors.New("failed to render admonition with unknown kind")
+	}
+	return admonitionTmpl.Execute(result, struct {
+		Kind    types.AdmonitionKind
+		ID      string
+		Title   string
+		Content string
+	}{
+		Kind:    kind,
+		ID:      id,
+		Title:   title,
+		Content: content,
+	})
+}
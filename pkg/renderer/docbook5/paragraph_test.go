@@ -0,0 +1,56 @@
+package docbook5_test
+
+import . "github.com/onsi/ginkgo"
+
+var _ = Describe("paragraphs", func() {
+
+	It("paragraph with multiple lines and special characters", func() {
+		actualContent := `[#foo]
+a paragraph with <special> & *bold*, _italic_
+and ` + "`monospace`" + ` content`
+		expectedResult := `<simpara xml:id="foo">a paragraph with &lt;special&gt; &amp; <emphasis role="strong">bold</emphasis>, <emphasis>italic</emphasis>
+and <literal>monospace</literal> content</simpara>`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+
+	It("paragraph with title", func() {
+		actualContent := `.a title
+a paragraph`
+		expectedResult := `<formalpara>
+<title>a title</title>
+<para>a paragraph</para>
+</formalpara>`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+
+	It("admonition paragraph", func() {
+		actualContent := `WARNING: watch out!`
+		expectedResult := `<warning>
+<simpara>watch out!</simpara>
+</warning>`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+
+	It("admonition paragraph with title", func() {
+		actualContent := `.Tip of the day
+[TIP]
+a tip`
+		expectedResult := `<tip>
+<title>Tip of the day</title>
+<simpara>a tip</simpara>
+</tip>`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+
+	It("paragraph with comment lines only", func() {
+		actualContent := `a paragraph
+
+// a comment
+// another comment
+
+another paragraph`
+		expectedResult := `<simpara>a paragraph</simpara>
+<simpara>another paragraph</simpara>`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+})
@@ -0,0 +1,31 @@
+package docbook5
+
+import (
+	"bytes"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+)
+
+func renderPassthrough(ctx *renderer.Context, p types.Passthrough) ([]byte, error) {
+	buff := bytes.NewBuffer(nil)
+	for _, element := range p.Elements {
+		switch element := element.(type) {
+		case types.StringElement:
+			if p.Kind == types.SinglePlusPassthrough || ctx.SafeMode() >= renderer.SafeModeServer {
+				// the content of a single plus passthrough is escaped (as well as all passthroughs in the `server` and `secure` safe modes)
+				buff.WriteString(escape(element.Content))
+			} else {
+				buff.WriteString(element.Content)
+			}
+		default:
+			renderedElement, err := renderElement(ctx, element)
+			if err != nil {
+				return nil, errors.Wrap(err, "unable to render passthrough")
+			}
+			buff.Write(renderedElement)
+		}
+	}
+	return buff.Bytes(), nil
+}
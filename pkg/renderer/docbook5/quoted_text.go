@@ -0,0 +1,26 @@
+package docbook5
+
+import (
+	"fmt"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+)
+
+func renderQuotedText(ctx *renderer.Context, t types.QuotedText) ([]byte, error) {
+	content, err := renderInlineElements(ctx, t.Elements)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render quoted text")
+	}
+	switch t.Kind {
+	case types.Bold:
+		return []byte(fmt.Sprintf(`<emphasis role="strong">%s</emphasis>`, content)), nil
+	case types.Italic:
+		return []byte(fmt.Sprintf(`<emphasis>%s</emphasis>`, content)), nil
+	case types.Monospace:
+		return []byte(fmt.Sprintf(`<literal>%s</literal>`, content)), nil
+	default:
+		return nil, errors.Errorf("unsupported quoted text kind: %v", t.Kind)
+	}
+}
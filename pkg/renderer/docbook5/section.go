@@ -0,0 +1,125 @@
+package docbook5
+
+import (
+	"bytes"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+var prefaceTmpl texttemplate.Template
+var sectionTmpl texttemplate.Template
+
+// initializes the templates
+func init() {
+	prefaceTmpl = newTextTemplate("preface",
+		`<preface>
+<title></title>
+{{ . }}
+</preface>`)
+	sectionTmpl = newTextTemplate("section",
+		`<{{ .Tag }} xml:id="{{ html .ID }}">
+<title>{{ .Title }}</title>{{ if .Intro }}
+<partintro>
+{{ .Intro }}
+</partintro>{{ end }}{{ if .Elements }}
+{{ .Elements }}{{ end }}
+</{{ .Tag }}>`)
+}
+
+func renderPreamble(ctx *renderer.Context, p types.Preamble) ([]byte, error) {
+	log.Debugf("Rendering preamble...")
+	renderedElements, err := renderElements(ctx, p.Elements)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render preamble")
+	}
+	if len(renderedElements) == 0 || !isBook(ctx) {
+		// in an article, the preamble has no dedicated element
+		return renderedElements, nil
+	}
+	result := bytes.NewBuffer(nil)
+	err = prefaceTmpl.Execute(result, string(renderedElements))
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render preamble")
+	}
+	return result.Bytes(), nil
+}
+
+func renderSection(ctx *renderer.Context, s types.Section) ([]byte, error) {
+	log.Debugf("Rendering section level %d", s.Level)
+	renderedTitle, err := renderInlineElements(ctx, s.Title.Content)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error while rendering section")
+	}
+	tag := sectionTag(ctx, s)
+	elements := s.Elements
+	var renderedIntro []byte
+	if tag == "part" {
+		// the blocks before the first chapter of a part are its introduction
+		intro := make([]interface{}, 0, len(elements))
+		for len(elements) > 0 {
+			if _, ok := elements[0].(types.Section); ok {
+				break
+			}
+			intro = append(intro, elements[0])
+			elements = elements[1:]
+		}
+		if renderedIntro, err = renderElements(ctx, intro); err != nil {
+			return nil, errors.Wrapf(err, "error while rendering section")
+		}
+	}
+	renderedElements, err := renderElements(ctx, elements)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error while rendering section")
+	}
+	result := bytes.NewBuffer(nil)
+	err = sectionTmpl.Execute(result, struct {
+		Tag      string
+		ID       string
+		Title    string
+		Intro    string
+		Elements string
+	}{
+		Tag:      tag,
+		ID:       getID(s.Title.Attributes),
+		Title:    strings.TrimSpace(string(renderedTitle)),
+		Intro:    string(renderedIntro),
+		Elements: string(renderedElements),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "error while rendering section")
+	}
+	return result.Bytes(), nil
+}
+
+// sectionTag returns the name of the element for the given section. In a book, the sections of level 0 are parts
+// and the sections of level 1 are chapters, unless they are special sections (eg: `[appendix]` or `[glossary]`).
+func sectionTag(ctx *renderer.Context, s types.Section) string {
+	if s.Level == 1 {
+		switch style := s.Title.Style(); style {
+		case types.SectionStyleAppendix, types.SectionStyleGlossary, types.SectionStyleBibliography, types.SectionStyleIndex:
+			return style
+		case types.SectionStylePreface, types.SectionStyleColophon, types.SectionStyleAbstract:
+			// these sections have no dedicated element in an article
+			if isBook(ctx) {
+				if style == types.SectionStyleAbstract {
+					return types.SectionStylePreface
+				}
+				return style
+			}
+		}
+	}
+	if isBook(ctx) {
+		switch s.Level {
+		case 0:
+			return "part"
+		case 1:
+			return "chapter"
+		}
+	}
+	return "section"
+}
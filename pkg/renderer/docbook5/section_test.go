@@ -0,0 +1,92 @@
+package docbook5_test
+
+import . "github.com/onsi/ginkgo"
+
+var _ = Describe("sections", func() {
+
+	It("nested sections", func() {
+		actualContent := `== Section A
+
+a paragraph with *bold content*
+
+=== Section A.a
+
+a paragraph
+
+== Section B`
+		expectedResult := `<section xml:id="_section_a">
+<title>Section A</title>
+<simpara>a paragraph with <emphasis role="strong">bold content</emphasis></simpara>
+<section xml:id="_section_a_a">
+<title>Section A.a</title>
+<simpara>a paragraph</simpara>
+</section>
+</section>
+<section xml:id="_section_b">
+<title>Section B</title>
+</section>`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+
+	It("section with custom ID and quoted text in title", func() {
+		actualContent := `[[custom]]
+== Section _A_ & B`
+		expectedResult := `<section xml:id="custom">
+<title>Section <emphasis>A</emphasis> &amp; B</title>
+</section>`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+	It("parts and special sections in a book", func() {
+		actualContent := `= The Book
+:doctype: book
+
+[preface]
+== Preface
+
+= The Part
+
+the introduction
+
+== The Chapter
+
+[appendix]
+== The Appendix
+
+[glossary]
+== The Glossary`
+		expectedResult := `<preface xml:id="_preface">
+<title>Preface</title>
+</preface>
+<part xml:id="_the_part">
+<title>The Part</title>
+<partintro>
+<simpara>the introduction</simpara>
+</partintro>
+<chapter xml:id="_the_chapter">
+<title>The Chapter</title>
+</chapter>
+<appendix xml:id="_the_appendix">
+<title>The Appendix</title>
+</appendix>
+<glossary xml:id="_the_glossary">
+<title>The Glossary</title>
+</glossary>
+</part>`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+
+	It("special sections in an article", func() {
+		actualContent := `[abstract]
+== The Abstract
+
+[appendix]
+== The Appendix`
+		expectedResult := `<section xml:id="_the_abstract">
+<title>The Abstract</title>
+</section>
+<appendix xml:id="_the_appendix">
+<title>The Appendix</title>
+</appendix>`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+})
@@ -0,0 +1,200 @@
+package docbook5
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+)
+
+var tableTmpl texttemplate.Template
+
+// initializes the templates
+func init() {
+	tableTmpl = newTextTemplate("table",
+		`<{{ .Tag }}{{ if .ID }} xml:id="{{ html .ID }}"{{ end }} frame="{{ .Frame }}" rowsep="{{ .RowSep }}" colsep="{{ .ColSep }}">{{ if .Title }}
+<title>{{ .Title }}</title>{{ end }}
+<tgroup cols="{{ len .Columns }}">{{ range $index, $column := .Columns }}
+<colspec colname="col_{{ inc $index }}" colwidth="{{ $column }}*"/>{{ end }}{{ if .Header }}
+<thead>{{ template "rows" .Header }}
+</thead>{{ end }}{{ if .Footer }}
+<tfoot>{{ template "rows" .Footer }}
+</tfoot>{{ end }}
+<tbody>{{ template "rows" .Rows }}
+</tbody>
+</tgroup>
+</{{ .Tag }}>{{ define "rows" }}{{ range . }}
+<row>{{ range . }}
+<entry align="{{ .HAlign }}" valign="{{ .VAlign }}"{{ if .NameStart }} namest="{{ .NameStart }}" nameend="{{ .NameEnd }}"{{ end }}{{ if .MoreRows }} morerows="{{ .MoreRows }}"{{ end }}>{{ .Content }}</entry>{{ end }}
+</row>{{ end }}{{ end }}`,
+		texttemplate.FuncMap{
+			"inc": func(i int) int {
+				return i + 1
+			},
+		})
+}
+
+type tableCell struct {
+	HAlign    types.HAlignment
+	VAlign    types.VAlignment
+	NameStart string
+	NameEnd   string
+	MoreRows  int
+	Content   string
+}
+
+// the DocBook frame values for the Asciidoctor `frame` attribute values
+var frames = map[string]string{
+	"all":    "all",
+	"topbot": "topbot",
+	"ends":   "topbot",
+	"sides":  "sides",
+	"none":   "none",
+}
+
+func renderTable(ctx *renderer.Context, t types.Table) ([]byte, error) {
+	title := escape(getTitle(t.Attributes))
+	tag := "informaltable"
+	if title != "" {
+		tag = "table"
+	}
+	frame := "all"
+	if f, ok := t.Attributes[types.AttrFrame].(string); ok && frames[f] != "" {
+		frame = frames[f]
+	}
+	rowSep, colSep := 1, 1
+	switch t.Attributes[types.AttrGrid] {
+	case "rows":
+		colSep = 0
+	case "cols":
+		rowSep = 0
+	case "none":
+		rowSep, colSep = 0, 0
+	}
+	columns := make([]int, len(t.Columns))
+	for i, c := range t.Columns {
+		columns[i] = c.Width
+		if c.AutoWidth || c.Width == 0 {
+			columns[i] = 1
+		}
+	}
+	header, err := renderTableRows(ctx, false, t.Header)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render table")
+	}
+	rows, err := renderTableRows(ctx, true, t.Rows...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render table")
+	}
+	footer, err := renderTableRows(ctx, true, t.Footer)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render table")
+	}
+	result := bytes.NewBuffer(nil)
+	err = tableTmpl.Execute(result, struct {
+		Tag     string
+		ID      string
+		Title   string
+		Frame   string
+		RowSep  int
+		ColSep  int
+		Columns []int
+		Header  [][]tableCell
+		Rows    [][]tableCell
+		Footer  [][]tableCell
+	}{
+		Tag:     tag,
+		ID:      getID(t.Attributes),
+		Title:   title,
+		Frame:   frame,
+		RowSep:  rowSep,
+		ColSep:  colSep,
+		Columns: columns,
+		Header:  header,
+		Rows:    rows,
+		Footer:  footer,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render table")
+	}
+	return result.Bytes(), nil
+}
+
+func renderTableRows(ctx *renderer.Context, paragraphs bool, rows ...types.TableRow) ([][]tableCell, error) {
+	result := make([][]tableCell, 0, len(rows))
+	for _, row := range rows {
+		if len(row.Cells) == 0 {
+			continue
+		}
+		cells := make([]tableCell, 0, len(row.Cells))
+		column := 1
+		for _, c := range row.Cells {
+			content, err := renderTableCellContent(ctx, c, paragraphs)
+			if err != nil {
+				return nil, errors.Wrapf(err, "unable to render table cell")
+			}
+			cell := tableCell{
+				HAlign:  c.HAlign,
+				VAlign:  c.VAlign,
+				Content: content,
+			}
+			if cell.HAlign == types.HAlignDefault {
+				cell.HAlign = types.HAlignLeft
+			}
+			if cell.VAlign == types.VAlignDefault {
+				cell.VAlign = types.VAlignTop
+			}
+			if c.ColSpan > 1 {
+				cell.NameStart = fmt.Sprintf("col_%d", column)
+				cell.NameEnd = fmt.Sprintf("col_%d", column+c.ColSpan-1)
+				column += c.ColSpan
+			} else {
+				column++
+			}
+			if c.RowSpan > 1 {
+				cell.MoreRows = c.RowSpan - 1
+			}
+			cells = append(cells, cell)
+		}
+		result = append(result, cells)
+	}
+	return result, nil
+}
+
+func renderTableCellContent(ctx *renderer.Context, c types.TableCell, paragraphs bool) (string, error) {
+	if len(c.Lines) == 0 {
+		return "", nil
+	}
+	if c.Style == types.LiteralCellStyle {
+		lines := make([]string, len(c.Lines))
+		for i, l := range c.Lines {
+			s, err := renderPlainString(ctx, l)
+			if err != nil {
+				return "", err
+			}
+			lines[i] = s
+		}
+		return fmt.Sprintf(`<literallayout class="monospaced">%s</literallayout>`, strings.Join(lines, "\n")), nil
+	}
+	content, err := renderLines(ctx, c.Lines)
+	if err != nil {
+		return "", err
+	}
+	text := string(content)
+	switch c.Style {
+	case types.EmphasisCellStyle:
+		text = fmt.Sprintf("<emphasis>%s</emphasis>", text)
+	case types.MonospaceCellStyle:
+		text = fmt.Sprintf("<literal>%s</literal>", text)
+	case types.StrongCellStyle:
+		text = fmt.Sprintf(`<emphasis role="strong">%s</emphasis>`, text)
+	}
+	if !paragraphs {
+		return text, nil
+	}
+	return fmt.Sprintf("<simpara>%s</simpara>", text), nil
+}
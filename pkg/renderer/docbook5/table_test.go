@@ -0,0 +1,40 @@
+package docbook5_test
+
+import . "github.com/onsi/ginkgo"
+
+var _ = Describe("tables", func() {
+
+	It("table with title, header and spans", func() {
+		actualContent := `.a table
+[cols="2,1", grid=rows]
+|===
+|Name |Value
+
+2+|spanning
+|a |b
+|===`
+		expectedResult := `<table frame="all" rowsep="1" colsep="0">
+<title>a table</title>
+<tgroup cols="2">
+<colspec colname="col_1" colwidth="2*"/>
+<colspec colname="col_2" colwidth="1*"/>
+<thead>
+<row>
+<entry align="left" valign="top">Name</entry>
+<entry align="left" valign="top">Value</entry>
+</row>
+</thead>
+<tbody>
+<row>
+<entry align="left" valign="top" namest="col_1" nameend="col_2"><simpara>spanning</simpara></entry>
+</row>
+<row>
+<entry align="left" valign="top"><simpara>a</simpara></entry>
+<entry align="left" valign="top"><simpara>b</simpara></entry>
+</row>
+</tbody>
+</tgroup>
+</table>`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+})
@@ -0,0 +1,46 @@
+package docbook5
+
+import (
+	"bytes"
+	texttemplate "text/template"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+)
+
+var unorderedListTmpl texttemplate.Template
+
+// initializes the templates
+func init() {
+	unorderedListTmpl = newTextTemplate("unordered list",
+		`{{ $ctx := .Context }}{{ with .Data }}<itemizedlist{{ if .ID }} xml:id="{{ html .ID }}"{{ end }}>{{ if .Title }}
+<title>{{ .Title }}</title>{{ end }}{{ range .Items }}
+<listitem>
+{{ renderContent $ctx .Elements }}
+</listitem>{{ end }}
+</itemizedlist>{{ end }}`,
+		texttemplate.FuncMap{
+			"renderContent": renderContent,
+		})
+}
+
+func renderUnorderedList(ctx *renderer.Context, l types.UnorderedList) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	err := unorderedListTmpl.Execute(result, ContextualPipeline{
+		Context: ctx,
+		Data: struct {
+			ID    string
+			Title string
+			Items []types.UnorderedListItem
+		}{
+			ID:    getID(l.Attributes),
+			Title: escape(getTitle(l.Attributes)),
+			Items: l.Items,
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render unordered list")
+	}
+	return result.Bytes(), nil
+}
@@ -0,0 +1,25 @@
+package docbook5_test
+
+import . "github.com/onsi/ginkgo"
+
+var _ = Describe("unordered lists", func() {
+
+	It("unordered list with ID and continuation", func() {
+		actualContent := `[#list]
+* item 1
++
+a paragraph
+
+* item 2`
+		expectedResult := `<itemizedlist xml:id="list">
+<listitem>
+<simpara>item 1</simpara>
+<simpara>a paragraph</simpara>
+</listitem>
+<listitem>
+<simpara>item 2</simpara>
+</listitem>
+</itemizedlist>`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+})
@@ -0,0 +1,21 @@
+package renderer
+
+import (
+	"io"
+	"os"
+)
+
+// FileResolver the interface of the component which opens the files to include in a document (or to embed in its output, such as the images).
+// The given path has already been resolved against the location of the including document,
+// so implementations may serve the content from the file system, from an in-memory store, etc.
+type FileResolver interface {
+	Open(path string) (io.ReadCloser, error)
+}
+
+// fileSystemResolver the default FileResolver, which opens the files on the local file system
+type fileSystemResolver struct{}
+
+// Open opens the file at the given path on the local file system
+func (r fileSystemResolver) Open(path string) (io.ReadCloser, error) {
+	return os.Open(path)
+}
@@ -0,0 +1,53 @@
+package html5
+
+import (
+	"bytes"
+	texttemplate "text/template"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+var calloutListTmpl texttemplate.Template
+
+// initializes the templates
+func init() {
+	calloutListTmpl = newTextTemplate("callout-list",
+		`{{ $ctx := .Context }}{{ with .Data }}<div{{ if hasID .Attributes }} id="{{ getID .Attributes }}"{{ end }} class="colist arabic">{{ if hasTitle .Attributes }}
+<div class="title">{{ getTitle .Attributes }}</div>{{ end }}
+<ol>
+{{ range $itemIndex, $item := .Items }}<li>
+{{ $elements := $item.Elements }}{{ range $elementIndex, $element := $elements }}{{ renderElement $ctx $element | printf "%s" }}{{ if includeNewline $ctx $elementIndex $elements }}{{ print "\n" }}{{ end }}{{ end }}
+</li>
+{{ end }}</ol>
+</div>{{ end }}`,
+		texttemplate.FuncMap{
+			"renderElement":  renderElement,
+			"includeNewline": includeNewline,
+			"hasID":          hasID,
+			"getID":          getID,
+			"hasTitle":       hasTitle,
+			"getTitle":       getTitle,
+		})
+}
+
+func renderCalloutList(ctx *renderer.Context, l types.CalloutList) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	// make sure nested elements are aware of that their rendering occurs within a list
+	ctx.SetWithinList(true)
+	defer func() {
+		ctx.SetWithinList(false)
+	}()
+
+	err := executeTemplate(ctx, &calloutListTmpl, result, ContextualPipeline{
+		Context: ctx,
+		Data:    l,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render callout list")
+	}
+	log.Debugf("rendered callout list of items: %s", result.Bytes())
+	return result.Bytes(), nil
+}
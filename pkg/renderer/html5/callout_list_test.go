@@ -0,0 +1,64 @@
+package html5_test
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer/html5"
+	. "github.com/onsi/ginkgo"
+)
+
+var _ = Describe("callouts", func() {
+
+	It("listing block with callouts and callout list", func() {
+		actualContent := `----
+import <lib> <1>
+server.start() <2> <3>
+----
+<1> imports the library
+<2> starts the server
+<3> on the default port`
+		expectedResult := `<div class="listingblock">
+<div class="content">
+<pre class="highlight"><code>import &lt;lib&gt; <b class="conum">(1)</b>
+server.start() <b class="conum">(2)</b> <b class="conum">(3)</b></code></pre>
+</div>
+</div>
+<div class="colist arabic">
+<ol>
+<li>
+<p>imports the library</p>
+</li>
+<li>
+<p>starts the server</p>
+</li>
+<li>
+<p>on the default port</p>
+</li>
+</ol>
+</div>`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+
+	It("source block with callouts and highlighter", func() {
+		actualContent := `[source,go]
+----
+return true <1>
+----
+[#explanations]
+.Explanations
+<1> returns *true*`
+		expectedResult := `<div class="listingblock">
+<div class="content">
+<pre class="builtin highlight"><code data-lang="go"><span class="k">return</span> <span class="kc">true</span> <b class="conum">(1)</b></code></pre>
+</div>
+</div>
+<div id="explanations" class="colist arabic">
+<div class="title">Explanations</div>
+<ol>
+<li>
+<p>returns <strong>true</strong></p>
+</li>
+</ol>
+</div>`
+		verify(GinkgoT(), expectedResult, actualContent, renderer.Highlighter(html5.NewSyntaxHighlighter()))
+	})
+})
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"html/template"
 
+	"github.com/bytesparadise/libasciidoc/pkg/parser"
 	"github.com/bytesparadise/libasciidoc/pkg/renderer"
 	"github.com/bytesparadise/libasciidoc/pkg/types"
 	"github.com/pkg/errors"
@@ -12,37 +13,150 @@ import (
 )
 
 var crossReferenceTmpl template.Template
+var inlineAnchorTmpl template.Template
 
 // initializes the templates
 func init() {
-	crossReferenceTmpl = newHTMLTemplate("cross reference", `<a href="#{{ .ID }}">{{ .Content }}</a>`)
+	crossReferenceTmpl = newHTMLTemplate("cross-reference", `<a href="{{ .Href }}">{{ .Content }}</a>`)
+	inlineAnchorTmpl = newHTMLTemplate("inline-anchor", `<a id="{{ .ID }}"></a>`)
 }
 
 func renderCrossReference(ctx *renderer.Context, xref types.CrossReference) ([]byte, error) {
+	if xref.IsInterDocument() && !ctx.IsCurrentDocument(xref) {
+		return renderInterDocumentCrossReference(ctx, xref)
+	}
 	log.Debugf("rendering cross reference with ID: %s", xref.ID)
-	result := bytes.NewBuffer(nil)
-	renderedContentStr := fmt.Sprintf("[%s]", xref.ID)
+	renderedContent := template.HTML(template.HTMLEscapeString(fmt.Sprintf("[%s]", xref.ID)))
 	if target, found := ctx.Document.ElementReferences[xref.ID]; found {
-		switch t := target.(type) {
-		case types.SectionTitle:
-			renderedContent, err := renderElement(ctx, t.Content)
-			if err != nil {
-				return nil, errors.Wrapf(err, "error while rendering sectionTitle content")
-			}
-			renderedContentStr = string(renderedContent)
-		default:
-			return nil, errors.Errorf("unable to process cross-reference to element of type %T", target)
+		if xref.Label != "" {
+			renderedContent = template.HTML(template.HTMLEscapeString(xref.Label))
+		} else if text, err := renderReferenceText(ctx, target, ctx.SectionNumbers()); err != nil {
+			return nil, err
+		} else if text != "" {
+			renderedContent = text
+		}
+	} else {
+		ctx.Diagnostics().Warnf("dangling-xref", xref.Location, "unable to resolve cross reference to '%s'", xref.ID)
+		if xref.Label != "" {
+			renderedContent = template.HTML(template.HTMLEscapeString(xref.Label))
 		}
 	}
-	err := crossReferenceTmpl.Execute(result, struct {
-		ID      string
-		Content string
+	return executeCrossReferenceTmpl(ctx, "#"+xref.ID, renderedContent)
+}
+
+// renderInterDocumentCrossReference renders the cross reference to another document as a link to its HTML output,
+// relative to the current document. The default text is the title of the target, if it is known from the catalog
+// of the context, or the path of the link otherwise.
+func renderInterDocumentCrossReference(ctx *renderer.Context, xref types.CrossReference) ([]byte, error) {
+	log.Debugf("rendering cross reference to document '%s' with ID: '%s'", xref.Path, xref.ID)
+	href := renderer.OutputPath(xref, outfileSuffix(ctx))
+	renderedContent := template.HTML(template.HTMLEscapeString(href))
+	target, numbers := ctx.ResolveCrossReference(xref)
+	if xref.Label != "" {
+		renderedContent = template.HTML(template.HTMLEscapeString(xref.Label))
+	} else if target != nil {
+		text, err := renderReferenceText(ctx, target, numbers)
+		if err != nil {
+			return nil, err
+		}
+		if text != "" {
+			renderedContent = text
+		}
+	}
+	return executeCrossReferenceTmpl(ctx, href, renderedContent)
+}
+
+// outfileSuffix returns the extension of the output files, which can be changed with the `outfilesuffix` attribute
+func outfileSuffix(ctx *renderer.Context) string {
+	if suffix := ctx.Document.Attributes.GetAsString("outfilesuffix"); suffix != nil && *suffix != "" {
+		return *suffix
+	}
+	return ".html"
+}
+
+func executeCrossReferenceTmpl(ctx *renderer.Context, href string, content template.HTML) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	err := executeTemplate(ctx, &crossReferenceTmpl, result, struct {
+		Href    string
+		Content template.HTML
 	}{
-		ID:      xref.ID,
-		Content: renderedContentStr,
+		Href:    href,
+		Content: content,
 	})
 	if err != nil {
 		return nil, errors.Wrapf(err, "unable to render cross reference")
 	}
 	return result.Bytes(), nil
 }
+
+// renderReferenceText returns the default text of the cross references to the given target, i.e., the rendered
+// content of a section title (along with its number among the given ones, if any), the label of an inline anchor,
+// or the rendered title of other elements
+func renderReferenceText(ctx *renderer.Context, target interface{}, numbers renderer.SectionNumbers) (template.HTML, error) {
+	switch t := target.(type) {
+	case types.SectionTitle:
+		renderedContent, err := renderElement(ctx, t.Content)
+		if err != nil {
+			return "", errors.Wrapf(err, "error while rendering sectionTitle content")
+		}
+		id, _ := t.Attributes[types.AttrID].(string)
+		return template.HTML(template.HTMLEscapeString(numbers[id]) + string(renderedContent)), nil
+	case types.InlineAnchor:
+		return template.HTML(template.HTMLEscapeString(t.Label)), nil
+	default:
+		return renderBlockTitle(ctx, referenceText(target))
+	}
+}
+
+// renderBlockTitle renders the inline elements of the given block title (eg: `The *bold* image`)
+func renderBlockTitle(ctx *renderer.Context, title string) (template.HTML, error) {
+	if title == "" {
+		return "", nil
+	}
+	elements, err := parser.Parse("", []byte(title), parser.Entrypoint("InlineElements"))
+	if err != nil {
+		log.Debugf("unable to parse the block title '%s': %v", title, err)
+		return template.HTML(template.HTMLEscapeString(title)), nil
+	}
+	renderedContent, err := renderElement(ctx, elements)
+	if err != nil {
+		return "", errors.Wrapf(err, "error while rendering block title")
+	}
+	return template.HTML(renderedContent), nil
+}
+
+// referenceText returns the title of the given target (other than a section or an inline anchor),
+// or an empty string if it has none
+func referenceText(target interface{}) string {
+	switch t := target.(type) {
+	case types.Paragraph:
+		return getTitle(t.Attributes)
+	case types.BlockImage:
+		return getTitle(t.Attributes)
+	case types.DelimitedBlock:
+		return getTitle(t.Attributes)
+	case types.Table:
+		return getTitle(t.Attributes)
+	case types.OrderedList:
+		return getTitle(t.Attributes)
+	case types.OrderedListItem:
+		return getTitle(t.Attributes)
+	case types.UnorderedList:
+		return getTitle(t.Attributes)
+	case types.LabeledList:
+		return getTitle(t.Attributes)
+	case types.CalloutList:
+		return getTitle(t.Attributes)
+	default:
+		return ""
+	}
+}
+
+func renderInlineAnchor(ctx *renderer.Context, a types.InlineAnchor) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	err := executeTemplate(ctx, &inlineAnchorTmpl, result, a)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render inline anchor")
+	}
+	return result.Bytes(), nil
+}
@@ -1,6 +1,14 @@
 package html5_test
 
-import . "github.com/onsi/ginkgo"
+import (
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/parser"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	. "github.com/onsi/ginkgo"
+	"github.com/stretchr/testify/require"
+)
 
 var _ = Describe("cross references", func() {
 
@@ -40,4 +48,149 @@ with some content linked to <<thewrongtitle>>!`
 			verify(GinkgoT(), expectedResult, actualContent)
 		})
 	})
+	Context("block and anchor references", func() {
+
+		It("reference to a titled paragraph", func() {
+
+			actualContent := `[[thepara]]
+.The Paragraph
+some content
+
+see <<thepara>>`
+			expectedResult := `<div id="thepara" class="paragraph">
+<div class="doctitle">The Paragraph</div>
+<p>some content</p>
+</div>
+<div class="paragraph">
+<p>see <a href="#thepara">The Paragraph</a></p>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("reference to an untitled listing", func() {
+
+			actualContent := `[#thelisting]
+----
+some code
+----
+
+see <<thelisting>>`
+			expectedResult := `<div id="thelisting" class="listingblock">
+<div class="content">
+<pre class="highlight"><code>some code</code></pre>
+</div>
+</div>
+<div class="paragraph">
+<p>see <a href="#thelisting">[thelisting]</a></p>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("reference to a titled image with custom text", func() {
+
+			actualContent := `[[theimage]]
+.The Image
+image::foo.png[foo]
+
+see xref:theimage[the picture] and <<theimage>>`
+			expectedResult := `<div id="theimage" class="imageblock">
+<div class="content">
+<img src="foo.png" alt="foo">
+</div>
+<div class="title">Figure 1. The Image</div>
+</div>
+<div class="paragraph">
+<p>see <a href="#theimage">the picture</a> and <a href="#theimage">The Image</a></p>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("reference to an image with a formatted title", func() {
+
+			actualContent := `[[theimage]]
+.The *bold* Image
+image::foo.png[foo]
+
+see <<theimage>>`
+			expectedResult := `<div id="theimage" class="imageblock">
+<div class="content">
+<img src="foo.png" alt="foo">
+</div>
+<div class="title">Figure 1. The *bold* Image</div>
+</div>
+<div class="paragraph">
+<p>see <a href="#theimage">The <strong>bold</strong> Image</a></p>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("reference to a section with a formatted title", func() {
+
+			actualContent := `[[thesection]]
+== The _italic_ Section
+
+see <<thesection>>`
+			expectedResult := `<div class="sect1">
+<h2 id="thesection">The <em>italic</em> Section</h2>
+<div class="sectionbody">
+<div class="paragraph">
+<p>see <a href="#thesection">The <em>italic</em> Section</a></p>
+</div>
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("reference to an inline anchor in a list item", func() {
+
+			actualContent := `* [[first,First Item]]first item
+* second item
+
+see <<first>> and <<missing,the missing item>>`
+			expectedResult := `<div class="ulist">
+<ul>
+<li>
+<p><a id="first"></a>first item</p>
+</li>
+<li>
+<p>second item</p>
+</li>
+</ul>
+</div>
+<div class="paragraph">
+<p>see <a href="#first">First Item</a> and <a href="#missing">the missing item</a></p>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+	})
+	Context("inter-document references", func() {
+
+		It("references without catalog", func() {
+
+			actualContent := `see <<other.adoc#section_a,Section A>>, xref:guide/other#intro[] and <<other.adoc>>`
+			expectedResult := `<div class="paragraph">
+<p>see <a href="other.html#section_a">Section A</a>, <a href="guide/other.html#intro">guide/other.html#intro</a> and <a href="other.html">other.html</a></p>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("references with catalog", func() {
+
+			other, err := parser.ParseReader("other.adoc", strings.NewReader(`= The Other Document
+
+== Section A
+
+[[thepara]]
+.The Paragraph
+some content`))
+			require.NoError(GinkgoT(), err)
+			catalog := renderer.NewReferenceCatalog()
+			catalog.Add("other.adoc", other.(types.Document))
+			actualContent := `see <<other.adoc#_section_a>>, <<other.adoc#thepara>>, <<other.adoc>> and <<other.adoc#unknown>>`
+			expectedResult := `<div class="paragraph">
+<p>see <a href="other.html#_section_a">Section A</a>, <a href="other.html#thepara">The Paragraph</a>, <a href="other.html">The Other Document</a> and <a href="other.html#unknown">other.html#unknown</a></p>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent, renderer.References(catalog))
+		})
+	})
 })
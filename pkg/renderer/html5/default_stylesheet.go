@@ -0,0 +1,88 @@
+package html5
+
+// DefaultStylesheet the content of the built-in stylesheet, which is embedded in (or linked from) the full HTML documents
+// unless another stylesheet is set with the `stylesheet` attribute
+const DefaultStylesheet string = `/* libasciidoc default stylesheet */
+html{font-family:sans-serif;-webkit-text-size-adjust:100%}
+body{margin:0;color:rgba(0,0,0,.8);background:#fff;font-family:"Noto Serif","DejaVu Serif",serif;font-size:1.0625em;line-height:1.6;word-wrap:break-word}
+a{color:#2156a5;text-decoration:underline}
+a:hover,a:focus{color:#1d4b8f}
+img{max-width:100%;height:auto;border:0;vertical-align:middle}
+code,pre{font-family:"Droid Sans Mono","DejaVu Sans Mono",monospace;font-size:.9375em}
+code{padding:.1em .5ex;background:#f7f7f8;border-radius:4px;color:rgba(0,0,0,.9)}
+pre{margin:0;overflow-x:auto;white-space:pre;word-wrap:normal;line-height:1.45}
+pre code{padding:0;background:none}
+h1,h2,h3,h4,h5,h6{font-family:"Open Sans","DejaVu Sans",sans-serif;font-weight:300;color:#ba3925;line-height:1.2;margin:1em 0 .5em}
+h1{font-size:2.125em}
+h2{font-size:1.6875em}
+h3{font-size:1.375em}
+h4,h5,h6{font-size:1.125em}
+p{margin:0 0 1.25em}
+table{border-collapse:collapse;border-spacing:0}
+#header,#content,#footnotes,#footer{max-width:62.5em;margin:0 auto;padding:0 1em}
+#header>h1:first-child{margin-top:1em;color:rgba(0,0,0,.85)}
+#header .details{border-bottom:1px solid #dddddf;padding-bottom:.5em;margin-bottom:1em;color:rgba(0,0,0,.6)}
+#header .details span{margin-right:.5em}
+#toc{border-bottom:1px solid #e7e7e9;padding-bottom:.5em;margin-bottom:1.25em}
+#toc #toctitle{color:#7a2518;font-size:1.2em}
+#toc ul{margin:0;padding-left:1.25em;list-style-type:none}
+#toc li{line-height:1.33}
+#toc a{text-decoration:none}
+.sect0 h1,h1.sect0{font-size:2.25em;color:#a0a0a0}
+.sect1{padding-bottom:.625em}
+.sect1+.sect1{border-top:1px solid #e7e7e9}
+.paragraph .doctitle,.title,.tableblock caption{font-style:italic;color:#7a2518;margin-bottom:.25em}
+.listingblock,.literalblock,.imageblock,.exampleblock,.sidebarblock,.quoteblock,.verseblock,.admonitionblock,.openblock,.ulist,.olist,.dlist,.hdlist,.colist,table.tableblock{margin-bottom:1.25em}
+.listingblock pre,.literalblock pre{background:#f7f7f8;border-radius:4px;padding:1em}
+.exampleblock>.content{border:1px solid #e6e6e6;border-radius:4px;padding:1.25em}
+.sidebarblock{border:1px solid #dbdbd6;border-radius:4px;background:#f3f3f2;padding:1.25em}
+.sidebarblock>.content>.title{text-align:center;color:#7a2518}
+.quoteblock,.verseblock{margin:0 1em 1.25em 1.5em;border-left:5px solid #eee;padding-left:1em;color:rgba(0,0,0,.85)}
+.quoteblock .attribution,.verseblock .attribution{font-size:.9375em;color:rgba(0,0,0,.6);margin-top:.5em}
+.verseblock pre{font-family:inherit;white-space:pre-wrap}
+.admonitionblock>table{width:100%}
+.admonitionblock td.icon{width:80px;text-align:center;vertical-align:top}
+.admonitionblock td.icon .title{font-weight:bold;font-style:normal;text-transform:uppercase;color:rgba(0,0,0,.6)}
+.admonitionblock td.content{padding-left:1.125em;border-left:1px solid #dddddf;color:rgba(0,0,0,.6)}
+.openblock.partintro{font-style:italic}
+ul,ol{margin:0 0 1.25em;padding-left:1.5em}
+li>p{margin-bottom:.5em}
+ol.arabic{list-style-type:decimal}
+ol.loweralpha{list-style-type:lower-alpha}
+ol.upperalpha{list-style-type:upper-alpha}
+ol.lowerroman{list-style-type:lower-roman}
+ol.upperroman{list-style-type:upper-roman}
+ol.lowergreek{list-style-type:lower-greek}
+.dlist dt,.hdlist1{font-weight:bold}
+.dlist dd{margin-left:1.125em;margin-bottom:.75em}
+.hdlist td{vertical-align:top;padding-right:.8em}
+.colist td:not([class]):first-child{width:1.5em;vertical-align:top}
+.conum{display:inline-block;width:1.25em;border-radius:50%;background:rgba(0,0,0,.8);color:#fff;text-align:center;font-family:"Open Sans","DejaVu Sans",sans-serif;font-style:normal;font-weight:bold;font-size:.75em}
+table.tableblock{max-width:100%}
+table.tableblock.stretch{width:100%}
+table.tableblock.frame-all{border:1px solid #dedede}
+table.tableblock.frame-topbot{border-top:1px solid #dedede;border-bottom:1px solid #dedede}
+table.tableblock.frame-sides{border-left:1px solid #dedede;border-right:1px solid #dedede}
+table.grid-all>*>tr>*{border:1px solid #dedede}
+table.grid-rows>*>tr>*{border-top:1px solid #dedede;border-bottom:1px solid #dedede}
+table.grid-cols>*>tr>*{border-left:1px solid #dedede;border-right:1px solid #dedede}
+th.tableblock,td.tableblock{padding:.5em .625em}
+p.tableblock{margin:0}
+.halign-left{text-align:left}
+.halign-right{text-align:right}
+.halign-center{text-align:center}
+.valign-top{vertical-align:top}
+.valign-bottom{vertical-align:bottom}
+.valign-middle{vertical-align:middle}
+.footnote,.footnoteref{font-size:.875em}
+.footnoteref.red{color:#b12146}
+#footnotes{padding-top:.75em;padding-bottom:.75em;margin-bottom:.625em}
+#footnotes hr{width:20%;min-width:6.25em;margin:-.25em 0 .75em;border:0;border-top:1px solid #dddddf}
+#footnotes .footnote{font-size:.875em;line-height:1.3;margin-bottom:.2em}
+#footer{max-width:none;padding:1.25em;background:rgba(0,0,0,.8)}
+#footer-text{max-width:62.5em;margin:0 auto;color:rgba(255,255,255,.8);line-height:1.44}
+@media print{
+#footer{background:none}
+#footer-text{color:rgba(0,0,0,.6)}
+a{color:inherit}
+}`
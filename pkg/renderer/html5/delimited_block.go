@@ -2,6 +2,8 @@ package html5
 
 import (
 	"bytes"
+	"fmt"
+	"strings"
 	texttemplate "text/template"
 
 	"github.com/bytesparadise/libasciidoc/pkg/renderer"
@@ -11,22 +13,33 @@ import (
 )
 
 var listingBlockTmpl texttemplate.Template
+var sourceBlockTmpl texttemplate.Template
 var exampleBlockTmpl texttemplate.Template
 var admonitionBlockTmpl texttemplate.Template
 var verseBlockTmpl texttemplate.Template
+var quoteBlockTmpl texttemplate.Template
+var sidebarBlockTmpl texttemplate.Template
+var openBlockTmpl texttemplate.Template
 
 // initializes the templates
 func init() {
-	listingBlockTmpl = newTextTemplate("listing block", `<div class="listingblock">
+	listingBlockTmpl = newTextTemplate("listing-block", `{{ with .Data }}<div{{ if .ID }} id="{{ .ID }}"{{ end }} class="listingblock">{{ if .Title }}
+<div class="title">{{ .Title }}</div>{{ end }}
 <div class="content">
-<pre class="highlight"><code>{{ $ctx := .Context }}{{ with .Data }}{{ .Element }}{{ end }}</code></pre>
+<pre class="highlight"><code>{{ .Element }}</code></pre>
 </div>
-</div>`,
+</div>{{ end }}`,
 		texttemplate.FuncMap{
 			"renderElement":  renderElement,
 			"includeNewline": includeNewline,
 		})
-	exampleBlockTmpl = newTextTemplate("example block", `<div class="exampleblock">
+	sourceBlockTmpl = newTextTemplate("source-block", `{{ with .Data }}<div{{ if .ID }} id="{{ .ID }}"{{ end }} class="listingblock">{{ if .Title }}
+<div class="title">{{ .Title }}</div>{{ end }}
+<div class="content">
+<pre class="{{ if .Highlighter }}{{ .Highlighter }} {{ end }}highlight"><code{{ if .Language }}{{ if not .Highlighter }} class="language-{{ html .Language }}"{{ end }} data-lang="{{ html .Language }}"{{ end }}>{{ .Content }}</code></pre>
+</div>
+</div>{{ end }}`)
+	exampleBlockTmpl = newTextTemplate("example-block", `<div class="exampleblock">
 <div class="content">
 {{ $ctx := .Context }}{{ with .Data }}{{ $elements := .Elements }}{{ range $index, $element := $elements }}{{ renderElement $ctx $element | printf "%s" }}{{ if includeNewline $ctx $index $elements }}{{ print "\n" }}{{ end }}{{ end }}{{ end }}
 </div>
@@ -35,7 +48,7 @@ func init() {
 			"renderElement":  renderElement,
 			"includeNewline": includeNewline,
 		})
-	verseBlockTmpl = newTextTemplate("verse block", `<div class="verseblock">
+	verseBlockTmpl = newTextTemplate("verse-block", `<div class="verseblock">
 {{ $ctx := .Context }}{{ with .Data }}<pre class="content">{{ $elements := .Elements }}{{ range $index, $element := $elements }}{{ renderElement $ctx $element | printf "%s" }}{{ if includeNewline $ctx $index $elements }}{{ print "\n" }}{{ end }}{{ end }}</pre>{{ if .Attribution.First }}
 <div class="attribution">
 &#8212; {{ .Attribution.First }}{{ if .Attribution.Second }}<br>
@@ -46,7 +59,41 @@ func init() {
 			"renderElement":  renderElement,
 			"includeNewline": includeNewline,
 		})
-	admonitionBlockTmpl = newTextTemplate("admonition block", `{{ $ctx := .Context }}{{ with .Data }}<div class="admonitionblock {{ .Class }}">
+	quoteBlockTmpl = newTextTemplate("quote-block", `{{ $ctx := .Context }}{{ with .Data }}<div{{ if .ID }} id="{{ .ID }}"{{ end }} class="quoteblock">{{ if .Title }}
+<div class="title">{{ .Title }}</div>{{ end }}
+<blockquote>
+{{ $elements := .Elements }}{{ range $index, $element := $elements }}{{ renderElement $ctx $element | printf "%s" }}{{ if includeNewline $ctx $index $elements }}{{ print "\n" }}{{ end }}{{ end }}
+</blockquote>{{ if .Attribution.First }}
+<div class="attribution">
+&#8212; {{ .Attribution.First }}{{ if .Attribution.Second }}<br>
+<cite>{{ .Attribution.Second }}</cite>{{ end }}
+</div>{{ end }}
+</div>{{ end }}`,
+		texttemplate.FuncMap{
+			"renderElement":  renderElement,
+			"includeNewline": includeNewline,
+		})
+	sidebarBlockTmpl = newTextTemplate("sidebar-block", `{{ $ctx := .Context }}{{ with .Data }}<div{{ if .ID }} id="{{ .ID }}"{{ end }} class="sidebarblock">
+<div class="content">{{ if .Title }}
+<div class="title">{{ .Title }}</div>{{ end }}
+{{ $elements := .Elements }}{{ range $index, $element := $elements }}{{ renderElement $ctx $element | printf "%s" }}{{ if includeNewline $ctx $index $elements }}{{ print "\n" }}{{ end }}{{ end }}
+</div>
+</div>{{ end }}`,
+		texttemplate.FuncMap{
+			"renderElement":  renderElement,
+			"includeNewline": includeNewline,
+		})
+	openBlockTmpl = newTextTemplate("open-block", `{{ $ctx := .Context }}{{ with .Data }}<div{{ if .ID }} id="{{ .ID }}"{{ end }} class="openblock">{{ if .Title }}
+<div class="title">{{ .Title }}</div>{{ end }}
+<div class="content">
+{{ $elements := .Elements }}{{ range $index, $element := $elements }}{{ renderElement $ctx $element | printf "%s" }}{{ if includeNewline $ctx $index $elements }}{{ print "\n" }}{{ end }}{{ end }}
+</div>
+</div>{{ end }}`,
+		texttemplate.FuncMap{
+			"renderElement":  renderElement,
+			"includeNewline": includeNewline,
+		})
+	admonitionBlockTmpl = newTextTemplate("admonition-block", `{{ $ctx := .Context }}{{ with .Data }}<div class="admonitionblock {{ .Class }}">
 <table>
 <tr>
 <td class="icon">
@@ -72,32 +119,40 @@ func renderDelimitedBlock(ctx *renderer.Context, b types.DelimitedBlock) ([]byte
 	kind := b.Attributes[types.AttrBlockKind]
 	switch kind {
 	case types.Fenced, types.Listing:
-		content := make([]byte, 0)
-		for _, e := range elements {
-			s, err := renderPlainString(ctx, e)
-			if err != nil {
-				return nil, errors.Wrapf(err, "unable to initialize a new delimited block")
-			}
-			content = append(content, s...)
+		var content string
+		if isVerbatim(elements) {
+			content, err = renderVerbatimLines(elements, nil)
+		} else {
+			content, err = renderListingContent(ctx, elements)
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render delimited block")
 		}
 		ctx.SetIncludeBlankLine(true)
 		ctx.SetWithinDelimitedBlock(true)
 		defer func() {
 			ctx.SetIncludeBlankLine(false)
-			ctx.SetIncludeBlankLine(false)
+			ctx.SetWithinDelimitedBlock(false)
 		}()
-
-		err = listingBlockTmpl.Execute(result, ContextualPipeline{
+		id, _ := b.Attributes[types.AttrID].(string)
+		title, _ := b.Attributes[types.AttrTitle].(string)
+		err = executeTemplate(ctx, &listingBlockTmpl, result, ContextualPipeline{
 			Context: ctx,
 			Data: struct {
+				ID      string
+				Title   string
 				Element string
 			}{
-				Element: string(content),
+				ID:      id,
+				Title:   title,
+				Element: content,
 			},
 		})
+	case types.Source:
+		err = renderSourceBlock(ctx, b, elements, result)
 	case types.Example:
 		if k, ok := b.Attributes[types.AttrAdmonitionKind].(types.AdmonitionKind); ok {
-			err = admonitionBlockTmpl.Execute(result, ContextualPipeline{
+			err = executeTemplate(ctx, &admonitionBlockTmpl, result, ContextualPipeline{
 				Context: ctx,
 				Data: struct {
 					ID       string
@@ -113,7 +168,7 @@ func renderDelimitedBlock(ctx *renderer.Context, b types.DelimitedBlock) ([]byte
 			})
 		} else {
 			// default, example block
-			err = exampleBlockTmpl.Execute(result, ContextualPipeline{
+			err = executeTemplate(ctx, &exampleBlockTmpl, result, ContextualPipeline{
 				Context: ctx,
 				Data: struct {
 					Elements []interface{}
@@ -143,7 +198,7 @@ func renderDelimitedBlock(ctx *renderer.Context, b types.DelimitedBlock) ([]byte
 		} else if b.Attributes[types.AttrVerseTitle].(string) != "" {
 			attribution.First = b.Attributes[types.AttrVerseTitle].(string)
 		}
-		err = verseBlockTmpl.Execute(result, ContextualPipeline{
+		err = executeTemplate(ctx, &verseBlockTmpl, result, ContextualPipeline{
 			Context: ctx,
 			Data: struct {
 				Attribution struct {
@@ -157,6 +212,37 @@ func renderDelimitedBlock(ctx *renderer.Context, b types.DelimitedBlock) ([]byte
 				Elements:    elements,
 			},
 		})
+	case types.Quote:
+		err = renderQuoteBlock(ctx, b, elements, result)
+	case types.Sidebar:
+		err = executeTemplate(ctx, &sidebarBlockTmpl, result, ContextualPipeline{
+			Context: ctx,
+			Data:    newCompoundBlock(b, elements),
+		})
+	case types.Open:
+		err = executeTemplate(ctx, &openBlockTmpl, result, ContextualPipeline{
+			Context: ctx,
+			Data:    newCompoundBlock(b, elements),
+		})
+	case types.PassthroughBlock:
+		// the content of a passthrough block is rendered as-is...
+		lines := make([]string, 0, len(elements))
+		for _, e := range elements {
+			s, err := renderPlainString(ctx, e)
+			if err != nil {
+				return nil, errors.Wrapf(err, "unable to render passthrough block")
+			}
+			lines = append(lines, string(s))
+		}
+		content := strings.Trim(strings.Join(lines, "\n"), "\n")
+		if ctx.SafeMode() >= renderer.SafeModeServer {
+			// ... unless the safe mode does not allow raw content in the output
+			content = texttemplate.HTMLEscapeString(content)
+		} else {
+			// ... once filtered by the sanitizer policy
+			content = sanitizer(ctx).SanitizeHTML(content)
+		}
+		result.WriteString(content)
 	case types.Comment:
 		// nothing to do
 	default:
@@ -169,3 +255,163 @@ func renderDelimitedBlock(ctx *renderer.Context, b types.DelimitedBlock) ([]byte
 	// log.Debugf("rendered delimited block: %s", result.Bytes())
 	return result.Bytes(), nil
 }
+
+// compoundBlock the data of the blocks which contain other blocks
+type compoundBlock struct {
+	ID       string
+	Title    string
+	Elements []interface{}
+}
+
+func newCompoundBlock(b types.DelimitedBlock, elements []interface{}) compoundBlock {
+	id, _ := b.Attributes[types.AttrID].(string)
+	title, _ := b.Attributes[types.AttrTitle].(string)
+	return compoundBlock{
+		ID:       id,
+		Title:    title,
+		Elements: elements,
+	}
+}
+
+func renderQuoteBlock(ctx *renderer.Context, b types.DelimitedBlock, elements []interface{}, result *bytes.Buffer) error {
+	var attribution struct {
+		First  string
+		Second string
+	}
+	author, _ := b.Attributes[types.AttrQuoteAuthor].(string)
+	title, _ := b.Attributes[types.AttrQuoteTitle].(string)
+	if author != "" {
+		attribution.First = author
+		attribution.Second = title
+	} else {
+		attribution.First = title
+	}
+	return executeTemplate(ctx, &quoteBlockTmpl, result, ContextualPipeline{
+		Context: ctx,
+		Data: struct {
+			compoundBlock
+			Attribution struct {
+				First  string
+				Second string
+			}
+		}{
+			compoundBlock: newCompoundBlock(b, elements),
+			Attribution:   attribution,
+		},
+	})
+}
+
+func renderListingContent(ctx *renderer.Context, elements []interface{}) (string, error) {
+	content := make([]byte, 0)
+	for _, e := range elements {
+		s, err := renderPlainString(ctx, e)
+		if err != nil {
+			return "", errors.Wrapf(err, "unable to initialize a new delimited block")
+		}
+		content = append(content, s...)
+	}
+	return string(content), nil
+}
+
+func renderSourceBlock(ctx *renderer.Context, b types.DelimitedBlock, elements []interface{}, result *bytes.Buffer) error {
+	language, _ := b.Attributes[types.AttrLanguage].(string)
+	var highlight func(string) (string, error)
+	var highlighterName string
+	if h := highlighter(ctx); h != nil && language != "" {
+		log.Debugf("highlighting source block in '%s' with the '%s' highlighter", language, h.Name())
+		highlight = func(source string) (string, error) {
+			return h.Highlight(source, language)
+		}
+		highlighterName = h.Name()
+	}
+	content, err := renderVerbatimLines(elements, highlight)
+	if err != nil {
+		return errors.Wrapf(err, "unable to render source block")
+	}
+	id, _ := b.Attributes[types.AttrID].(string)
+	title, _ := b.Attributes[types.AttrTitle].(string)
+	return executeTemplate(ctx, &sourceBlockTmpl, result, ContextualPipeline{
+		Context: ctx,
+		Data: struct {
+			ID          string
+			Title       string
+			Language    string
+			Highlighter string
+			Content     string
+		}{
+			ID:          id,
+			Title:       title,
+			Language:    language,
+			Highlighter: highlighterName,
+			Content:     content,
+		},
+	})
+}
+
+// isVerbatim returns true if the given elements are the verbatim lines of a block
+func isVerbatim(elements []interface{}) bool {
+	if len(elements) == 0 {
+		return false
+	}
+	_, ok := elements[0].(types.VerbatimLine)
+	return ok
+}
+
+// renderVerbatimLines renders the given verbatim lines, without the leading and trailing blank lines.
+// The content of the lines is highlighted with the given func if any, escaped otherwise, and the callouts are
+// rendered at the end of their line
+func renderVerbatimLines(elements []interface{}, highlight func(string) (string, error)) (string, error) {
+	lines := make([]types.VerbatimLine, 0, len(elements))
+	for _, e := range elements {
+		if l, ok := e.(types.VerbatimLine); ok {
+			lines = append(lines, l)
+		}
+	}
+	for len(lines) > 0 && strings.TrimSpace(lines[0].Content) == "" && len(lines[0].Callouts) == 0 {
+		lines = lines[1:]
+	}
+	for len(lines) > 0 && strings.TrimSpace(lines[len(lines)-1].Content) == "" && len(lines[len(lines)-1].Callouts) == 0 {
+		lines = lines[:len(lines)-1]
+	}
+	contents := make([]string, len(lines))
+	for i, l := range lines {
+		contents[i] = l.Content
+	}
+	if highlight != nil {
+		highlighted, err := highlight(strings.Join(contents, "\n"))
+		if err != nil {
+			return "", errors.Wrapf(err, "unable to highlight source block")
+		}
+		contents = strings.Split(highlighted, "\n")
+		if len(contents) != len(lines) {
+			// the highlighter did not retain the lines, so the callouts cannot be placed
+			log.Warnf("unable to render the callouts: the highlighted content has %d line(s) instead of %d", len(contents), len(lines))
+			return highlighted, nil
+		}
+	} else {
+		for i, c := range contents {
+			contents[i] = verbatimEscaper.Replace(c)
+		}
+	}
+	buff := bytes.NewBuffer(nil)
+	for i, l := range lines {
+		if i > 0 {
+			buff.WriteString("\n")
+		}
+		buff.WriteString(contents[i])
+		for j, c := range l.Callouts {
+			if j > 0 {
+				buff.WriteString(" ")
+			}
+			buff.WriteString(renderCallout(c))
+		}
+	}
+	return buff.String(), nil
+}
+
+// verbatimEscaper escapes the special characters of verbatim content, but leaves the quotes unchanged
+var verbatimEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+func renderCallout(c types.Callout) string {
+	return fmt.Sprintf(`<b class="conum">(%d)</b>`, c.Ref)
+}
@@ -1,6 +1,12 @@
 package html5_test
 
-import . "github.com/onsi/ginkgo"
+import (
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer/html5"
+	. "github.com/onsi/ginkgo"
+)
 
 var _ = Describe("delimited Blocks", func() {
 
@@ -273,4 +279,216 @@ ____`
 		})
 	})
 
+	Context("source blocks", func() {
+
+		It("source block with language", func() {
+			actualContent := `[source,go]
+----
+fmt.Println("hello")
+----`
+			expectedResult := `<div class="listingblock">
+<div class="content">
+<pre class="highlight"><code class="language-go" data-lang="go">fmt.Println("hello")</code></pre>
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("source block without language, with ID and title", func() {
+			actualContent := `[[source-id]]
+.a title
+[source]
+----
+some code
+----`
+			expectedResult := `<div id="source-id" class="listingblock">
+<div class="title">a title</div>
+<div class="content">
+<pre class="highlight"><code>some code</code></pre>
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("fenced block with language", func() {
+			actualContent := "```ruby\nputs 'hello'\n```"
+			expectedResult := `<div class="listingblock">
+<div class="content">
+<pre class="highlight"><code class="language-ruby" data-lang="ruby">puts 'hello'</code></pre>
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("source block with built-in highlighter", func() {
+			actualContent := `[source,go]
+----
+// prints a greeting
+func main() {
+    fmt.Println("hello, <world>", 42, true)
+}
+----`
+			expectedResult := `<div class="listingblock">
+<div class="content">
+<pre class="builtin highlight"><code data-lang="go"><span class="c">// prints a greeting</span>
+<span class="k">func</span> main() {
+    fmt.Println(<span class="s">&#34;hello, &lt;world&gt;&#34;</span>, <span class="m">42</span>, <span class="kc">true</span>)
+}</code></pre>
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent, renderer.Highlighter(html5.NewSyntaxHighlighter()))
+		})
+
+		It("source block with unsupported language and built-in highlighter", func() {
+			actualContent := `[source,cobol]
+----
+DISPLAY "<hello>"
+----`
+			expectedResult := `<div class="listingblock">
+<div class="content">
+<pre class="builtin highlight"><code data-lang="cobol">DISPLAY &#34;&lt;hello&gt;&#34;</code></pre>
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent, renderer.Highlighter(html5.NewSyntaxHighlighter()))
+		})
+
+		It("source block with built-in highlighter enabled by the source-highlighter attribute", func() {
+			actualContent := `:source-highlighter: rouge
+
+[source,go]
+----
+return true
+----`
+			expectedResult := `<div class="listingblock">
+<div class="content">
+<pre class="builtin highlight"><code data-lang="go"><span class="k">return</span> <span class="kc">true</span></code></pre>
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("source block with custom highlighter", func() {
+			actualContent := `[source,go]
+----
+some code
+----`
+			expectedResult := `<div class="listingblock">
+<div class="content">
+<pre class="upper highlight"><code data-lang="go">SOME CODE</code></pre>
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent, renderer.Highlighter(upperCaseHighlighter{}))
+		})
+	})
+	Context("quote blocks", func() {
+
+		It("quote block with author and title", func() {
+			actualContent := `[quote, john doe, quote title]
+____
+some *quote* content
+____`
+			expectedResult := `<div class="quoteblock">
+<blockquote>
+<div class="paragraph">
+<p>some <strong>quote</strong> content</p>
+</div>
+</blockquote>
+<div class="attribution">
+&#8212; john doe<br>
+<cite>quote title</cite>
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("quote block with ID and title, without attribution", func() {
+			actualContent := `[#quote-id]
+.a title
+____
+some content
+____`
+			expectedResult := `<div id="quote-id" class="quoteblock">
+<div class="title">a title</div>
+<blockquote>
+<div class="paragraph">
+<p>some content</p>
+</div>
+</blockquote>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+	})
+
+	Context("sidebar blocks", func() {
+
+		It("sidebar block with ID and title", func() {
+			actualContent := `[#sidebar-id]
+.a title
+****
+some content
+
+another paragraph
+****`
+			expectedResult := `<div id="sidebar-id" class="sidebarblock">
+<div class="content">
+<div class="title">a title</div>
+<div class="paragraph">
+<p>some content</p>
+</div>
+<div class="paragraph">
+<p>another paragraph</p>
+</div>
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+	})
+
+	Context("open blocks", func() {
+
+		It("open block with title", func() {
+			actualContent := `.a title
+--
+some content
+--`
+			expectedResult := `<div class="openblock">
+<div class="title">a title</div>
+<div class="content">
+<div class="paragraph">
+<p>some content</p>
+</div>
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+	})
+
+	Context("passthrough blocks", func() {
+
+		It("passthrough block with raw content", func() {
+			actualContent := `++++
+<video src="*foo*.mp4">
+</video>
+++++
+
+a paragraph`
+			expectedResult := `<video src="*foo*.mp4">
+</video>
+<div class="paragraph">
+<p>a paragraph</p>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+	})
 })
+
+// upperCaseHighlighter a SyntaxHighlighter which converts the content to upper case
+type upperCaseHighlighter struct{}
+
+func (h upperCaseHighlighter) Name() string {
+	return "upper"
+}
+
+func (h upperCaseHighlighter) Highlight(source, language string) (string, error) {
+	return strings.ToUpper(source), nil
+}
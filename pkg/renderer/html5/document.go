@@ -15,7 +15,7 @@ import (
 var documentTmpl texttemplate.Template
 
 func init() {
-	documentTmpl = newTextTemplate("root document",
+	documentTmpl = newTextTemplate("document",
 		`<!DOCTYPE html>
 <html lang="en">
 <head>
@@ -23,15 +23,17 @@ func init() {
 <!--[if IE]><meta http-equiv="X-UA-Compatible" content="IE=edge"><![endif]-->
 <meta name="viewport" content="width=device-width, initial-scale=1.0">{{ if .Generator }}
 <meta name="generator" content="{{.Generator}}">{{ end }}
-<title>{{.Title}}</title>
-<body class="article">
+<title>{{.Title}}</title>{{ if .Stylesheet }}
+{{ .Stylesheet }}{{ end }}
+<body class="{{.DocType}}">
 <div id="header">
 <h1>{{.Title}}</h1>{{ if .Details }}
 {{ .Details }}{{ end }}
 </div>
 <div id="content">
 {{.Content}}
-</div>
+</div>{{ if .Footnotes }}
+{{ .Footnotes }}{{ end }}
 <div id="footer">
 <div id="footer-text">{{ if .RevNumber }}
 Version {{.RevNumber}}<br>{{ end }}
@@ -61,17 +63,31 @@ func renderDocument(ctx *renderer.Context, output io.Writer) (map[string]interfa
 		if err != nil {
 			return nil, errors.Wrapf(err, "unable to render full document")
 		}
-		err = documentTmpl.Execute(output, struct {
+		renderedFootnotes, err := renderFootnotes(ctx)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render full document")
+		}
+		stylesheet, err := renderStylesheet(ctx)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render full document")
+		}
+		err = executeTemplate(ctx, &documentTmpl, output, struct {
 			Generator   string
+			DocType     string
 			Title       string
+			Stylesheet  htmltemplate.HTML
 			Content     htmltemplate.HTML
+			Footnotes   htmltemplate.HTML
 			RevNumber   *string
 			LastUpdated string
 			Details     *htmltemplate.HTML
 		}{
 			Generator:   "libasciidoc", // TODO: externalize this value and include the lib version ?
+			DocType:     ctx.Document.Attributes.GetDocType(),
 			Title:       string(renderedTitle),
+			Stylesheet:  stylesheet,
 			Content:     htmltemplate.HTML(string(renderedElements)),
+			Footnotes:   htmltemplate.HTML(string(renderedFootnotes)),
 			RevNumber:   ctx.Document.Attributes.GetAsString("revnumber"),
 			LastUpdated: ctx.LastUpdated(),
 			Details:     documentDetails,
@@ -85,6 +101,14 @@ func renderDocument(ctx *renderer.Context, output io.Writer) (map[string]interfa
 			return nil, errors.Wrapf(err, "unable to render full document")
 		}
 		output.Write(renderedElements)
+		renderedFootnotes, err := renderFootnotes(ctx)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render full document")
+		}
+		if len(renderedFootnotes) > 0 {
+			output.Write([]byte("\n"))
+			output.Write(renderedFootnotes)
+		}
 	}
 	// copy all document attributes, and override the title with its rendered value instead of the `types.Section` struct
 	for k, v := range ctx.Document.Attributes {
@@ -15,6 +15,10 @@ func processAttributeDeclaration(ctx *renderer.Context, attr types.DocumentAttri
 
 func processAttributeReset(ctx *renderer.Context, attr types.DocumentAttributeReset) error {
 	ctx.Document.Attributes.Reset(attr)
+	if attr.Name == AttrFigureCaption {
+		// unlike the other attributes, the figure caption has a default value when it is not declared
+		ctx.Document.Attributes[AttrFigureCaption] = ""
+	}
 	return nil
 }
 
@@ -23,6 +27,7 @@ func renderAttributeSubstitution(ctx *renderer.Context, attr types.DocumentAttri
 	if value, found := ctx.Document.Attributes[attr.Name]; found {
 		result.WriteString(fmt.Sprintf("%v", value))
 	} else {
+		ctx.Diagnostics().Warnf("unknown-attribute", attr.Location, "unknown attribute: '%s'", attr.Name)
 		result.WriteString(fmt.Sprintf("{%s}", attr.Name))
 	}
 	return result.Bytes(), nil
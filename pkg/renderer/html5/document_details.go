@@ -15,14 +15,14 @@ var documentDetailsTmpl htmltemplate.Template
 var documentAuthorDetailsTmpl htmltemplate.Template
 
 func init() {
-	documentDetailsTmpl = newHTMLTemplate("document details", `<div class="details">{{ if .Authors }}
+	documentDetailsTmpl = newHTMLTemplate("document-details", `<div class="details">{{ if .Authors }}
 {{.Authors}}{{ end }}{{ if .RevNumber }}
 <span id="revnumber">version {{.RevNumber}},</span>{{ end }}{{ if .RevDate }}
 <span id="revdate">{{.RevDate}}</span>{{ end }}{{ if .RevRemark }}
 <br><span id="revremark">{{.RevRemark}}</span>{{ end }}
 </div>`)
 
-	documentAuthorDetailsTmpl = newHTMLTemplate("author details", `{{ if .Name }}<span id="author{{.Index}}" class="author">{{.Name}}</span><br>{{ end }}{{ if .Email }}
+	documentAuthorDetailsTmpl = newHTMLTemplate("author-details", `{{ if .Name }}<span id="author{{.Index}}" class="author">{{.Name}}</span><br>{{ end }}{{ if .Email }}
 <span id="email{{.Index}}" class="email"><a href="mailto:{{.Email}}">{{.Email}}</a></span><br>{{ end }}`)
 }
 
@@ -33,7 +33,7 @@ func renderDocumentDetails(ctx *renderer.Context) (*htmltemplate.HTML, error) {
 			return nil, errors.Wrap(err, "error while rendering the document details")
 		}
 		documentDetailsBuff := bytes.NewBuffer(nil)
-		err = documentDetailsTmpl.Execute(documentDetailsBuff, struct {
+		err = executeTemplate(ctx, &documentDetailsTmpl, documentDetailsBuff, struct {
 			Authors   htmltemplate.HTML
 			RevNumber *string
 			RevDate   *string
@@ -72,7 +72,7 @@ func renderDocumentAuthorsDetails(ctx *renderer.Context) (*htmltemplate.HTML, er
 		// having at least one author is the minimal requirement for document details
 		if author := ctx.Document.Attributes.GetAsString(authorKey); author != nil {
 			authorDetailsBuff := bytes.NewBuffer(nil)
-			err := documentAuthorDetailsTmpl.Execute(authorDetailsBuff, struct {
+			err := executeTemplate(ctx, &documentAuthorDetailsTmpl, authorDetailsBuff, struct {
 				Index string
 				Name  *string
 				Email *string
@@ -25,6 +25,9 @@ v1.0, June 19, 2017: First incarnation`
 <meta name="viewport" content="width=device-width, initial-scale=1.0">
 <meta name="generator" content="libasciidoc">
 <title>The Dangerous and Thrilling Documentation Chronicles</title>
+<style>
+{{.DefaultStylesheet}}
+</style>
 <body class="article">
 <div id="header">
 <h1>The Dangerous and Thrilling Documentation Chronicles</h1>
@@ -63,6 +66,9 @@ Kismet Rainbow Chameleon <kismet@asciidoctor.org>; Lazarus het_Draeke <lazarus@a
 <meta name="viewport" content="width=device-width, initial-scale=1.0">
 <meta name="generator" content="libasciidoc">
 <title>The Dangerous and Thrilling Documentation Chronicles</title>
+<style>
+{{.DefaultStylesheet}}
+</style>
 <body class="article">
 <div id="header">
 <h1>The Dangerous and Thrilling Documentation Chronicles</h1>
@@ -21,6 +21,9 @@ var _ = Describe("document header", func() {
 <meta name="viewport" content="width=device-width, initial-scale=1.0">
 <meta name="generator" content="libasciidoc">
 <title>The Dangerous and Thrilling Documentation Chronicles</title>
+<style>
+{{.DefaultStylesheet}}
+</style>
 <body class="article">
 <div id="header">
 <h1>The Dangerous and Thrilling Documentation Chronicles</h1>
@@ -55,6 +58,9 @@ v1.0, June 19, 2017: First incarnation`
 <meta name="viewport" content="width=device-width, initial-scale=1.0">
 <meta name="generator" content="libasciidoc">
 <title>The Dangerous and Thrilling Documentation Chronicles</title>
+<style>
+{{.DefaultStylesheet}}
+</style>
 <body class="article">
 <div id="header">
 <h1>The Dangerous and Thrilling Documentation Chronicles</h1>
@@ -93,6 +99,9 @@ Kismet Rainbow Chameleon <kismet@asciidoctor.org>; Lazarus het_Draeke <lazarus@a
 <meta name="viewport" content="width=device-width, initial-scale=1.0">
 <meta name="generator" content="libasciidoc">
 <title>The Dangerous and Thrilling Documentation Chronicles</title>
+<style>
+{{.DefaultStylesheet}}
+</style>
 <body class="article">
 <div id="header">
 <h1>The Dangerous and Thrilling Documentation Chronicles</h1>
@@ -117,4 +126,48 @@ Last updated {{.LastUpdated}}
 
 		})
 	})
+	Context("book", func() {
+
+		It("book doctype", func() {
+			actualContent := `= The Book
+:doctype: book
+
+== The Chapter`
+			expectedResult := `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="UTF-8">
+<!--[if IE]><meta http-equiv="X-UA-Compatible" content="IE=edge"><![endif]-->
+<meta name="viewport" content="width=device-width, initial-scale=1.0">
+<meta name="generator" content="libasciidoc">
+<title>The Book</title>
+<style>
+{{.DefaultStylesheet}}
+</style>
+<body class="book">
+<div id="header">
+<h1>The Book</h1>
+</div>
+<div id="content">
+<div id="preamble">
+<div class="sectionbody">
+
+</div>
+</div>
+<div class="sect1">
+<h2 id="_the_chapter">The Chapter</h2>
+<div class="sectionbody">
+</div>
+</div>
+</div>
+<div id="footer">
+<div id="footer-text">
+Last updated {{.LastUpdated}}
+</div>
+</div>
+</body>
+</html>`
+			verify(GinkgoT(), expectedResult, actualContent, renderer.IncludeHeaderFooter(true), renderer.LastUpdated(time.Now()))
+		})
+	})
 })
@@ -0,0 +1,68 @@
+package html5_test
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer/html5"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	. "github.com/onsi/ginkgo"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var _ = Describe("rendering errors", func() {
+
+	It("error with the location of the innermost element", func() {
+		// given
+		doc := types.Document{
+			Attributes:        types.DocumentAttributes{},
+			ElementReferences: types.ElementReferences{},
+			Elements: []interface{}{
+				types.Section{
+					Level: 1,
+					Title: types.SectionTitle{
+						Attributes: map[string]interface{}{
+							types.AttrID: "_section_a",
+						},
+						Content: types.InlineElements{
+							types.StringElement{Content: "Section A"},
+						},
+					},
+					Elements: []interface{}{
+						types.Paragraph{
+							Attributes: map[string]interface{}{},
+							Lines: []types.InlineElements{
+								{
+									types.StringElement{Content: "a paragraph with an "},
+									types.DocumentAuthor{FullName: "unexpected element"},
+								},
+							},
+							Location: types.Location{
+								File:  "test.adoc",
+								Start: types.Position{Line: 3, Col: 1, Offset: 14},
+								End:   types.Position{Line: 3, Col: 10, Offset: 23},
+							},
+						},
+					},
+					Location: types.Location{
+						File:  "test.adoc",
+						Start: types.Position{Line: 1, Col: 1, Offset: 0},
+						End:   types.Position{Line: 3, Col: 10, Offset: 23},
+					},
+				},
+			},
+		}
+		// when
+		_, err := html5.Render(renderer.Wrap(context.Background(), doc), bytes.NewBuffer(nil))
+		// then
+		require.Error(GinkgoT(), err)
+		locationErr, ok := errors.Cause(err).(types.LocationError)
+		require.True(GinkgoT(), ok)
+		assert.Equal(GinkgoT(), "test.adoc:3:1", locationErr.Location.String())
+		assert.Contains(GinkgoT(), err.Error(), "test.adoc:3:1: ")
+		assert.Contains(GinkgoT(), err.Error(), "unsupported type of element: types.DocumentAuthor")
+	})
+})
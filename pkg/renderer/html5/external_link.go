@@ -14,7 +14,7 @@ var linkTmpl template.Template
 
 // initializes the templates
 func init() {
-	linkTmpl = newHTMLTemplate("external link", `<a href="{{ .URL }}"{{if .Class}} class="{{ .Class }}"{{ end }}>{{ .Text }}</a>`)
+	linkTmpl = newHTMLTemplate("link", `<a href="{{ .URL }}"{{if .Class}} class="{{ .Class }}"{{ end }}>{{ .Text }}</a>`)
 }
 
 func renderLink(ctx *renderer.Context, l types.Link) ([]byte, error) {
@@ -26,7 +26,12 @@ func renderLink(ctx *renderer.Context, l types.Link) ([]byte, error) {
 		text = l.URL
 		class = "bare"
 	}
-	err := linkTmpl.Execute(result, struct {
+	if !sanitizer(ctx).AllowURL(l.URL) {
+		// the link is replaced by its text
+		ctx.Diagnostics().Warnf("unsafe-url", l.Location, "the '%s' URL is not allowed by the sanitizer policy", l.URL)
+		return []byte(template.HTMLEscapeString(text)), nil
+	}
+	err := executeTemplate(ctx, &linkTmpl, result, struct {
 		URL   string
 		Text  string
 		Class string
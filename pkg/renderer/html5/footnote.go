@@ -0,0 +1,85 @@
+package html5
+
+import (
+	"bytes"
+	texttemplate "text/template"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+var footnoteTmpl texttemplate.Template
+var footnoteRefTmpl texttemplate.Template
+var invalidFootnoteTmpl texttemplate.Template
+var footnotesTmpl texttemplate.Template
+
+// initializes the templates
+func init() {
+	footnoteTmpl = newTextTemplate("footnote", `{{ with .Data }}<sup class="footnote"{{ if .Ref }} id="_footnote_{{ html .Ref }}"{{ end }}>[<a id="_footnoteref_{{ .ID }}" class="footnote" href="#_footnotedef_{{ .ID }}" title="View footnote.">{{ .ID }}</a>]</sup>{{ end }}`)
+	footnoteRefTmpl = newTextTemplate("footnote-ref", `{{ with .Data }}<sup class="footnoteref">[<a class="footnote" href="#_footnotedef_{{ .ID }}" title="View footnote.">{{ .ID }}</a>]</sup>{{ end }}`)
+	invalidFootnoteTmpl = newTextTemplate("invalid-footnote", `{{ with .Data }}<sup class="footnoteref red" title="Unresolved footnote reference.">[{{ html .Ref }}]</sup>{{ end }}`)
+	footnotesTmpl = newTextTemplate("footnotes", `{{ with .Data }}<div id="footnotes">
+<hr>{{ range . }}
+<div class="footnote" id="_footnotedef_{{ .ID }}">
+<a href="#_footnoteref_{{ .ID }}">{{ .ID }}</a>. {{ .Content }}
+</div>{{ end }}
+</div>{{ end }}`)
+}
+
+// renderFootnote renders the given footnote, with the number which was set when the footnotes
+// were collected in the document
+func renderFootnote(ctx *renderer.Context, f types.Footnote) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	tmpl := footnoteTmpl
+	if f.Ref != "" {
+		if _, found := ctx.Document.FootnoteReferences[f.Ref]; !found {
+			ctx.Diagnostics().Warnf("unresolved-footnote-ref", f.Location, "unable to resolve footnote reference '%s'", f.Ref)
+			tmpl = invalidFootnoteTmpl
+		} else if f.ID == 0 {
+			// the reference is used before the definition of the footnote (which was already reported)
+			tmpl = invalidFootnoteTmpl
+		} else if len(f.Elements) == 0 {
+			// reference to a footnote which was already defined
+			tmpl = footnoteRefTmpl
+		}
+	}
+	log.Debugf("rendering footnote #%d (ref='%s')", f.ID, f.Ref)
+	err := executeTemplate(ctx, &tmpl, result, ContextualPipeline{
+		Context: ctx,
+		Data:    f,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render footnote")
+	}
+	return result.Bytes(), nil
+}
+
+// renderFootnotes renders the `footnotes` section with all the footnotes of the document, if any
+func renderFootnotes(ctx *renderer.Context) ([]byte, error) {
+	if len(ctx.Document.Footnotes) == 0 {
+		return nil, nil
+	}
+	footnotes := make([]struct {
+		ID      int
+		Content string
+	}, len(ctx.Document.Footnotes))
+	for i, f := range ctx.Document.Footnotes {
+		content, err := renderInlineElements(ctx, f.Elements)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render footnotes")
+		}
+		footnotes[i].ID = f.ID
+		footnotes[i].Content = string(content)
+	}
+	result := bytes.NewBuffer(nil)
+	err := executeTemplate(ctx, &footnotesTmpl, result, ContextualPipeline{
+		Context: ctx,
+		Data:    footnotes,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render footnotes")
+	}
+	return result.Bytes(), nil
+}
@@ -0,0 +1,117 @@
+package html5_test
+
+import . "github.com/onsi/ginkgo"
+
+var _ = Describe("footnotes", func() {
+
+	It("basic footnote in a paragraph", func() {
+		actualContent := `foo footnote:[a note for foo]`
+		expectedResult := `<div class="paragraph">
+<p>foo <sup class="footnote">[<a id="_footnoteref_1" class="footnote" href="#_footnotedef_1" title="View footnote.">1</a>]</sup></p>
+</div>
+<div id="footnotes">
+<hr>
+<div class="footnote" id="_footnotedef_1">
+<a href="#_footnoteref_1">1</a>. a note for foo
+</div>
+</div>`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+
+	It("footnotes with references and rich content", func() {
+		actualContent := `a sentence.footnote:[a *strong* note]
+
+== section 1
+
+bar footnote:disclaimer[a disclaimer] and baz footnoteref:[disclaimer] and qux footnote:unknown[]`
+		expectedResult := `<div id="preamble">
+<div class="sectionbody">
+<div class="paragraph">
+<p>a sentence.<sup class="footnote">[<a id="_footnoteref_1" class="footnote" href="#_footnotedef_1" title="View footnote.">1</a>]</sup></p>
+</div>
+</div>
+</div>
+<div class="sect1">
+<h2 id="_section_1">section 1</h2>
+<div class="sectionbody">
+<div class="paragraph">
+<p>bar <sup class="footnote" id="_footnote_disclaimer">[<a id="_footnoteref_2" class="footnote" href="#_footnotedef_2" title="View footnote.">2</a>]</sup> and baz <sup class="footnoteref">[<a class="footnote" href="#_footnotedef_2" title="View footnote.">2</a>]</sup> and qux <sup class="footnoteref red" title="Unresolved footnote reference.">[unknown]</sup></p>
+</div>
+</div>
+</div>
+<div id="footnotes">
+<hr>
+<div class="footnote" id="_footnotedef_1">
+<a href="#_footnoteref_1">1</a>. a <strong>strong</strong> note
+</div>
+<div class="footnote" id="_footnotedef_2">
+<a href="#_footnoteref_2">2</a>. a disclaimer
+</div>
+</div>`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+
+	It("footnote in a table cell", func() {
+		actualContent := `|===
+| cookie.footnote:[a note for the cookie] | pasta
+|===`
+		expectedResult := `<table class="tableblock frame-all grid-all stretch">
+<colgroup>
+<col style="width: 50%;">
+<col style="width: 50%;">
+</colgroup>
+<tbody>
+<tr>
+<td class="tableblock halign-left valign-top"><p class="tableblock">cookie.<sup class="footnote">[<a id="_footnoteref_1" class="footnote" href="#_footnotedef_1" title="View footnote.">1</a>]</sup></p></td>
+<td class="tableblock halign-left valign-top"><p class="tableblock">pasta</p></td>
+</tr>
+</tbody>
+</table>
+<div id="footnotes">
+<hr>
+<div class="footnote" id="_footnotedef_1">
+<a href="#_footnoteref_1">1</a>. a note for the cookie
+</div>
+</div>`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+
+	It("footnote in a section title with a table of contents", func() {
+		actualContent := `= Title
+:toc:
+
+[[sec]]
+== section footnote:[a note for the section]
+
+foo footnote:[a note for foo]`
+		expectedResult := `<div id="toc" class="toc">
+<div id="toctitle">Table of Contents</div>
+<ul class="sectlevel1">
+<li><a href="#sec">section <sup class="footnote">[<a id="_footnoteref_1" class="footnote" href="#_footnotedef_1" title="View footnote.">1</a>]</sup></a></li>
+</ul>
+</div>
+<div id="preamble">
+<div class="sectionbody">
+
+</div>
+</div>
+<div class="sect1">
+<h2 id="sec">section <sup class="footnote">[<a id="_footnoteref_1" class="footnote" href="#_footnotedef_1" title="View footnote.">1</a>]</sup></h2>
+<div class="sectionbody">
+<div class="paragraph">
+<p>foo <sup class="footnote">[<a id="_footnoteref_2" class="footnote" href="#_footnotedef_2" title="View footnote.">2</a>]</sup></p>
+</div>
+</div>
+</div>
+<div id="footnotes">
+<hr>
+<div class="footnote" id="_footnotedef_1">
+<a href="#_footnoteref_1">1</a>. a note for the section
+</div>
+<div class="footnote" id="_footnotedef_2">
+<a href="#_footnoteref_2">2</a>. a note for foo
+</div>
+</div>`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+})
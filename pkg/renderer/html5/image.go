@@ -2,7 +2,14 @@ package html5
 
 import (
 	"bytes"
+	"encoding/base64"
+	"fmt"
 	"html/template"
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+	"regexp"
+	"strings"
 
 	"github.com/bytesparadise/libasciidoc/pkg/renderer"
 	"github.com/bytesparadise/libasciidoc/pkg/types"
@@ -10,54 +17,76 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
+const (
+	// AttrDataURI the attribute which makes the local images be embedded in the documents, as `data:` URIs
+	AttrDataURI string = "data-uri"
+	// AttrImagesDir the attribute which sets the directory of the images, relative to the document (or the base URL of the images)
+	AttrImagesDir string = "imagesdir"
+	// AttrFigureCaption the attribute which sets the label of the numbered block images with a title (default is `Figure`)
+	AttrFigureCaption string = "figure-caption"
+)
+
 var blockImageTmpl template.Template
 var inlineImageTmpl template.Template
 
 // initializes the templates
 func init() {
-	blockImageTmpl = newHTMLTemplate("block image", `<div{{ if ne .ID "" }} id="{{ .ID }}"{{ end }} class="imageblock">
+	blockImageTmpl = newHTMLTemplate("block-image", `<div{{ if ne .ID "" }} id="{{ .ID }}"{{ end }} class="{{ .Class }}">
 <div class="content">
-{{ if ne .Link "" }}<a class="image" href="{{ .Link }}">{{ end}}<img src="{{ .Macro.Path }}" alt="{{ .Macro.Alt }}"{{ if .Macro.Width }} width="{{ .Macro.Width }}"{{ end }}{{ if .Macro.Height }} height="{{ .Macro.Height }}"{{ end }}>{{ if ne .Link "" }}</a>{{ end }}
+{{ if ne .Link "" }}<a class="image" href="{{ .Link }}">{{ end}}<img src="{{ .Src }}" alt="{{ .Macro.Alt }}"{{ if .Macro.Width }} width="{{ .Macro.Width }}"{{ end }}{{ if .Macro.Height }} height="{{ .Macro.Height }}"{{ end }}>{{ if ne .Link "" }}</a>{{ end }}
 </div>{{ if ne .Title "" }}
-<div class="doctitle">{{ .Title }}</div>
+<div class="title">{{ .Title }}</div>
 {{ else }}
 {{ end }}</div>`)
-	inlineImageTmpl = newHTMLTemplate("inline image", `<span class="image"><img src="{{.Macro.Path}}" alt="{{.Macro.Alt}}"{{if .Macro.Width}} width="{{.Macro.Width}}"{{end}}{{if .Macro.Height}} height="{{.Macro.Height}}"{{end}}></span>`)
+	inlineImageTmpl = newHTMLTemplate("inline-image", `<span class="{{ .Class }}">{{ if ne .Link "" }}<a class="image" href="{{ .Link }}">{{ end }}<img src="{{.Src}}" alt="{{.Macro.Alt}}"{{if .Macro.Width}} width="{{.Macro.Width}}"{{end}}{{if .Macro.Height}} height="{{.Macro.Height}}"{{end}}{{ if .Macro.Title }} title="{{ .Macro.Title }}"{{ end }}>{{ if ne .Link "" }}</a>{{ end }}</span>`)
 }
 
 func renderBlockImage(ctx *renderer.Context, img types.BlockImage) ([]byte, error) {
 	result := bytes.NewBuffer(nil)
-	var id, title, link string
+	var id, title string
 	if i, ok := img.Attributes[types.AttrID].(string); ok {
 		id = i
 	}
+	// the title of the block takes precedence over the `title` attribute of the macro
 	if t, ok := img.Attributes[types.AttrTitle].(string); ok {
 		title = t
+	} else {
+		title = img.Macro.Title()
+	}
+	if title != "" {
+		if caption := figureCaption(ctx); caption != "" {
+			title = fmt.Sprintf("%s %d. %s", caption, ctx.GetAndIncrementFigureCounter(), title)
+		}
 	}
+	link := img.Macro.Link()
 	if l, ok := img.Attributes[types.AttrLink].(string); ok {
 		link = l
 	}
-	err := blockImageTmpl.Execute(result, struct {
+	class := "imageblock"
+	if float := img.Macro.Float(); float != "" {
+		class = class + " " + float
+	}
+	if align := img.Macro.Align(); align != "" {
+		class = class + " text-" + align
+	}
+	if role := img.Macro.Role(); role != "" {
+		class = class + " " + role
+	}
+	err := executeTemplate(ctx, &blockImageTmpl, result, struct {
 		ID    string
+		Class string
 		Title string
 		Link  string
+		Src   interface{}
 		Macro types.ImageMacro
 	}{
 		ID:    id,
+		Class: class,
 		Title: title,
-		Link:  link,
+		Link:  imageLink(ctx, link, img.Location),
+		Src:   imageSrc(ctx, img.Macro.Path, img.Location),
 		Macro: img.Macro,
 	})
-
-	// var alt string
-	// width := attributes["width"].(string)
-	// height := attributes["height"].(string)
-	// for k, v := range attributes {
-	// 	if v == nil {
-	// 		alt = k
-	// 	}
-	// }
-
 	if err != nil {
 		return nil, errors.Wrapf(err, "unable to render block image")
 	}
@@ -67,10 +96,113 @@ func renderBlockImage(ctx *renderer.Context, img types.BlockImage) ([]byte, erro
 
 func renderInlineImage(ctx *renderer.Context, img types.InlineImage) ([]byte, error) {
 	result := bytes.NewBuffer(nil)
-	err := inlineImageTmpl.Execute(result, img)
+	class := "image"
+	if float := img.Macro.Float(); float != "" {
+		class = class + " " + float
+	}
+	if role := img.Macro.Role(); role != "" {
+		class = class + " " + role
+	}
+	err := executeTemplate(ctx, &inlineImageTmpl, result, struct {
+		types.InlineImage
+		Class string
+		Link  string
+		Src   interface{}
+	}{
+		InlineImage: img,
+		Class:       class,
+		Link:        imageLink(ctx, img.Macro.Link(), img.Location),
+		Src:         imageSrc(ctx, img.Macro.Path, img.Location),
+	})
 	if err != nil {
 		return nil, errors.Wrapf(err, "unable to render inline image")
 	}
 	log.Debugf("rendered inline image: %s", result.Bytes())
 	return result.Bytes(), nil
 }
+
+// figureCaption returns the label of the block images with a title, which is empty if the `figure-caption` attribute was unset
+func figureCaption(ctx *renderer.Context) string {
+	if caption := ctx.Document.Attributes.GetAsString(AttrFigureCaption); caption != nil {
+		return *caption
+	}
+	if o, found := ctx.Attributes()[AttrFigureCaption]; found && o.Unset {
+		return ""
+	}
+	return "Figure"
+}
+
+// imageLink returns the given link target, or an empty string (and a warning) if the URL is not allowed by the sanitizer policy
+func imageLink(ctx *renderer.Context, link string, location types.Location) string {
+	if link != "" && !sanitizer(ctx).AllowURL(link) {
+		ctx.Diagnostics().Warnf("unsafe-url", location, "the '%s' URL is not allowed by the sanitizer policy", link)
+		return ""
+	}
+	return link
+}
+
+// imagePath returns the path of the image with the given target, relative to the `imagesdir` directory,
+// unless the target is an absolute path or a URL
+func imagePath(ctx *renderer.Context, target string) string {
+	dir := ctx.Document.Attributes.GetAsString(AttrImagesDir)
+	if dir == nil || *dir == "" || strings.HasPrefix(target, "/") || strings.Contains(target, "://") || strings.HasPrefix(target, "data:") {
+		return target
+	}
+	return strings.TrimSuffix(*dir, "/") + "/" + target
+}
+
+// imageSrc returns the `src` of the image at the given path, which is the path itself (relative to the `imagesdir` directory),
+// or the content of the image as a `data:` URI when the `data-uri` attribute is set and the image is a local file
+func imageSrc(ctx *renderer.Context, target string, location types.Location) interface{} {
+	path := imagePath(ctx, target)
+	if _, found := ctx.Document.Attributes[AttrDataURI]; !found || strings.Contains(path, "://") || strings.HasPrefix(path, "data:") {
+		// the remote images are not embedded
+		return path
+	}
+	file := path
+	if !filepath.IsAbs(file) {
+		file = filepath.Join(filepath.Dir(ctx.Filename()), filepath.FromSlash(file))
+	}
+	if baseDir := filepath.Dir(ctx.Filename()); ctx.SafeMode() >= renderer.SafeModeSafe && !renderer.WithinDir(baseDir, file) {
+		ctx.Diagnostics().Warnf("unsafe-image", location, "unable to embed the image '%s': the file is outside of the '%s' directory in the '%s' safe mode", path, baseDir, ctx.SafeMode())
+		return path
+	}
+	content, err := readImage(ctx, file)
+	if err != nil {
+		ctx.Diagnostics().Warnf("unresolved-image", location, "unable to embed the image '%s': %v", path, err)
+		return path
+	}
+	mimeType := imageMIMEType(content)
+	if !strings.HasPrefix(mimeType, "image/") {
+		ctx.Diagnostics().Warnf("unresolved-image", location, "unable to embed the image '%s': unsupported content type '%s'", path, mimeType)
+		return path
+	}
+	// the `data:` URIs are rejected by the `html/template` package unless they are explicitly trusted
+	return template.URL("data:" + mimeType + ";base64," + base64.StdEncoding.EncodeToString(content))
+}
+
+// readImage reads the image file at the given path
+func readImage(ctx *renderer.Context, path string) ([]byte, error) {
+	log.Debugf("reading the image in %s", path)
+	f, err := ctx.IncludeResolver().Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ioutil.ReadAll(f)
+}
+
+// imageMIMEType returns the MIME type of the given image, sniffed from its content
+func imageMIMEType(content []byte) string {
+	mimeType := http.DetectContentType(content)
+	if strings.HasPrefix(mimeType, "text/") && svgRegexp.Match(content) {
+		// SVG images are not detected by the standard library
+		return "image/svg+xml"
+	}
+	if i := strings.Index(mimeType, ";"); i >= 0 {
+		mimeType = mimeType[:i]
+	}
+	return mimeType
+}
+
+var svgRegexp = regexp.MustCompile(`(?s)^\s*(?:<\?xml[^>]*>\s*)?(?:<!--.*?-->\s*)*(?:<!DOCTYPE svg[^>]*>\s*)?<svg[\s>]`)
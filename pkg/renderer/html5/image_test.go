@@ -1,6 +1,19 @@
 package html5_test
 
-import . "github.com/onsi/ginkgo"
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/parser"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer/html5"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	. "github.com/onsi/ginkgo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
 
 var _ = Describe("Images", func() {
 	Context("block Images", func() {
@@ -44,7 +57,163 @@ var _ = Describe("Images", func() {
 			<div class="content">
 			<a class="image" href="http://foo.bar"><img src="images/foo.png" alt="the foo.png image" width="600" height="400"></a>
 			</div>
-			<div class="doctitle">A title to foobar</div>
+			<div class="title">Figure 1. A title to foobar</div>
+			</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+	})
+
+	Context("block images with named attributes", func() {
+
+		It("block image with link, align, float and role", func() {
+			actualContent := "image::foo.png[foo image, link=https://example.com, align=center, float=right, role=thumb]"
+			expectedResult := `<div class="imageblock right text-center thumb">
+			<div class="content">
+			<a class="image" href="https://example.com"><img src="foo.png" alt="foo image"></a>
+			</div>
+			</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("block image with link in element attributes and in macro", func() {
+			actualContent := "[link=https://example.com/block]\nimage::foo.png[foo image, link=https://example.com/macro]"
+			expectedResult := `<div class="imageblock">
+			<div class="content">
+			<a class="image" href="https://example.com/block"><img src="foo.png" alt="foo image"></a>
+			</div>
+			</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("block image with unsafe link", func() {
+			actualContent := "image::foo.png[foo image, link=javascript:alert(1)]"
+			expectedResult := `<div class="imageblock">
+			<div class="content">
+			<img src="foo.png" alt="foo image">
+			</div>
+			</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+	})
+
+	Context("figure captions", func() {
+
+		It("block images with titles", func() {
+			actualContent := `.The first image
+image::foo.png[]
+
+image::bar.png[]
+
+image::baz.png[title="The third image, without a block title"]`
+			expectedResult := `<div class="imageblock">
+			<div class="content">
+			<img src="foo.png" alt="foo">
+			</div>
+			<div class="title">Figure 1. The first image</div>
+			</div>
+			<div class="imageblock">
+			<div class="content">
+			<img src="bar.png" alt="bar">
+			</div>
+			</div>
+			<div class="imageblock">
+			<div class="content">
+			<img src="baz.png" alt="baz">
+			</div>
+			<div class="title">Figure 2. The third image, without a block title</div>
+			</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("block image with title in element attributes and in macro", func() {
+			actualContent := ".The block title\nimage::foo.png[title=The macro title]"
+			expectedResult := `<div class="imageblock">
+			<div class="content">
+			<img src="foo.png" alt="foo">
+			</div>
+			<div class="title">Figure 1. The block title</div>
+			</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("block images with custom and unset figure caption", func() {
+			actualContent := `:figure-caption: Fig.
+
+.The first image
+image::foo.png[]
+
+:figure-caption!:
+
+.The second image
+image::bar.png[]`
+			expectedResult := `<div class="imageblock">
+			<div class="content">
+			<img src="foo.png" alt="foo">
+			</div>
+			<div class="title">Fig. 1. The first image</div>
+			</div>
+			<div class="imageblock">
+			<div class="content">
+			<img src="bar.png" alt="bar">
+			</div>
+			<div class="title">The second image</div>
+			</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("block image with figure caption unset from the API", func() {
+			actualContent := ".The image\nimage::foo.png[]"
+			expectedResult := `<div class="imageblock">
+			<div class="content">
+			<img src="foo.png" alt="foo">
+			</div>
+			<div class="title">The image</div>
+			</div>`
+			verify(GinkgoT(), expectedResult, actualContent, renderer.Attributes(map[string]string{"figure-caption!": ""}))
+		})
+	})
+
+	Context("images directory", func() {
+
+		It("block and inline images with relative paths", func() {
+			actualContent := `:imagesdir: assets/images/
+
+image::foo.png[]
+
+an image:bar.png[] here`
+			expectedResult := `<div class="imageblock">
+			<div class="content">
+			<img src="assets/images/foo.png" alt="foo">
+			</div>
+			</div>
+			<div class="paragraph">
+			<p>an <span class="image"><img src="assets/images/bar.png" alt="bar"></span> here</p>
+			</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("block images with absolute paths and URLs", func() {
+			actualContent := `:imagesdir: https://example.com/images
+
+image::foo.png[]
+
+image::/srv/bar.png[]
+
+image::https://example.org/baz.png[]`
+			expectedResult := `<div class="imageblock">
+			<div class="content">
+			<img src="https://example.com/images/foo.png" alt="foo">
+			</div>
+			</div>
+			<div class="imageblock">
+			<div class="content">
+			<img src="/srv/bar.png" alt="bar">
+			</div>
+			</div>
+			<div class="imageblock">
+			<div class="content">
+			<img src="https://example.org/baz.png" alt="baz">
+			</div>
 			</div>`
 			verify(GinkgoT(), expectedResult, actualContent)
 		})
@@ -86,6 +255,17 @@ var _ = Describe("Images", func() {
 			})
 		})
 
+		Context("inline images with named attributes", func() {
+
+			It("inline image with link, title, float and role", func() {
+				actualContent := "an image:foo.png[foo image, link=https://example.com, title=The foo, float=left, role=icon] here"
+				expectedResult := `<div class="paragraph">
+				<p>an <span class="image left icon"><a class="image" href="https://example.com"><img src="foo.png" alt="foo image" title="The foo"></a></span> here</p>
+				</div>`
+				verify(GinkgoT(), expectedResult, actualContent)
+			})
+		})
+
 		Context("invalid Inline Images", func() {
 
 			It("paragraph with block image with alt and dimensions", func() {
@@ -97,4 +277,127 @@ var _ = Describe("Images", func() {
 			})
 		})
 	})
+
+	Context("data URIs", func() {
+
+		images := inMemoryResolver{
+			filepath.Join("images", "foo.png"):         "\x89PNG\r\n\x1a\nfoo",
+			filepath.Join("images", "bar.gif"):         "GIF89a",
+			"logo.svg":                                 `<svg xmlns="http://www.w3.org/2000/svg"/>`,
+			"notes.txt":                                "some notes",
+			filepath.Join("docs", "images", "foo.png"): "\x89PNG\r\n\x1a\nfoo",
+		}
+
+		It("block image embedded as data URI", func() {
+			actualContent := `:data-uri:
+:imagesdir: images
+
+image::foo.png[]`
+			expectedResult := `<div class="imageblock">
+<div class="content">
+<img src="data:image/png;base64,iVBORw0KGgpmb28=" alt="foo">
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent, renderer.IncludeResolver(images))
+		})
+
+		It("inline images embedded as data URIs", func() {
+			actualContent := `:data-uri:
+
+a image:images/bar.gif[] and a image:logo.svg[logo]`
+			expectedResult := `<div class="paragraph">
+<p>a <span class="image"><img src="data:image/gif;base64,R0lGODlh" alt="bar"></span> and a <span class="image"><img src="data:image/svg&#43;xml;base64,PHN2ZyB4bWxucz0iaHR0cDovL3d3dy53My5vcmcvMjAwMC9zdmciLz4=" alt="logo"></span></p>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent, renderer.IncludeResolver(images))
+		})
+
+		It("images not embedded without data-uri attribute", func() {
+			actualContent := `image::logo.svg[]`
+			expectedResult := `<div class="imageblock">
+<div class="content">
+<img src="logo.svg" alt="logo">
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent, renderer.IncludeResolver(images))
+		})
+
+		It("remote image not embedded", func() {
+			actualContent := `:data-uri:
+
+image::https://example.com/logo.svg[]`
+			expectedResult := `<div class="imageblock">
+<div class="content">
+<img src="https://example.com/logo.svg" alt="logo">
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent, renderer.IncludeResolver(images))
+		})
+
+		It("image relative to the source file", func() {
+			doc, err := parser.ParseReader("", strings.NewReader(`:data-uri:
+:imagesdir: images
+
+image::foo.png[]`))
+			require.NoError(GinkgoT(), err)
+			ctx := renderer.Wrap(context.Background(), doc.(types.Document), renderer.IncludeResolver(images))
+			ctx.SetFilename(filepath.Join("docs", "index.adoc"))
+			buff := bytes.NewBuffer(nil)
+			_, err = html5.Render(ctx, buff)
+			require.NoError(GinkgoT(), err)
+			assert.Contains(GinkgoT(), buff.String(), `<img src="data:image/png;base64,iVBORw0KGgpmb28=" alt="foo">`)
+			assert.Empty(GinkgoT(), *ctx.Diagnostics())
+		})
+
+		It("images outside of the document directory not embedded in safe modes", func() {
+			source := `:data-uri:
+
+image::images/foo.png[]
+
+image::../images/foo.png[]
+
+image::` + filepath.Join(string(filepath.Separator), "images", "bar.gif") + `[]`
+			render := func(mode renderer.SafeMode) (string, *renderer.Context) {
+				doc, err := parser.ParseReader("", strings.NewReader(source))
+				require.NoError(GinkgoT(), err)
+				ctx := renderer.Wrap(context.Background(), doc.(types.Document), renderer.IncludeResolver(images), renderer.Safe(mode))
+				ctx.SetFilename(filepath.Join("docs", "index.adoc"))
+				buff := bytes.NewBuffer(nil)
+				_, err = html5.Render(ctx, buff)
+				require.NoError(GinkgoT(), err)
+				return buff.String(), ctx
+			}
+			// in the unsafe mode, all the local images are embedded
+			output, ctx := render(renderer.SafeModeUnsafe)
+			assert.NotContains(GinkgoT(), output, `src="../images/foo.png"`)
+			assert.Len(GinkgoT(), *ctx.Diagnostics(), 1) // the absolute path is not in the resolver
+			// in the server mode, only the images in the directory of the document are embedded
+			output, ctx = render(renderer.SafeModeServer)
+			assert.Contains(GinkgoT(), output, `<img src="data:image/png;base64,iVBORw0KGgpmb28=" alt="foo">`)
+			assert.Contains(GinkgoT(), output, `<img src="../images/foo.png" alt="foo">`)
+			assert.Contains(GinkgoT(), output, `alt="bar">`)
+			assert.NotContains(GinkgoT(), output, "R0lGODlh")
+			require.Len(GinkgoT(), *ctx.Diagnostics(), 2)
+			assert.Equal(GinkgoT(), "unsafe-image", (*ctx.Diagnostics())[0].Rule)
+			assert.Equal(GinkgoT(), "unsafe-image", (*ctx.Diagnostics())[1].Rule)
+		})
+
+		It("unresolved and invalid images reported in diagnostics", func() {
+			doc, err := parser.ParseReader("", strings.NewReader(`:data-uri:
+
+image::unknown.gif[]
+
+image::notes.txt[]`))
+			require.NoError(GinkgoT(), err)
+			ctx := renderer.Wrap(context.Background(), doc.(types.Document), renderer.IncludeResolver(images))
+			buff := bytes.NewBuffer(nil)
+			_, err = html5.Render(ctx, buff)
+			require.NoError(GinkgoT(), err)
+			assert.Contains(GinkgoT(), buff.String(), `<img src="unknown.gif" alt="unknown">`)
+			assert.Contains(GinkgoT(), buff.String(), `<img src="notes.txt" alt="notes">`)
+			require.Len(GinkgoT(), *ctx.Diagnostics(), 2)
+			assert.Equal(GinkgoT(), "unresolved-image", (*ctx.Diagnostics())[0].Rule)
+			assert.Equal(GinkgoT(), "unresolved-image", (*ctx.Diagnostics())[1].Rule)
+			assert.Contains(GinkgoT(), (*ctx.Diagnostics())[1].Message, "unsupported content type 'text/plain'")
+		})
+	})
 })
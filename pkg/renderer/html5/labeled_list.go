@@ -15,7 +15,7 @@ var horizontalLabeledListTmpl texttemplate.Template
 
 // initializes the templates
 func init() {
-	defaultLabeledListTmpl = newTextTemplate("labeled list with default layout",
+	defaultLabeledListTmpl = newTextTemplate("labeled-list",
 		`{{ $ctx := .Context }}{{ with .Data }}<div{{ if hasID .Attributes }} id="{{ getID .Attributes }}"{{ end }} class="dlist">
 <dl>
 {{ $items := .Items }}{{ range $itemIndex, $item := $items }}<dt class="hdlist1">{{ $item.Term }}</dt>{{ if $item.Elements }}
@@ -32,7 +32,7 @@ func init() {
 			"getID":          getID,
 		})
 
-	horizontalLabeledListTmpl = newTextTemplate("labeled list with horizontal layout",
+	horizontalLabeledListTmpl = newTextTemplate("horizontal-labeled-list",
 		`{{ $ctx := .Context }}{{ with .Data }}<div{{ if hasID .Attributes }} id="{{ getID .Attributes }}"{{ end }} class="hdlist">
 <table>
 <tr>
@@ -79,7 +79,7 @@ func renderLabeledList(ctx *renderer.Context, l types.LabeledList) ([]byte, erro
 
 	result := bytes.NewBuffer(nil)
 	// here we must preserve the HTML tags
-	err := tmpl.Execute(result, ContextualPipeline{
+	err := executeTemplate(ctx, &tmpl, result, ContextualPipeline{
 		Context: ctx,
 		Data:    l,
 	})
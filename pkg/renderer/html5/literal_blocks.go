@@ -14,7 +14,7 @@ var literalBlockTmpl template.Template
 
 // initializes the templates
 func init() {
-	literalBlockTmpl = newHTMLTemplate("literal block", `<div class="literalblock">
+	literalBlockTmpl = newHTMLTemplate("literal-block", `<div class="literalblock">
 <div class="content">
 <pre>{{.Content}}</pre>
 </div>
@@ -24,7 +24,7 @@ func init() {
 func renderLiteralBlock(ctx *renderer.Context, b types.LiteralBlock) ([]byte, error) {
 	log.Debugf("rendering delimited block with content: %s", b.Content)
 	result := bytes.NewBuffer(nil)
-	err := literalBlockTmpl.Execute(result, b)
+	err := executeTemplate(ctx, &literalBlockTmpl, result, b)
 	if err != nil {
 		return nil, errors.Wrapf(err, "unable to render delimited block")
 	}
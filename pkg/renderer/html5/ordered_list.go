@@ -14,7 +14,7 @@ var orderedListTmpl texttemplate.Template
 
 // initializes the templates
 func init() {
-	orderedListTmpl = newTextTemplate("ordered list",
+	orderedListTmpl = newTextTemplate("ordered-list",
 		`{{ $ctx := .Context }}{{ with .Data }}{{ $items := .Items }}{{ $firstItem := index $items 0 }}<div{{ if hasID .Attributes }} id="{{ getID .Attributes }}"{{ end }} class="olist {{ $firstItem.NumberingStyle }}">
 <ol class="{{ $firstItem.NumberingStyle }}"{{ style $firstItem.NumberingStyle }}>
 {{ range $itemIndex, $item := $items }}<li>
@@ -41,7 +41,7 @@ func renderOrderedList(ctx *renderer.Context, l types.OrderedList) ([]byte, erro
 		ctx.SetWithinList(false)
 	}()
 
-	err := orderedListTmpl.Execute(result, ContextualPipeline{
+	err := executeTemplate(ctx, &orderedListTmpl, result, ContextualPipeline{
 		Context: ctx,
 		Data:    l,
 	})
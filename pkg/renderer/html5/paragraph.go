@@ -27,7 +27,7 @@ func init() {
 			"includeNewline": includeNewline,
 		})
 
-	admonitionParagraphTmpl = newTextTemplate("admonition paragraph",
+	admonitionParagraphTmpl = newTextTemplate("admonition-paragraph",
 		`{{ $ctx := .Context }}{{ with .Data }}{{ $renderedElements := renderElements $ctx .Lines | printf "%s"  }}{{ if ne $renderedElements "" }}<div {{ if .ID }}id="{{ .ID }}" {{ end }}class="admonitionblock {{ .Class }}">
 <table>
 <tr>
@@ -46,14 +46,14 @@ func init() {
 			"includeNewline": includeNewline,
 		})
 
-	admonitionParagraphContentTmpl = newTextTemplate("admonition paragraph content",
+	admonitionParagraphContentTmpl = newTextTemplate("admonition-paragraph-content",
 		`{{ $ctx := .Context }}{{ with .Data }}{{ $lines := .Lines }}{{ range $index, $line := $lines }}{{ renderElement $ctx $line | printf "%s" }}{{ if includeNewline $ctx $index $lines }}{{ print "\n" }}{{ end }}{{ end }}{{ end }}`,
 		texttemplate.FuncMap{
 			"renderElement":  renderElement,
 			"includeNewline": includeNewline,
 		})
 
-	listParagraphTmpl = newTextTemplate("list paragraph",
+	listParagraphTmpl = newTextTemplate("list-paragraph",
 		`{{ $ctx := .Context }}{{ with .Data }}<p>{{ $lines := .Lines }}{{ range $index, $line := $lines }}{{ renderElement $ctx $line | printf "%s" }}{{ if includeNewline $ctx $index $lines }}{{ print "\n" }}{{ end }}{{ end }}</p>{{ end }}`,
 		texttemplate.FuncMap{
 			"renderElement":  renderElement,
@@ -79,7 +79,7 @@ func renderParagraph(ctx *renderer.Context, p types.Paragraph) ([]byte, error) {
 		if !ok {
 			return nil, errors.Errorf("failed to render admonition with unknown kind: %T", p.Attributes[types.AttrAdmonitionKind])
 		}
-		err = admonitionParagraphTmpl.Execute(result, ContextualPipeline{
+		err = executeTemplate(ctx, &admonitionParagraphTmpl, result, ContextualPipeline{
 			Context: ctx,
 			Data: struct {
 				ID    string
@@ -97,7 +97,7 @@ func renderParagraph(ctx *renderer.Context, p types.Paragraph) ([]byte, error) {
 		})
 	} else if ctx.WithinDelimitedBlock() || ctx.WithinList() {
 		log.Debug("rendering paragraph within a delimited block or a list")
-		err = listParagraphTmpl.Execute(result, ContextualPipeline{
+		err = executeTemplate(ctx, &listParagraphTmpl, result, ContextualPipeline{
 			Context: ctx,
 			Data: struct {
 				ID    string
@@ -111,7 +111,7 @@ func renderParagraph(ctx *renderer.Context, p types.Paragraph) ([]byte, error) {
 		})
 	} else {
 		log.Debug("rendering a standalone paragraph")
-		err = paragraphTmpl.Execute(result, ContextualPipeline{
+		err = executeTemplate(ctx, &paragraphTmpl, result, ContextualPipeline{
 			Context: ctx,
 			Data: struct {
 				ID    string
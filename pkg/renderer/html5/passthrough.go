@@ -14,14 +14,15 @@ func renderPassthrough(ctx *renderer.Context, p types.Passthrough) ([]byte, erro
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to render passthrough")
 	}
-	switch p.Kind {
-	case types.SinglePlusPassthrough:
-		// rendered passthrough content is in an HTML-escaped form
+	switch {
+	case p.Kind == types.SinglePlusPassthrough, ctx.SafeMode() >= renderer.SafeModeServer:
+		// rendered passthrough content is in an HTML-escaped form (always, in the `server` and `secure` safe modes)
 		buf := bytes.NewBuffer(nil)
 		template.HTMLEscape(buf, renderedContent)
 		return buf.Bytes(), nil
 	default:
-		return renderedContent, nil
+		// the raw content is filtered by the sanitizer policy
+		return []byte(sanitizer(ctx).SanitizeHTML(string(renderedContent))), nil
 	}
 }
 
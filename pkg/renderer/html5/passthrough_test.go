@@ -1,6 +1,9 @@
 package html5_test
 
-import . "github.com/onsi/ginkgo"
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	. "github.com/onsi/ginkgo"
+)
 
 var _ = Describe("passthroughs", func() {
 
@@ -130,4 +133,31 @@ world</p>
 			verify(GinkgoT(), expectedResult, actualContent)
 		})
 	})
+
+	Context("safe modes", func() {
+
+		It("passthroughs escaped in server mode", func() {
+			actualContent := `The text +++<u>underline & me</u>+++ and pass:[<script>alert("xss")</script>] are escaped.`
+			expectedResult := `<div class="paragraph">
+<p>The text &lt;u&gt;underline &amp; me&lt;/u&gt; and &lt;script&gt;alert(&#34;xss&#34;)&lt;/script&gt; are escaped.</p>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent, renderer.Safe(renderer.SafeModeServer))
+		})
+
+		It("passthrough block escaped in secure mode", func() {
+			actualContent := `++++
+<script>alert("xss")</script>
+++++`
+			expectedResult := `&lt;script&gt;alert(&#34;xss&#34;)&lt;/script&gt;`
+			verify(GinkgoT(), expectedResult, actualContent, renderer.Safe(renderer.SafeModeSecure))
+		})
+
+		It("passthroughs not escaped in safe mode", func() {
+			actualContent := `The text +++<u>underline & me</u>+++ is underlined.`
+			expectedResult := `<div class="paragraph">
+<p>The text <u>underline & me</u> is underlined.</p>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent, renderer.Safe(renderer.SafeModeSafe))
+		})
+	})
 })
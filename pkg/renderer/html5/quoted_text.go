@@ -16,9 +16,9 @@ var monospaceTextTmpl template.Template
 
 // initializes the templates
 func init() {
-	boldTextTmpl = newHTMLTemplate("bold text", "<strong>{{.}}</strong>")
-	italicTextTmpl = newHTMLTemplate("italic text", "<em>{{.}}</em>")
-	monospaceTextTmpl = newHTMLTemplate("monospace text", "<code>{{.}}</code>")
+	boldTextTmpl = newHTMLTemplate("bold-text", "<strong>{{.}}</strong>")
+	italicTextTmpl = newHTMLTemplate("italic-text", "<em>{{.}}</em>")
+	monospaceTextTmpl = newHTMLTemplate("monospace-text", "<code>{{.}}</code>")
 }
 
 func renderQuotedText(ctx *renderer.Context, t types.QuotedText) ([]byte, error) {
@@ -45,7 +45,7 @@ func renderQuotedText(ctx *renderer.Context, t types.QuotedText) ([]byte, error)
 	default:
 		return nil, errors.Errorf("unsupported quoted text kind: %v", t.Kind)
 	}
-	err := tmpl.Execute(result, template.HTML(elementsBuffer.String()))
+	err := executeTemplate(ctx, &tmpl, result, template.HTML(elementsBuffer.String()))
 	if err != nil {
 		return nil, errors.Wrapf(err, "unable to render monospaced quote")
 	}
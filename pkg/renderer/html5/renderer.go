@@ -13,11 +13,16 @@ import (
 
 // Render renders the given document in HTML and writes the result in the given `writer`
 func Render(ctx *renderer.Context, output io.Writer) (map[string]interface{}, error) {
+	checkTemplates(ctx)
 	return renderDocument(ctx, output)
 }
 
-func renderElement(ctx *renderer.Context, element interface{}) ([]byte, error) {
+func renderElement(ctx *renderer.Context, element interface{}) (result []byte, err error) {
 	log.Debugf("rendering element of type `%T`", element)
+	defer func() {
+		// report the location of the innermost element which could not be rendered
+		err = types.WrapWithLocation(err, element)
+	}()
 	switch e := element.(type) {
 	case types.TableOfContentsMacro:
 		return renderTableOfContent(ctx, e)
@@ -33,10 +38,16 @@ func renderElement(ctx *renderer.Context, element interface{}) ([]byte, error) {
 		return renderOrderedList(ctx, e)
 	case types.UnorderedList:
 		return renderUnorderedList(ctx, e)
+	case types.CalloutList:
+		return renderCalloutList(ctx, e)
 	case types.Paragraph:
 		return renderParagraph(ctx, e)
 	case types.CrossReference:
 		return renderCrossReference(ctx, e)
+	case types.InlineAnchor:
+		return renderInlineAnchor(ctx, e)
+	case types.Footnote:
+		return renderFootnote(ctx, e)
 	case types.QuotedText:
 		return renderQuotedText(ctx, e)
 	case types.Passthrough:
@@ -49,6 +60,8 @@ func renderElement(ctx *renderer.Context, element interface{}) ([]byte, error) {
 		return renderDelimitedBlock(ctx, e)
 	case types.LiteralBlock:
 		return renderLiteralBlock(ctx, e)
+	case types.Table:
+		return renderTable(ctx, e)
 	case types.InlineElements:
 		return renderInlineElements(ctx, e)
 	case types.Link:
@@ -83,6 +96,8 @@ func renderPlainString(ctx *renderer.Context, element interface{}) ([]byte, erro
 		return []byte(element.Text()), nil
 	case types.BlankLine:
 		return []byte("\n\n"), nil
+	case types.InlineAnchor:
+		return nil, nil
 	case types.StringElement:
 		return []byte(element.Content), nil
 	case types.Paragraph:
@@ -174,3 +189,18 @@ func getID(attributes map[string]interface{}) string {
 	}
 	return id
 }
+
+// hasTitle checks if the given map has an entry with key `types.AttrTitle`
+func hasTitle(attributes map[string]interface{}) bool {
+	_, found := attributes[types.AttrTitle]
+	return found
+}
+
+// getTitle returns the value for the entry with key `types.AttrTitle` in the given map
+func getTitle(attributes map[string]interface{}) string {
+	title, ok := attributes[types.AttrTitle].(string)
+	if !ok {
+		return ""
+	}
+	return title
+}
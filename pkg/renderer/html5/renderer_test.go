@@ -30,6 +30,7 @@ func verify(t GinkgoTInterface, expectedResult, content string, rendererOpts ...
 	if strings.Contains(expectedResult, "{{.LastUpdated}}") {
 		expectedResult = strings.Replace(expectedResult, "{{.LastUpdated}}", rendererCtx.LastUpdated(), 1)
 	}
+	expectedResult = strings.Replace(expectedResult, "{{.DefaultStylesheet}}", html5.DefaultStylesheet, 1)
 	t.Log("* Done processing document:")
 	result := buff.String()
 	expectedResult = strings.Replace(expectedResult, "\t", "", -1)
@@ -0,0 +1,219 @@
+package html5
+
+import (
+	"bytes"
+	"html"
+	"io"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	log "github.com/sirupsen/logrus"
+	nethtml "golang.org/x/net/html"
+)
+
+// Policy a SanitizerPolicy based on an allowlist of HTML elements, attributes and URL schemes.
+// The elements which are not allowed are removed, but their content is retained, except for
+// the elements in `DroppedContent` (such as `<script>`), whose content is also removed.
+type Policy struct {
+	// Elements the allowed elements, with their allowed attributes
+	Elements map[string][]string
+	// GlobalAttributes the attributes which are allowed on all the allowed elements
+	GlobalAttributes []string
+	// URLSchemes the allowed schemes in the links and in the URL attributes (the relative URLs are always allowed)
+	URLSchemes []string
+	// DroppedContent the elements which are removed along with their content
+	DroppedContent []string
+}
+
+var _ renderer.SanitizerPolicy = &Policy{}
+
+// NewDefaultPolicy returns the policy which is applied when no other SanitizerPolicy is set in the renderer context.
+// It allows the common formatting elements, the lists, the tables, the links, the images, the audio and video elements, the `id`, `class`,
+// `title`, `lang` and `dir` attributes, and the `http`, `https`, `ftp`, `irc` and `mailto` URL schemes.
+func NewDefaultPolicy() *Policy {
+	return &Policy{
+		Elements: map[string][]string{
+			"a":          {"href", "target", "rel"},
+			"abbr":       {},
+			"audio":      {"src", "controls", "loop", "muted", "preload"},
+			"b":          {},
+			"blockquote": {"cite"},
+			"br":         {},
+			"caption":    {},
+			"code":       {},
+			"dd":         {},
+			"del":        {},
+			"details":    {},
+			"div":        {},
+			"dl":         {},
+			"dt":         {},
+			"em":         {},
+			"figcaption": {},
+			"figure":     {},
+			"h1":         {},
+			"h2":         {},
+			"h3":         {},
+			"h4":         {},
+			"h5":         {},
+			"h6":         {},
+			"hr":         {},
+			"i":          {},
+			"img":        {"src", "alt", "width", "height"},
+			"ins":        {},
+			"kbd":        {},
+			"li":         {},
+			"mark":       {},
+			"ol":         {"start", "type", "reversed"},
+			"p":          {},
+			"pre":        {},
+			"q":          {"cite"},
+			"s":          {},
+			"small":      {},
+			"source":     {"src", "type"},
+			"span":       {},
+			"strong":     {},
+			"sub":        {},
+			"summary":    {},
+			"sup":        {},
+			"table":      {},
+			"tbody":      {},
+			"td":         {"colspan", "rowspan"},
+			"tfoot":      {},
+			"th":         {"colspan", "rowspan", "scope"},
+			"thead":      {},
+			"tr":         {},
+			"u":          {},
+			"ul":         {},
+			"var":        {},
+			"video":      {"src", "controls", "loop", "muted", "preload", "poster", "width", "height"},
+		},
+		GlobalAttributes: []string{"id", "class", "title", "lang", "dir"},
+		URLSchemes:       []string{"http", "https", "ftp", "irc", "mailto"},
+		DroppedContent:   []string{"script", "style", "iframe", "object", "noscript", "template", "textarea", "title", "xmp", "noembed", "noframes", "plaintext"},
+	}
+}
+
+// defaultPolicy the policy applied when no other policy is set in the renderer context
+var defaultPolicy = NewDefaultPolicy()
+
+// sanitizer returns the policy set in the given context, or the default policy
+func sanitizer(ctx *renderer.Context) renderer.SanitizerPolicy {
+	if policy := ctx.Sanitizer(); policy != nil {
+		return policy
+	}
+	return defaultPolicy
+}
+
+// urlAttributes the attributes whose value is a URL, which must use an allowed scheme
+var urlAttributes = map[string]bool{
+	"href":       true,
+	"src":        true,
+	"cite":       true,
+	"action":     true,
+	"formaction": true,
+	"poster":     true,
+	"background": true,
+	"xlink:href": true,
+}
+
+// SanitizeHTML returns the given HTML fragment without the elements and attributes which are not allowed by the policy.
+// The text is written as-is, since it was already tokenized as text.
+func (p *Policy) SanitizeHTML(fragment string) string {
+	result := bytes.NewBuffer(nil)
+	tokenizer := nethtml.NewTokenizer(strings.NewReader(fragment))
+	dropping := "" // the element whose content is being dropped, if any
+	depth := 0     // the depth of the nested elements being dropped (eg: `<object><object></object></object>`)
+	for {
+		tt := tokenizer.Next()
+		if tt == nethtml.ErrorToken {
+			if tokenizer.Err() != io.EOF {
+				log.Debugf("stopped sanitizing the HTML fragment: %v", tokenizer.Err())
+			}
+			return result.String()
+		}
+		raw := string(tokenizer.Raw())
+		token := tokenizer.Token()
+		if dropping != "" {
+			switch {
+			case tt == nethtml.StartTagToken && token.Data == dropping:
+				depth++
+			case tt == nethtml.EndTagToken && token.Data == dropping:
+				depth--
+				if depth == 0 {
+					dropping = ""
+				}
+			}
+			continue
+		}
+		switch tt {
+		case nethtml.TextToken:
+			result.WriteString(raw)
+		case nethtml.StartTagToken, nethtml.SelfClosingTagToken:
+			if tt == nethtml.StartTagToken && contains(p.DroppedContent, token.Data) {
+				log.Debugf("dropping the '%s' element and its content", token.Data)
+				dropping = token.Data
+				depth = 1
+				continue
+			}
+			if allowed, found := p.Elements[token.Data]; found {
+				result.WriteString(p.startTag(token, allowed))
+			} else {
+				log.Debugf("dropping the '%s' element", token.Data)
+			}
+		case nethtml.EndTagToken:
+			if _, found := p.Elements[token.Data]; found {
+				result.WriteString("</" + token.Data + ">")
+			}
+		default:
+			// comments and doctypes are dropped
+		}
+	}
+}
+
+// startTag returns the start tag of the given token, with its allowed attributes only
+func (p *Policy) startTag(token nethtml.Token, allowed []string) string {
+	result := bytes.NewBuffer(nil)
+	result.WriteString("<" + token.Data)
+	for _, attr := range token.Attr {
+		if attr.Namespace != "" || !(contains(allowed, attr.Key) || contains(p.GlobalAttributes, attr.Key)) {
+			log.Debugf("dropping the '%s' attribute of the '%s' element", attr.Key, token.Data)
+			continue
+		}
+		if urlAttributes[attr.Key] && !p.AllowURL(attr.Val) {
+			log.Debugf("dropping the '%s' attribute of the '%s' element: '%s' is not allowed", attr.Key, token.Data, attr.Val)
+			continue
+		}
+		result.WriteString(" " + attr.Key + `="` + html.EscapeString(attr.Val) + `"`)
+	}
+	if token.Type == nethtml.SelfClosingTagToken {
+		result.WriteString("/")
+	}
+	result.WriteString(">")
+	return result.String()
+}
+
+// AllowURL returns true if the given URL is relative, or if its scheme is allowed by the policy
+func (p *Policy) AllowURL(url string) bool {
+	// the browsers ignore the whitespaces and the control characters in the schemes (eg: "java\tscript:")
+	url = strings.Map(func(r rune) rune {
+		if r <= ' ' || r == 0x7f {
+			return -1
+		}
+		return r
+	}, url)
+	i := strings.IndexAny(url, ":/?#")
+	if i < 0 || url[i] != ':' {
+		// relative URL
+		return true
+	}
+	return contains(p.URLSchemes, strings.ToLower(url[:i]))
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,170 @@
+package html5_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/parser"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer/html5"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	. "github.com/onsi/ginkgo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var _ = Describe("sanitizer", func() {
+
+	Context("passthroughs", func() {
+
+		It("script element", func() {
+			actualContent := `before +++<script>alert("xss")</script>+++ after`
+			expectedResult := `<div class="paragraph">
+<p>before  after</p>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("event handler attribute", func() {
+			actualContent := `+++<img src="cat.png" alt="a cat" onerror="alert(1)">+++`
+			expectedResult := `<div class="paragraph">
+<p><img src="cat.png" alt="a cat"></p>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("javascript URL in link", func() {
+			actualContent := `+++<a href="javascript:alert(1)">click</a> and <a href="https://example.com" target="_blank">here</a>+++`
+			expectedResult := `<div class="paragraph">
+<p><a>click</a> and <a href="https://example.com" target="_blank">here</a></p>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("obfuscated javascript URL in link", func() {
+			actualContent := `+++<a href="  JaVa&#x09;ScRiPt&#58;alert(1)">click</a>+++`
+			expectedResult := `<div class="paragraph">
+<p><a>click</a></p>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("svg element with onload attribute in passthrough macro", func() {
+			actualContent := `pass:[<svg onload=alert(1)><circle r="4"/></svg>*text*]`
+			expectedResult := `<div class="paragraph">
+<p>*text*</p>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("iframe and style attribute", func() {
+			actualContent := `+++<iframe src="https://example.com"><p>fallback</p></iframe><span style="background:url(javascript:alert(1))">styled</span>+++`
+			expectedResult := `<div class="paragraph">
+<p><span>styled</span></p>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("comment and unclosed script element", func() {
+			actualContent := `+++<!-- <script>alert(1)</script> --><b>bold</b><script>alert(2)+++`
+			expectedResult := `<div class="paragraph">
+<p><b>bold</b></p>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("passthrough block", func() {
+			actualContent := `++++
+<div class="custom" onclick="alert(1)">
+<p>some <em>content</em></p>
+<script src="https://example.com/evil.js"></script>
+<form action="javascript:alert(1)"><input type="submit"></form>
+</div>
+++++`
+			expectedResult := `<div class="custom">
+<p>some <em>content</em></p>
+
+
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+	})
+
+	Context("links", func() {
+
+		It("javascript URL in link macro", func() {
+			actualContent := `a link:javascript:alert(1)[click <me>] and a link:https://example.com[safe link]`
+			expectedResult := `<div class="paragraph">
+<p>a click &lt;me&gt; and a <a href="https://example.com">safe link</a></p>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("data URL in link macro reported in diagnostics", func() {
+			doc, err := parser.ParseReader("", strings.NewReader(`link:data:text/html;base64,PHNjcmlwdD5hbGVydCgxKTwvc2NyaXB0Pg==[]`))
+			require.NoError(GinkgoT(), err)
+			ctx := renderer.Wrap(context.Background(), doc.(types.Document))
+			buff := bytes.NewBuffer(nil)
+			_, err = html5.Render(ctx, buff)
+			require.NoError(GinkgoT(), err)
+			assert.NotContains(GinkgoT(), buff.String(), "<a ")
+			require.Len(GinkgoT(), *ctx.Diagnostics(), 1)
+			assert.Equal(GinkgoT(), "unsafe-url", (*ctx.Diagnostics())[0].Rule)
+		})
+
+		It("relative URL in link macro", func() {
+			actualContent := `link:other.html#section[other page]`
+			expectedResult := `<div class="paragraph">
+<p><a href="other.html#section">other page</a></p>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+	})
+
+	Context("custom policies", func() {
+
+		It("default policy with additional element and fewer URL schemes", func() {
+			policy := html5.NewDefaultPolicy()
+			policy.Elements["marquee"] = []string{}
+			policy.URLSchemes = []string{"https"}
+			actualContent := `+++<marquee onclick="alert(1)">news</marquee>+++ link:http://example.com[insecure] link:https://example.com[secure]`
+			expectedResult := `<div class="paragraph">
+<p><marquee>news</marquee> insecure <a href="https://example.com">secure</a></p>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent, renderer.Sanitizer(policy))
+		})
+
+		It("custom implementation", func() {
+			// the `javascript:` URLs are still filtered by the `html/template` package
+			actualContent := `+++<script>trusted()</script>+++ link:javascript:trusted()[run]`
+			expectedResult := `<div class="paragraph">
+<p><script>trusted()</script> <a href="#ZgotmplZ">run</a></p>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent, renderer.Sanitizer(unrestrictedPolicy{}))
+		})
+	})
+
+	It("allowed URLs", func() {
+		policy := html5.NewDefaultPolicy()
+		assert.True(GinkgoT(), policy.AllowURL("https://example.com"))
+		assert.True(GinkgoT(), policy.AllowURL("MAILTO:foo@example.com"))
+		assert.True(GinkgoT(), policy.AllowURL("images/foo:bar.png"))
+		assert.True(GinkgoT(), policy.AllowURL("#section"))
+		assert.False(GinkgoT(), policy.AllowURL("javascript:alert(1)"))
+		assert.False(GinkgoT(), policy.AllowURL(" java\tscript:alert(1)"))
+		assert.False(GinkgoT(), policy.AllowURL("vbscript:msgbox(1)"))
+		assert.False(GinkgoT(), policy.AllowURL("data:text/html,<script>alert(1)</script>"))
+	})
+})
+
+// unrestrictedPolicy a SanitizerPolicy which allows all the content
+type unrestrictedPolicy struct{}
+
+func (unrestrictedPolicy) SanitizeHTML(fragment string) string {
+	return fragment
+}
+
+func (unrestrictedPolicy) AllowURL(url string) bool {
+	return true
+}
@@ -17,6 +17,7 @@ var preambleTmpl template.Template
 var sectionHeaderTmpl template.Template
 var section1ContentTmpl template.Template
 var otherSectionContentTmpl template.Template
+var partTmpl template.Template
 
 // initializes the templates
 func init() {
@@ -26,20 +27,28 @@ func init() {
 {{.}}
 </div>
 </div>`)
-	section1ContentTmpl = newHTMLTemplate("section 1",
+	section1ContentTmpl = newHTMLTemplate("section-1",
 		`<div class="{{.Class}}">
 {{.SectionTitle}}
 <div class="sectionbody">{{ if .Elements }}
 {{.Elements}}{{end}}
 </div>
 </div>`)
-	otherSectionContentTmpl = newHTMLTemplate("other section",
+	otherSectionContentTmpl = newHTMLTemplate("section",
 		`<div class="{{.Class}}">
 {{.SectionTitle}}{{ if .Elements }}
 {{.Elements}}{{end}}
 </div>`)
-	sectionHeaderTmpl = newHTMLTemplate("other sectionTitle",
-		`<h{{.Level}} id="{{.ID}}">{{.Content}}</h{{.Level}}>`)
+	sectionHeaderTmpl = newHTMLTemplate("section-title",
+		`<h{{.Level}} id="{{.ID}}"{{ if .Class }} class="{{ .Class }}"{{ end }}>{{.Content}}</h{{.Level}}>`)
+	partTmpl = newHTMLTemplate("part",
+		`{{.SectionTitle}}{{ if .Intro }}
+<div class="openblock partintro">
+<div class="content">
+{{.Intro}}
+</div>
+</div>{{ end }}{{ if .Elements }}
+{{.Elements}}{{ end }}`)
 }
 
 func renderPreamble(ctx *renderer.Context, p types.Preamble) ([]byte, error) {
@@ -61,7 +70,7 @@ func renderPreamble(ctx *renderer.Context, p types.Preamble) ([]byte, error) {
 		}
 	}
 	result := bytes.NewBuffer(nil)
-	err := preambleTmpl.Execute(result, template.HTML(renderedElementsBuff.String()))
+	err := executeTemplate(ctx, &preambleTmpl, result, template.HTML(renderedElementsBuff.String()))
 	if err != nil {
 		return nil, errors.Wrapf(err, "error while rendering section")
 	}
@@ -71,6 +80,9 @@ func renderPreamble(ctx *renderer.Context, p types.Preamble) ([]byte, error) {
 
 func renderSection(ctx *renderer.Context, s types.Section) ([]byte, error) {
 	log.Debugf("Rendering section level %d", s.Level)
+	if s.Level == 0 {
+		return renderPart(ctx, s)
+	}
 	renderedSectionTitle, err := renderSectionTitle(ctx, s.Level, s.Title)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error while rendering section")
@@ -89,12 +101,16 @@ func renderSection(ctx *renderer.Context, s types.Section) ([]byte, error) {
 	}
 	renderedHTMLSectionTitle := template.HTML(renderedSectionTitle)
 	renderedHTMLElements := template.HTML(renderedSectionElements)
-	err = tmpl.Execute(result, struct {
+	class := "sect" + strconv.Itoa(s.Level)
+	if style := s.Title.Style(); style != "" {
+		class = class + " " + style
+	}
+	err = executeTemplate(ctx, &tmpl, result, struct {
 		Class        string
 		SectionTitle template.HTML
 		Elements     template.HTML
 	}{
-		Class:        "sect" + strconv.Itoa(s.Level),
+		Class:        class,
 		SectionTitle: renderedHTMLSectionTitle,
 		Elements:     renderedHTMLElements,
 	})
@@ -105,25 +121,74 @@ func renderSection(ctx *renderer.Context, s types.Section) ([]byte, error) {
 	return result.Bytes(), nil
 }
 
+// renderPart renders the level 0 section of a book, whose title is followed by its content without any wrapper,
+// except for the blocks before its first chapter, which are wrapped in a `partintro` block
+func renderPart(ctx *renderer.Context, s types.Section) ([]byte, error) {
+	if ctx.Document.Attributes.GetDocType() != types.DocTypeBook {
+		ctx.Diagnostics().Warnf("invalid-part", s.Location, "level 0 sections can only be used when doctype is book")
+	}
+	renderedSectionTitle, err := renderSectionTitle(ctx, s.Level, s.Title)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error while rendering part")
+	}
+	intro := make([]interface{}, 0, len(s.Elements))
+	elements := s.Elements
+	for len(elements) > 0 {
+		if _, ok := elements[0].(types.Section); ok {
+			break
+		}
+		intro = append(intro, elements[0])
+		elements = elements[1:]
+	}
+	renderedIntro, err := renderSectionElements(ctx, intro)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error while rendering part")
+	}
+	renderedElements, err := renderSectionElements(ctx, elements)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error while rendering part")
+	}
+	result := bytes.NewBuffer(nil)
+	err = executeTemplate(ctx, &partTmpl, result, struct {
+		SectionTitle template.HTML
+		Intro        template.HTML
+		Elements     template.HTML
+	}{
+		SectionTitle: template.HTML(renderedSectionTitle),
+		Intro:        template.HTML(renderedIntro),
+		Elements:     template.HTML(renderedElements),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "error while rendering part")
+	}
+	return result.Bytes(), nil
+}
+
 func renderSectionTitle(ctx *renderer.Context, level int, sectionTitle types.SectionTitle) ([]byte, error) {
 	result := bytes.NewBuffer(nil)
 	renderedContent, err := renderElement(ctx, sectionTitle.Content)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error while rendering sectionTitle content")
 	}
-	renderedContentStr := strings.TrimSpace(string(renderedContent))
-	content := template.HTML(renderedContentStr)
 	var id string
 	if i, ok := sectionTitle.Attributes[types.AttrID].(string); ok {
 		id = i
 	}
-	err = sectionHeaderTmpl.Execute(result, struct {
+	renderedContentStr := strings.TrimSpace(string(renderedContent))
+	content := template.HTML(template.HTMLEscapeString(ctx.SectionNumber(id)) + renderedContentStr)
+	var class string
+	if level == 0 {
+		class = "sect0"
+	}
+	err = executeTemplate(ctx, &sectionHeaderTmpl, result, struct {
 		Level   int
 		ID      string
+		Class   string
 		Content template.HTML
 	}{
 		Level:   level + 1,
 		ID:      id,
+		Class:   class,
 		Content: content,
 	})
 	if err != nil {
@@ -181,4 +181,313 @@ with some text, too`
 		})
 
 	})
+	Context("numbered sections", func() {
+
+		It("sections numbered down to the sectnumlevels level", func() {
+			actualContent := `= A title
+:sectnums:
+:sectnumlevels: 2
+
+== Section A
+
+=== Section A.a
+
+==== Section A.a.a
+
+=== Section A.b
+
+== Section B`
+			expectedResult := `<div id="preamble">
+<div class="sectionbody">
+
+</div>
+</div>
+<div class="sect1">
+<h2 id="_section_a">1. Section A</h2>
+<div class="sectionbody">
+<div class="sect2">
+<h3 id="_section_a_a">1.1. Section A.a</h3>
+<div class="sect3">
+<h4 id="_section_a_a_a">Section A.a.a</h4>
+</div>
+</div>
+<div class="sect2">
+<h3 id="_section_a_b">1.2. Section A.b</h3>
+</div>
+</div>
+</div>
+<div class="sect1">
+<h2 id="_section_b">2. Section B</h2>
+<div class="sectionbody">
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("sections numbering toggled in the document", func() {
+			actualContent := `== Section A
+
+:sectnums:
+
+== Section B
+
+=== Section B.a
+
+:sectnums!:
+
+== Section C
+
+:sectnums:
+
+== Section D`
+			expectedResult := `<div class="sect1">
+<h2 id="_section_a">Section A</h2>
+<div class="sectionbody">
+</div>
+</div>
+<div class="sect1">
+<h2 id="_section_b">1. Section B</h2>
+<div class="sectionbody">
+<div class="sect2">
+<h3 id="_section_b_a">1.1. Section B.a</h3>
+</div>
+</div>
+</div>
+<div class="sect1">
+<h2 id="_section_c">Section C</h2>
+<div class="sectionbody">
+</div>
+</div>
+<div class="sect1">
+<h2 id="_section_d">2. Section D</h2>
+<div class="sectionbody">
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("appendices", func() {
+			actualContent := `= A title
+:sectnums:
+
+== Section A
+
+[appendix]
+== First Appendix
+
+=== Appendix Section
+
+[appendix]
+== Second Appendix`
+			expectedResult := `<div id="preamble">
+<div class="sectionbody">
+
+</div>
+</div>
+<div class="sect1">
+<h2 id="_section_a">1. Section A</h2>
+<div class="sectionbody">
+</div>
+</div>
+<div class="sect1 appendix">
+<h2 id="_first_appendix">Appendix A: First Appendix</h2>
+<div class="sectionbody">
+<div class="sect2">
+<h3 id="_appendix_section">A.1. Appendix Section</h3>
+</div>
+</div>
+</div>
+<div class="sect1 appendix">
+<h2 id="_second_appendix">Appendix B: Second Appendix</h2>
+<div class="sectionbody">
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("appendices without sectnums nor caption", func() {
+			actualContent := `= A title
+:appendix-caption!:
+
+== Section A
+
+[appendix]
+== First Appendix`
+			expectedResult := `<div id="preamble">
+<div class="sectionbody">
+
+</div>
+</div>
+<div class="sect1">
+<h2 id="_section_a">Section A</h2>
+<div class="sectionbody">
+</div>
+</div>
+<div class="sect1 appendix">
+<h2 id="_first_appendix">First Appendix</h2>
+<div class="sectionbody">
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("cross reference to a numbered section", func() {
+			actualContent := `= A title
+:sectnums:
+
+== Section A
+
+=== Section A.a
+
+see <<_section_a_a>>`
+			expectedResult := `<div id="preamble">
+<div class="sectionbody">
+
+</div>
+</div>
+<div class="sect1">
+<h2 id="_section_a">1. Section A</h2>
+<div class="sectionbody">
+<div class="sect2">
+<h3 id="_section_a_a">1.1. Section A.a</h3>
+<div class="paragraph">
+<p>see <a href="#_section_a_a">1.1. Section A.a</a></p>
+</div>
+</div>
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+	})
+	Context("book sections", func() {
+
+		It("parts, chapters and special sections", func() {
+			actualContent := `= The Book
+:doctype: book
+:sectnums:
+:partnums:
+
+[preface]
+== Preface
+
+=== Preface Section
+
+= First Part
+
+the introduction of the first part
+
+== First Chapter
+
+=== First Section
+
+= Second Part
+
+== Second Chapter
+
+[appendix]
+== First Appendix
+
+=== Appendix Section
+
+[glossary]
+== Glossary
+
+[colophon]
+== Colophon`
+			expectedResult := `<div id="preamble">
+<div class="sectionbody">
+
+</div>
+</div>
+<div class="sect1 preface">
+<h2 id="_preface">Preface</h2>
+<div class="sectionbody">
+<div class="sect2">
+<h3 id="_preface_section">Preface Section</h3>
+</div>
+</div>
+</div>
+<h1 id="_first_part" class="sect0">I: First Part</h1>
+<div class="openblock partintro">
+<div class="content">
+<div class="paragraph">
+<p>the introduction of the first part</p>
+</div>
+</div>
+</div>
+<div class="sect1">
+<h2 id="_first_chapter">1. First Chapter</h2>
+<div class="sectionbody">
+<div class="sect2">
+<h3 id="_first_section">1.1. First Section</h3>
+</div>
+</div>
+</div>
+<h1 id="_second_part" class="sect0">II: Second Part</h1>
+<div class="sect1">
+<h2 id="_second_chapter">2. Second Chapter</h2>
+<div class="sectionbody">
+</div>
+</div>
+<div class="sect1 appendix">
+<h2 id="_first_appendix">Appendix A: First Appendix</h2>
+<div class="sectionbody">
+<div class="sect2">
+<h3 id="_appendix_section">A.1. Appendix Section</h3>
+</div>
+</div>
+</div>
+<div class="sect1 glossary">
+<h2 id="_glossary">Glossary</h2>
+<div class="sectionbody">
+</div>
+</div>
+<div class="sect1 colophon">
+<h2 id="_colophon">Colophon</h2>
+<div class="sectionbody">
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("chapters with signifier", func() {
+			actualContent := `= The Book
+:doctype: book
+:sectnums:
+:chapter-signifier: Chapter
+
+== First Chapter
+
+=== First Section`
+			expectedResult := `<div id="preamble">
+<div class="sectionbody">
+
+</div>
+</div>
+<div class="sect1">
+<h2 id="_first_chapter">Chapter 1. First Chapter</h2>
+<div class="sectionbody">
+<div class="sect2">
+<h3 id="_first_section">1.1. First Section</h3>
+</div>
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("part in an article", func() {
+			actualContent := `= The Article
+
+= The Part
+
+== The Section`
+			expectedResult := `<h1 id="_the_part" class="sect0">The Part</h1>
+<div class="sect1">
+<h2 id="_the_section">The Section</h2>
+<div class="sectionbody">
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+	})
 })
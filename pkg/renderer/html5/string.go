@@ -13,12 +13,12 @@ var stringElementTmpl template.Template
 
 // initializes the templates
 func init() {
-	stringElementTmpl = newHTMLTemplate("string element", "{{.}}")
+	stringElementTmpl = newHTMLTemplate("string", "{{.}}")
 }
 
 func renderStringElement(ctx *renderer.Context, str types.StringElement) ([]byte, error) {
 	result := bytes.NewBuffer(nil)
-	err := stringElementTmpl.Execute(result, str.Content)
+	err := executeTemplate(ctx, &stringElementTmpl, result, str.Content)
 	if err != nil {
 		return nil, errors.Wrapf(err, "unable to render string element")
 	}
@@ -0,0 +1,199 @@
+package html5
+
+import (
+	"bytes"
+	htmltemplate "html/template"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// AttrStylesheet the attribute which sets the path of a custom stylesheet, relative to the `stylesdir` directory.
+	// No stylesheet is used when this attribute is unset (`:stylesheet!:`)
+	AttrStylesheet string = "stylesheet"
+	// AttrStylesDir the attribute which sets the directory of the stylesheet, relative to the document (default is `.`)
+	AttrStylesDir string = "stylesdir"
+	// AttrLinkCSS the attribute which makes the documents link to their stylesheet instead of embedding it
+	AttrLinkCSS string = "linkcss"
+	// AttrCopyCSS the attribute which makes the stylesheet be copied next to the output file when it is linked (see CopyStylesheet)
+	AttrCopyCSS string = "copycss"
+	// DefaultStylesheetName the name of the built-in stylesheet when it is linked from the documents
+	DefaultStylesheetName string = "libasciidoc.css"
+)
+
+var linkedStylesheetTmpl htmltemplate.Template
+var embeddedStylesheetTmpl texttemplate.Template
+
+// initializes the templates
+func init() {
+	linkedStylesheetTmpl = newHTMLTemplate("linked-stylesheet", `<link rel="stylesheet" href="{{ . }}">`)
+	embeddedStylesheetTmpl = newTextTemplate("embedded-stylesheet", `<style>
+{{ . }}
+</style>`)
+}
+
+// renderStylesheet renders the stylesheet of the document, which is either linked (when the `linkcss` attribute is set)
+// or embedded, or nothing if the `stylesheet` attribute was unset
+func renderStylesheet(ctx *renderer.Context) (htmltemplate.HTML, error) {
+	if isUnset(ctx.Document, AttrStylesheet) {
+		return "", nil
+	}
+	result := bytes.NewBuffer(nil)
+	if _, found := ctx.Document.Attributes[AttrLinkCSS]; found {
+		href := stylesheetName(ctx.Document.Attributes)
+		if dir := stylesDir(ctx.Document.Attributes); dir != "" && !isAbsoluteOrURL(href) {
+			href = dir + "/" + href
+		}
+		if err := executeTemplate(ctx, &linkedStylesheetTmpl, result, href); err != nil {
+			return "", errors.Wrap(err, "unable to render the stylesheet")
+		}
+		return htmltemplate.HTML(result.String()), nil
+	}
+	content := DefaultStylesheet
+	if stylesheet := ctx.Document.Attributes.GetAsString(AttrStylesheet); stylesheet != nil && *stylesheet != "" {
+		path := stylesheetPath(ctx, *stylesheet)
+		if baseDir := filepath.Dir(ctx.Filename()); ctx.SafeMode() >= renderer.SafeModeSafe && !renderer.WithinDir(baseDir, path) {
+			ctx.Diagnostics().Warnf("unsafe-stylesheet", types.Location{}, "unable to read the stylesheet '%s': the file is outside of the '%s' directory in the '%s' safe mode", *stylesheet, baseDir, ctx.SafeMode())
+			return "", nil
+		}
+		c, err := readStylesheet(ctx, path)
+		if err != nil {
+			ctx.Diagnostics().Warnf("unresolved-stylesheet", types.Location{}, "unable to read the stylesheet '%s': %v", *stylesheet, err)
+			return "", nil
+		}
+		content = c
+	}
+	if err := executeTemplate(ctx, &embeddedStylesheetTmpl, result, strings.TrimSpace(content)); err != nil {
+		return "", errors.Wrap(err, "unable to render the stylesheet")
+	}
+	return htmltemplate.HTML(result.String()), nil
+}
+
+// stylesheetPath returns the path of the custom stylesheet with the given name, relative to the `stylesdir` directory
+// (which is itself relative to the document being rendered)
+func stylesheetPath(ctx *renderer.Context, name string) string {
+	if filepath.IsAbs(name) {
+		return name
+	}
+	return filepath.Join(filepath.Dir(ctx.Filename()), filepath.FromSlash(stylesDir(ctx.Document.Attributes)), filepath.FromSlash(name))
+}
+
+// readStylesheet reads the custom stylesheet at the given path
+func readStylesheet(ctx *renderer.Context, path string) (string, error) {
+	log.Debugf("reading the stylesheet in %s", path)
+	f, err := ctx.IncludeResolver().Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	content, err := ioutil.ReadAll(f)
+	if err != nil {
+		return "", err
+	}
+	return string(content), nil
+}
+
+// CopyStylesheet copies the stylesheet linked by a document into the directory of its output file, when the `linkcss` and `copycss`
+// attributes are set. The given metadata are those returned when rendering the document, whose source and output files have the given names.
+// The built-in stylesheet is written as `libasciidoc.css` unless a custom stylesheet was set.
+func CopyStylesheet(metadata map[string]interface{}, sourceFilename, outputFilename string) error {
+	_, linkcss := metadata[AttrLinkCSS]
+	_, copycss := metadata[AttrCopyCSS]
+	if !linkcss || !copycss {
+		return nil
+	}
+	attributes := types.DocumentAttributes(metadata)
+	name := stylesheetName(attributes)
+	if isAbsoluteOrURL(name) {
+		return nil
+	}
+	dir := filepath.FromSlash(stylesDir(attributes))
+	target := filepath.Join(filepath.Dir(outputFilename), dir, filepath.FromSlash(name))
+	content := []byte(DefaultStylesheet)
+	if name != DefaultStylesheetName {
+		source := filepath.Join(filepath.Dir(sourceFilename), dir, filepath.FromSlash(name))
+		if sameFile(source, target) {
+			return nil
+		}
+		if baseDir := filepath.Dir(sourceFilename); safeModeLevel(attributes) >= int(renderer.SafeModeSafe) && !renderer.WithinDir(baseDir, source) {
+			return errors.Errorf("unable to copy the stylesheet: '%s' is outside of the '%s' directory in the '%s' safe mode", source, baseDir, attributes["safe-mode-name"])
+		}
+		c, err := ioutil.ReadFile(source)
+		if err != nil {
+			return errors.Wrapf(err, "unable to copy the stylesheet")
+		}
+		content = c
+	}
+	log.Debugf("copying the stylesheet to %s", target)
+	if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+		return errors.Wrapf(err, "unable to copy the stylesheet")
+	}
+	if err := ioutil.WriteFile(target, content, 0644); err != nil {
+		return errors.Wrapf(err, "unable to copy the stylesheet")
+	}
+	return nil
+}
+
+// safeModeLevel returns the value of the `safe-mode-level` attribute, or `0` (ie, the `unsafe` mode) if it is not set
+func safeModeLevel(attributes types.DocumentAttributes) int {
+	if level := attributes.GetAsString("safe-mode-level"); level != nil {
+		if l, err := strconv.Atoi(*level); err == nil {
+			return l
+		}
+	}
+	return 0
+}
+
+// stylesheetName returns the value of the `stylesheet` attribute, or the name of the built-in stylesheet if it is not set
+func stylesheetName(attributes types.DocumentAttributes) string {
+	if stylesheet := attributes.GetAsString(AttrStylesheet); stylesheet != nil && *stylesheet != "" {
+		return *stylesheet
+	}
+	return DefaultStylesheetName
+}
+
+// stylesDir returns the value of the `stylesdir` attribute, or `.` if it is not set
+func stylesDir(attributes types.DocumentAttributes) string {
+	if dir := attributes.GetAsString(AttrStylesDir); dir != nil {
+		return strings.TrimSuffix(*dir, "/")
+	}
+	return "."
+}
+
+// isUnset returns true if the last declaration of the attribute with the given name in the document is a reset (eg: `:stylesheet!:`)
+func isUnset(doc types.Document, name string) bool {
+	unset := false
+	types.Walk(types.VisitorFunc(func(element types.Visitable) error {
+		switch e := element.(type) {
+		case types.DocumentAttributeDeclaration:
+			if e.Name == name {
+				unset = false
+			}
+		case types.DocumentAttributeReset:
+			if e.Name == name {
+				unset = true
+			}
+		}
+		return nil
+	}), doc.Elements...)
+	return unset
+}
+
+func isAbsoluteOrURL(path string) bool {
+	return strings.HasPrefix(path, "/") || strings.Contains(path, "://")
+}
+
+func sameFile(path1, path2 string) bool {
+	abs1, err1 := filepath.Abs(path1)
+	abs2, err2 := filepath.Abs(path2)
+	return err1 == nil && err2 == nil && abs1 == abs2
+}
@@ -0,0 +1,339 @@
+package html5_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/bytesparadise/libasciidoc/pkg/parser"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer/html5"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	. "github.com/onsi/ginkgo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var _ = Describe("stylesheets", func() {
+
+	Context("linked stylesheets", func() {
+
+		It("default stylesheet", func() {
+			actualContent := `= The Title
+:linkcss:`
+			expectedResult := `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="UTF-8">
+<!--[if IE]><meta http-equiv="X-UA-Compatible" content="IE=edge"><![endif]-->
+<meta name="viewport" content="width=device-width, initial-scale=1.0">
+<meta name="generator" content="libasciidoc">
+<title>The Title</title>
+<link rel="stylesheet" href="./libasciidoc.css">
+<body class="article">
+<div id="header">
+<h1>The Title</h1>
+</div>
+<div id="content">
+
+</div>
+<div id="footer">
+<div id="footer-text">
+Last updated {{.LastUpdated}}
+</div>
+</div>
+</body>
+</html>`
+			verify(GinkgoT(), expectedResult, actualContent, renderer.IncludeHeaderFooter(true), renderer.LastUpdated(time.Now()))
+		})
+
+		It("custom stylesheet in custom directory", func() {
+			actualContent := `= The Title
+:linkcss:
+:stylesdir: css/
+:stylesheet: custom.css`
+			expectedResult := `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="UTF-8">
+<!--[if IE]><meta http-equiv="X-UA-Compatible" content="IE=edge"><![endif]-->
+<meta name="viewport" content="width=device-width, initial-scale=1.0">
+<meta name="generator" content="libasciidoc">
+<title>The Title</title>
+<link rel="stylesheet" href="css/custom.css">
+<body class="article">
+<div id="header">
+<h1>The Title</h1>
+</div>
+<div id="content">
+
+</div>
+<div id="footer">
+<div id="footer-text">
+Last updated {{.LastUpdated}}
+</div>
+</div>
+</body>
+</html>`
+			verify(GinkgoT(), expectedResult, actualContent, renderer.IncludeHeaderFooter(true), renderer.LastUpdated(time.Now()))
+		})
+
+		It("remote stylesheet", func() {
+			actualContent := `= The Title
+:linkcss:
+:stylesdir: css
+:stylesheet: https://example.com/custom.css`
+			expectedResult := `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="UTF-8">
+<!--[if IE]><meta http-equiv="X-UA-Compatible" content="IE=edge"><![endif]-->
+<meta name="viewport" content="width=device-width, initial-scale=1.0">
+<meta name="generator" content="libasciidoc">
+<title>The Title</title>
+<link rel="stylesheet" href="https://example.com/custom.css">
+<body class="article">
+<div id="header">
+<h1>The Title</h1>
+</div>
+<div id="content">
+
+</div>
+<div id="footer">
+<div id="footer-text">
+Last updated {{.LastUpdated}}
+</div>
+</div>
+</body>
+</html>`
+			verify(GinkgoT(), expectedResult, actualContent, renderer.IncludeHeaderFooter(true), renderer.LastUpdated(time.Now()))
+		})
+	})
+
+	Context("embedded stylesheets", func() {
+
+		It("custom stylesheet", func() {
+			actualContent := `= The Title
+:stylesdir: css
+:stylesheet: custom.css`
+			expectedResult := `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="UTF-8">
+<!--[if IE]><meta http-equiv="X-UA-Compatible" content="IE=edge"><![endif]-->
+<meta name="viewport" content="width=device-width, initial-scale=1.0">
+<meta name="generator" content="libasciidoc">
+<title>The Title</title>
+<style>
+body > p { color: red; }
+</style>
+<body class="article">
+<div id="header">
+<h1>The Title</h1>
+</div>
+<div id="content">
+
+</div>
+<div id="footer">
+<div id="footer-text">
+Last updated {{.LastUpdated}}
+</div>
+</div>
+</body>
+</html>`
+			resolver := inMemoryResolver{
+				filepath.Join("css", "custom.css"): "body > p { color: red; }\n",
+			}
+			verify(GinkgoT(), expectedResult, actualContent, renderer.IncludeHeaderFooter(true), renderer.LastUpdated(time.Now()), renderer.IncludeResolver(resolver))
+		})
+
+		It("unresolved custom stylesheet", func() {
+			actualContent := `= The Title
+:stylesheet: unknown.css`
+			doc, err := parser.ParseReader("", strings.NewReader(actualContent))
+			require.NoError(GinkgoT(), err)
+			ctx := renderer.Wrap(context.Background(), doc.(types.Document), renderer.IncludeHeaderFooter(true), renderer.IncludeResolver(inMemoryResolver{}))
+			buff := bytes.NewBuffer(nil)
+			_, err = html5.Render(ctx, buff)
+			require.NoError(GinkgoT(), err)
+			assert.NotContains(GinkgoT(), buff.String(), "<style>")
+			require.Len(GinkgoT(), *ctx.Diagnostics(), 1)
+			assert.Equal(GinkgoT(), "unresolved-stylesheet", (*ctx.Diagnostics())[0].Rule)
+		})
+
+		Context("in the server safe mode", func() {
+
+			It("do not embed a stylesheet with an absolute path", func() {
+				actualContent := `= The Title
+:stylesheet: /etc/hostname`
+				doc, err := parser.ParseReader("", strings.NewReader(actualContent))
+				require.NoError(GinkgoT(), err)
+				resolver := inMemoryResolver{
+					"/etc/hostname": "localhost\n",
+				}
+				ctx := renderer.Wrap(context.Background(), doc.(types.Document), renderer.IncludeHeaderFooter(true), renderer.Safe(renderer.SafeModeServer), renderer.IncludeResolver(resolver))
+				ctx.SetFilename(filepath.Join("docs", "index.adoc"))
+				buff := bytes.NewBuffer(nil)
+				_, err = html5.Render(ctx, buff)
+				require.NoError(GinkgoT(), err)
+				assert.NotContains(GinkgoT(), buff.String(), "localhost")
+				assert.NotContains(GinkgoT(), buff.String(), "<style>")
+				require.Len(GinkgoT(), *ctx.Diagnostics(), 1)
+				assert.Equal(GinkgoT(), "unsafe-stylesheet", (*ctx.Diagnostics())[0].Rule)
+			})
+
+			It("do not embed a stylesheet outside of the document directory", func() {
+				actualContent := `= The Title
+:stylesheet: ../../etc/hostname`
+				doc, err := parser.ParseReader("", strings.NewReader(actualContent))
+				require.NoError(GinkgoT(), err)
+				resolver := inMemoryResolver{
+					filepath.Join("..", "etc", "hostname"): "localhost\n",
+				}
+				ctx := renderer.Wrap(context.Background(), doc.(types.Document), renderer.IncludeHeaderFooter(true), renderer.Safe(renderer.SafeModeServer), renderer.IncludeResolver(resolver))
+				ctx.SetFilename(filepath.Join("docs", "index.adoc"))
+				buff := bytes.NewBuffer(nil)
+				_, err = html5.Render(ctx, buff)
+				require.NoError(GinkgoT(), err)
+				assert.NotContains(GinkgoT(), buff.String(), "localhost")
+				assert.NotContains(GinkgoT(), buff.String(), "<style>")
+				require.Len(GinkgoT(), *ctx.Diagnostics(), 1)
+				assert.Equal(GinkgoT(), "unsafe-stylesheet", (*ctx.Diagnostics())[0].Rule)
+			})
+
+			It("embed a custom stylesheet in the document directory", func() {
+				actualContent := `= The Title
+:stylesdir: css
+:stylesheet: custom.css`
+				doc, err := parser.ParseReader("", strings.NewReader(actualContent))
+				require.NoError(GinkgoT(), err)
+				resolver := inMemoryResolver{
+					filepath.Join("docs", "css", "custom.css"): "body > p { color: red; }\n",
+				}
+				ctx := renderer.Wrap(context.Background(), doc.(types.Document), renderer.IncludeHeaderFooter(true), renderer.Safe(renderer.SafeModeServer), renderer.IncludeResolver(resolver))
+				ctx.SetFilename(filepath.Join("docs", "index.adoc"))
+				buff := bytes.NewBuffer(nil)
+				_, err = html5.Render(ctx, buff)
+				require.NoError(GinkgoT(), err)
+				assert.Contains(GinkgoT(), buff.String(), "<style>\nbody > p { color: red; }\n</style>")
+				assert.Empty(GinkgoT(), *ctx.Diagnostics())
+			})
+		})
+	})
+
+	It("no stylesheet", func() {
+		actualContent := `= The Title
+:stylesheet!:`
+		expectedResult := `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="UTF-8">
+<!--[if IE]><meta http-equiv="X-UA-Compatible" content="IE=edge"><![endif]-->
+<meta name="viewport" content="width=device-width, initial-scale=1.0">
+<meta name="generator" content="libasciidoc">
+<title>The Title</title>
+<body class="article">
+<div id="header">
+<h1>The Title</h1>
+</div>
+<div id="content">
+
+</div>
+<div id="footer">
+<div id="footer-text">
+Last updated {{.LastUpdated}}
+</div>
+</div>
+</body>
+</html>`
+		verify(GinkgoT(), expectedResult, actualContent, renderer.IncludeHeaderFooter(true), renderer.LastUpdated(time.Now()))
+	})
+
+	Context("copy of the stylesheet", func() {
+
+		var dir string
+
+		BeforeEach(func() {
+			var err error
+			dir, err = ioutil.TempDir("", "stylesheet")
+			require.NoError(GinkgoT(), err)
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(dir)
+		})
+
+		It("copy the default stylesheet", func() {
+			metadata := map[string]interface{}{
+				html5.AttrLinkCSS: "",
+				html5.AttrCopyCSS: "",
+			}
+			err := html5.CopyStylesheet(metadata, "doc.adoc", filepath.Join(dir, "doc.html"))
+			require.NoError(GinkgoT(), err)
+			content, err := ioutil.ReadFile(filepath.Join(dir, html5.DefaultStylesheetName))
+			require.NoError(GinkgoT(), err)
+			assert.Equal(GinkgoT(), html5.DefaultStylesheet, string(content))
+		})
+
+		It("copy a custom stylesheet in the styles directory", func() {
+			sourceDir := filepath.Join(dir, "src")
+			require.NoError(GinkgoT(), os.MkdirAll(filepath.Join(sourceDir, "css"), 0755))
+			require.NoError(GinkgoT(), ioutil.WriteFile(filepath.Join(sourceDir, "css", "custom.css"), []byte("p { color: red; }"), 0644))
+			metadata := map[string]interface{}{
+				html5.AttrLinkCSS:    "",
+				html5.AttrCopyCSS:    "",
+				html5.AttrStylesDir:  "css",
+				html5.AttrStylesheet: "custom.css",
+			}
+			err := html5.CopyStylesheet(metadata, filepath.Join(sourceDir, "doc.adoc"), filepath.Join(dir, "out", "doc.html"))
+			require.NoError(GinkgoT(), err)
+			content, err := ioutil.ReadFile(filepath.Join(dir, "out", "css", "custom.css"))
+			require.NoError(GinkgoT(), err)
+			assert.Equal(GinkgoT(), "p { color: red; }", string(content))
+		})
+
+		It("do not copy a custom stylesheet outside of the document directory in the safe mode", func() {
+			sourceDir := filepath.Join(dir, "src")
+			require.NoError(GinkgoT(), os.MkdirAll(sourceDir, 0755))
+			require.NoError(GinkgoT(), ioutil.WriteFile(filepath.Join(dir, "custom.css"), []byte("p { color: red; }"), 0644))
+			metadata := map[string]interface{}{
+				html5.AttrLinkCSS:    "",
+				html5.AttrCopyCSS:    "",
+				html5.AttrStylesheet: "../custom.css",
+				"safe-mode-name":     "safe",
+				"safe-mode-level":    "1",
+			}
+			err := html5.CopyStylesheet(metadata, filepath.Join(sourceDir, "doc.adoc"), filepath.Join(dir, "out", "html", "doc.html"))
+			require.Error(GinkgoT(), err)
+			_, err = os.Stat(filepath.Join(dir, "custom.css"))
+			require.NoError(GinkgoT(), err)
+			_, err = os.Stat(filepath.Join(dir, "out"))
+			assert.True(GinkgoT(), os.IsNotExist(err))
+		})
+
+		It("do not copy the stylesheet unless it is linked", func() {
+			metadata := map[string]interface{}{
+				html5.AttrCopyCSS: "",
+			}
+			err := html5.CopyStylesheet(metadata, "doc.adoc", filepath.Join(dir, "doc.html"))
+			require.NoError(GinkgoT(), err)
+			_, err = os.Stat(filepath.Join(dir, html5.DefaultStylesheetName))
+			assert.True(GinkgoT(), os.IsNotExist(err))
+		})
+	})
+})
+
+// inMemoryResolver a FileResolver which serves the content of the files from memory
+type inMemoryResolver map[string]string
+
+func (r inMemoryResolver) Open(path string) (io.ReadCloser, error) {
+	if content, found := r[path]; found {
+		return ioutil.NopCloser(strings.NewReader(content)), nil
+	}
+	return nil, os.ErrNotExist
+}
@@ -0,0 +1,263 @@
+package html5
+
+import (
+	"bytes"
+	"html"
+	"strings"
+	"unicode"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+)
+
+// AttrSourceHighlighter the attribute which enables the built-in SyntaxHighlighter (whatever its value, eg: `rouge`),
+// unless another highlighter was set with the `renderer.Highlighter` option
+const AttrSourceHighlighter string = "source-highlighter"
+
+// highlighter returns the SyntaxHighlighter set with the `renderer.Highlighter` option, or the built-in one
+// if the `source-highlighter` attribute is set, or `nil` if the source blocks are not highlighted
+func highlighter(ctx *renderer.Context) renderer.SyntaxHighlighter {
+	if h := ctx.Highlighter(); h != nil {
+		return h
+	}
+	if name := ctx.Document.Attributes.GetAsString(AttrSourceHighlighter); name != nil && *name != "" {
+		return NewSyntaxHighlighter()
+	}
+	return nil
+}
+
+// NewSyntaxHighlighter returns the built-in SyntaxHighlighter, which recognizes the keywords, constants,
+// comments, strings and numbers of a few common languages. The tokens are wrapped in `<span>` elements
+// with the same short CSS classes as Pygments and Rouge (`k`, `kc`, `c`, `s` and `m`), so their stylesheets can be used.
+func NewSyntaxHighlighter() renderer.SyntaxHighlighter {
+	return builtinHighlighter{}
+}
+
+type builtinHighlighter struct{}
+
+// Name returns the name of the highlighter
+func (h builtinHighlighter) Name() string {
+	return "builtin"
+}
+
+// Highlight returns the HTML for the given source code in the given language.
+// The content is only escaped if the language is not supported.
+func (h builtinHighlighter) Highlight(source, language string) (string, error) {
+	l, found := languages[strings.ToLower(language)]
+	if !found {
+		return html.EscapeString(source), nil
+	}
+	result := bytes.NewBuffer(nil)
+	src := []rune(source)
+	for i := 0; i < len(src); {
+		if n := l.comment(src[i:]); n > 0 {
+			writeToken(result, "c", src[i:i+n])
+			i += n
+		} else if n := l.str(src[i:]); n > 0 {
+			writeToken(result, "s", src[i:i+n])
+			i += n
+		} else if unicode.IsDigit(src[i]) {
+			n := 1
+			for i+n < len(src) && (unicode.IsDigit(src[i+n]) || unicode.IsLetter(src[i+n]) || src[i+n] == '.' || src[i+n] == '_') {
+				n++
+			}
+			writeToken(result, "m", src[i:i+n])
+			i += n
+		} else if isIdentifierStart(src[i]) {
+			n := 1
+			for i+n < len(src) && isIdentifierPart(src[i+n]) {
+				n++
+			}
+			word := string(src[i : i+n])
+			switch {
+			case l.keywords[word]:
+				writeToken(result, "k", src[i:i+n])
+			case l.constants[word]:
+				writeToken(result, "kc", src[i:i+n])
+			default:
+				result.WriteString(html.EscapeString(word))
+			}
+			i += n
+		} else {
+			result.WriteString(html.EscapeString(string(src[i])))
+			i++
+		}
+	}
+	return result.String(), nil
+}
+
+func writeToken(buff *bytes.Buffer, class string, token []rune) {
+	buff.WriteString(`<span class="`)
+	buff.WriteString(class)
+	buff.WriteString(`">`)
+	buff.WriteString(html.EscapeString(string(token)))
+	buff.WriteString(`</span>`)
+}
+
+func isIdentifierStart(r rune) bool {
+	return unicode.IsLetter(r) || r == '_' || r == '$'
+}
+
+func isIdentifierPart(r rune) bool {
+	return isIdentifierStart(r) || unicode.IsDigit(r)
+}
+
+// language the lexical elements of a language
+type language struct {
+	keywords      map[string]bool
+	constants     map[string]bool
+	lineComments  []string
+	blockComments [][2]string
+	quotes        string
+}
+
+// comment returns the length of the comment at the beginning of the given source, or 0 if there is none
+func (l language) comment(src []rune) int {
+	for _, c := range l.lineComments {
+		if hasPrefix(src, c) {
+			n := len([]rune(c))
+			for n < len(src) && src[n] != '\n' {
+				n++
+			}
+			return n
+		}
+	}
+	for _, c := range l.blockComments {
+		if hasPrefix(src, c[0]) {
+			n := len([]rune(c[0]))
+			for n < len(src) && !hasPrefix(src[n:], c[1]) {
+				n++
+			}
+			if n < len(src) {
+				n += len([]rune(c[1]))
+			}
+			return n
+		}
+	}
+	return 0
+}
+
+// str returns the length of the string literal at the beginning of the given source, or 0 if there is none
+func (l language) str(src []rune) int {
+	if !strings.ContainsRune(l.quotes, src[0]) {
+		return 0
+	}
+	quote := src[0]
+	n := 1
+	for n < len(src) && src[n] != quote {
+		if src[n] == '\\' && quote != '`' {
+			n++
+		} else if src[n] == '\n' && quote != '`' {
+			// unterminated string
+			return n
+		}
+		n++
+	}
+	if n < len(src) {
+		n++
+	}
+	return n
+}
+
+func hasPrefix(src []rune, prefix string) bool {
+	p := []rune(prefix)
+	if len(src) < len(p) {
+		return false
+	}
+	for i, r := range p {
+		if src[i] != r {
+			return false
+		}
+	}
+	return true
+}
+
+func words(s string) map[string]bool {
+	result := map[string]bool{}
+	for _, w := range strings.Fields(s) {
+		result[w] = true
+	}
+	return result
+}
+
+var cStyleComments = struct {
+	line  []string
+	block [][2]string
+}{
+	line:  []string{"//"},
+	block: [][2]string{{"/*", "*/"}},
+}
+
+var languages = map[string]language{}
+
+func init() {
+	golang := language{
+		keywords:      words("break case chan const continue default defer else fallthrough for func go goto if import interface map package range return select struct switch type var"),
+		constants:     words("true false nil iota"),
+		lineComments:  cStyleComments.line,
+		blockComments: cStyleComments.block,
+		quotes:        "\"'`",
+	}
+	java := language{
+		keywords:      words("abstract assert boolean break byte case catch char class const continue default do double else enum extends final finally float for goto if implements import instanceof int interface long native new package private protected public return short static strictfp super switch synchronized this throw throws transient try var void volatile while"),
+		constants:     words("true false null"),
+		lineComments:  cStyleComments.line,
+		blockComments: cStyleComments.block,
+		quotes:        "\"'",
+	}
+	javascript := language{
+		keywords:      words("async await break case catch class const continue debugger default delete do else export extends finally for function if import in instanceof let new of return super switch this throw try typeof var void while with yield"),
+		constants:     words("true false null undefined NaN Infinity"),
+		lineComments:  cStyleComments.line,
+		blockComments: cStyleComments.block,
+		quotes:        "\"'`",
+	}
+	c := language{
+		keywords:      words("auto break case char class const continue default delete do double else enum extern float for goto if inline int long namespace new private protected public register return short signed sizeof static struct switch template this typedef union unsigned using virtual void volatile while"),
+		constants:     words("true false NULL nullptr"),
+		lineComments:  cStyleComments.line,
+		blockComments: cStyleComments.block,
+		quotes:        "\"'",
+	}
+	python := language{
+		keywords:     words("and as assert async await break class continue def del elif else except finally for from global if import in is lambda nonlocal not or pass raise return try while with yield"),
+		constants:    words("True False None"),
+		lineComments: []string{"#"},
+		quotes:       "\"'",
+	}
+	ruby := language{
+		keywords:     words("alias and begin break case class def defined? do else elsif end ensure for if in module next not or redo rescue retry return self super then undef unless until when while yield require"),
+		constants:    words("true false nil"),
+		lineComments: []string{"#"},
+		quotes:       "\"'",
+	}
+	shell := language{
+		keywords:     words("case do done elif else esac export fi for function if in local return then until while echo exit"),
+		constants:    words("true false"),
+		lineComments: []string{"#"},
+		quotes:       "\"'",
+	}
+	json := language{
+		constants: words("true false null"),
+		quotes:    "\"",
+	}
+	for name, l := range map[string]language{
+		"go":         golang,
+		"golang":     golang,
+		"java":       java,
+		"javascript": javascript,
+		"js":         javascript,
+		"c":          c,
+		"cpp":        c,
+		"c++":        c,
+		"python":     python,
+		"py":         python,
+		"ruby":       ruby,
+		"rb":         ruby,
+		"sh":         shell,
+		"bash":       shell,
+		"shell":      shell,
+		"json":       json,
+	} {
+		languages[name] = l
+	}
+}
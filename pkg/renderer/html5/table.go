@@ -0,0 +1,248 @@
+package html5
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+var tableTmpl texttemplate.Template
+
+// initializes the templates
+func init() {
+	tableTmpl = newTextTemplate("table", `{{ with .Data }}<table{{ if .ID }} id="{{ .ID }}"{{ end }} class="tableblock frame-{{ .Frame }} grid-{{ .Grid }} {{ .Fit }}">{{ if .Title }}
+<caption class="title">{{ .Title }}</caption>{{ end }}
+<colgroup>
+{{ range .Columns }}<col{{ if .Width }} style="width: {{ .Width }}%;"{{ end }}>
+{{ end }}</colgroup>{{ if .Header }}
+<thead>
+{{ template "rows" .Header }}
+</thead>{{ end }}{{ if .Rows }}
+<tbody>
+{{ template "rows" .Rows }}
+</tbody>{{ end }}{{ if .Footer }}
+<tfoot>
+{{ template "rows" .Footer }}
+</tfoot>{{ end }}
+</table>{{ end }}{{ define "rows" }}{{ $rows := . }}{{ range $index, $row := $rows }}<tr>
+{{ range $row }}<{{ .Tag }} class="tableblock halign-{{ .HAlign }} valign-{{ .VAlign }}"{{ if gt .ColSpan 1 }} colspan="{{ .ColSpan }}"{{ end }}{{ if gt .RowSpan 1 }} rowspan="{{ .RowSpan }}"{{ end }}>{{ .Content }}</{{ .Tag }}>
+{{ end }}</tr>{{ if notLast $index $rows }}{{ print "\n" }}{{ end }}{{ end }}{{ end }}`,
+		texttemplate.FuncMap{
+			"notLast": func(index int, rows [][]tableCell) bool {
+				return index < len(rows)-1
+			},
+		})
+}
+
+type tableColumn struct {
+	Width string
+}
+
+type tableCell struct {
+	Tag     string
+	HAlign  types.HAlignment
+	VAlign  types.VAlignment
+	ColSpan int
+	RowSpan int
+	Content string
+}
+
+func renderTable(ctx *renderer.Context, t types.Table) ([]byte, error) {
+	log.Debugf("rendering table with %d column(s) and %d row(s)", len(t.Columns), len(t.Rows))
+	result := bytes.NewBuffer(nil)
+	var id, title string
+	if i, ok := t.Attributes[types.AttrID].(string); ok {
+		id = i
+	}
+	if ti, ok := t.Attributes[types.AttrTitle].(string); ok {
+		caption := "Table"
+		if c, ok := ctx.Document.Attributes["table-caption"].(string); ok {
+			caption = c
+		}
+		title = fmt.Sprintf("%s %d. %s", caption, ctx.GetAndIncrementTableCounter(), ti)
+	}
+	frame := "all"
+	if f, ok := t.Attributes[types.AttrFrame].(string); ok {
+		frame = f
+	}
+	grid := "all"
+	if g, ok := t.Attributes[types.AttrGrid].(string); ok {
+		grid = g
+	}
+	fit := "stretch"
+	columns := tableColumns(t.Columns)
+	if len(t.Columns) > 0 && t.Columns[0].AutoWidth {
+		fit = "fit-content"
+	}
+	header, err := renderTableRows(ctx, t, "th", false, t.Header)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render table")
+	}
+	rows, err := renderTableRows(ctx, t, "td", true, t.Rows...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render table")
+	}
+	footer, err := renderTableRows(ctx, t, "td", true, t.Footer)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render table")
+	}
+	err = executeTemplate(ctx, &tableTmpl, result, ContextualPipeline{
+		Context: ctx,
+		Data: struct {
+			ID      string
+			Title   string
+			Frame   string
+			Grid    string
+			Fit     string
+			Columns []tableColumn
+			Header  [][]tableCell
+			Rows    [][]tableCell
+			Footer  [][]tableCell
+		}{
+			ID:      id,
+			Title:   title,
+			Frame:   frame,
+			Grid:    grid,
+			Fit:     fit,
+			Columns: columns,
+			Header:  header,
+			Rows:    rows,
+			Footer:  footer,
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render table")
+	}
+	return result.Bytes(), nil
+}
+
+// tableColumns computes the width of each column, in percent of the total width of the table.
+// Like Asciidoctor, the widths are truncated with a precision of 4 decimals, and the last column
+// takes the remaining width
+func tableColumns(columns []types.TableColumn) []tableColumn {
+	result := make([]tableColumn, len(columns))
+	total := 0
+	for _, c := range columns {
+		total += c.Width
+	}
+	if total == 0 {
+		return result
+	}
+	sum := 0.0
+	for i, c := range columns {
+		if c.AutoWidth {
+			continue
+		}
+		var width float64
+		if i < len(columns)-1 {
+			width = math.Trunc(float64(c.Width)*100/float64(total)*10000) / 10000
+			sum += width
+		} else {
+			width = math.Round((100-sum)*10000) / 10000
+		}
+		result[i] = tableColumn{
+			Width: strconv.FormatFloat(width, 'f', -1, 64),
+		}
+	}
+	return result
+}
+
+func renderTableRows(ctx *renderer.Context, t types.Table, tag string, paragraphs bool, rows ...types.TableRow) ([][]tableCell, error) {
+	result := make([][]tableCell, 0, len(rows))
+	for _, row := range rows {
+		if len(row.Cells) == 0 {
+			continue
+		}
+		cells := make([]tableCell, 0, len(row.Cells))
+		for _, c := range row.Cells {
+			content, err := renderTableCellContent(ctx, c, paragraphs)
+			if err != nil {
+				return nil, errors.Wrapf(err, "unable to render table cell")
+			}
+			cell := tableCell{
+				Tag:     tag,
+				HAlign:  c.HAlign,
+				VAlign:  c.VAlign,
+				ColSpan: c.ColSpan,
+				RowSpan: c.RowSpan,
+				Content: content,
+			}
+			if cell.HAlign == types.HAlignDefault {
+				cell.HAlign = types.HAlignLeft
+			}
+			if cell.VAlign == types.VAlignDefault {
+				cell.VAlign = types.VAlignTop
+			}
+			if c.Style == types.HeaderCellStyle {
+				cell.Tag = "th"
+			}
+			cells = append(cells, cell)
+		}
+		result = append(result, cells)
+	}
+	return result, nil
+}
+
+func renderTableCellContent(ctx *renderer.Context, c types.TableCell, paragraphs bool) (string, error) {
+	if len(c.Lines) == 0 {
+		return "", nil
+	}
+	switch c.Style {
+	case types.AsciidocCellStyle:
+		p, err := types.NewParagraph(toInterfaces(c.Lines), nil)
+		if err != nil {
+			return "", err
+		}
+		content, err := renderParagraph(ctx, p)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("<div class=\"content\">%s</div>", content), nil
+	case types.LiteralCellStyle:
+		buff := bytes.NewBuffer(nil)
+		for i, l := range c.Lines {
+			content, err := renderPlainString(ctx, l)
+			if err != nil {
+				return "", err
+			}
+			if i > 0 {
+				buff.WriteString("\n")
+			}
+			texttemplate.HTMLEscape(buff, content)
+		}
+		return fmt.Sprintf("<div class=\"literal\"><pre>%s</pre></div>", buff.String()), nil
+	}
+	content, err := renderAllInlineElements(ctx, c.Lines)
+	if err != nil {
+		return "", err
+	}
+	text := strings.TrimSpace(string(content))
+	switch c.Style {
+	case types.EmphasisCellStyle:
+		text = fmt.Sprintf("<em>%s</em>", text)
+	case types.MonospaceCellStyle:
+		text = fmt.Sprintf("<code>%s</code>", text)
+	case types.StrongCellStyle:
+		text = fmt.Sprintf("<strong>%s</strong>", text)
+	}
+	if !paragraphs {
+		return text, nil
+	}
+	return fmt.Sprintf("<p class=\"tableblock\">%s</p>", text), nil
+}
+
+func toInterfaces(lines []types.InlineElements) []interface{} {
+	result := make([]interface{}, len(lines))
+	for i, l := range lines {
+		result[i] = l
+	}
+	return result
+}
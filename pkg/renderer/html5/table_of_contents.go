@@ -15,11 +15,11 @@ var tableOfContentTmpl template.Template
 var tableOfContentSectionSetTmpl template.Template
 
 func init() {
-	tableOfContentTmpl = newHTMLTemplate("toc", `<div id="toc" class="toc">
+	tableOfContentTmpl = newHTMLTemplate("table-of-contents", `<div id="toc" class="toc">
 <div id="toctitle">Table of Contents</div>
 {{.Content}}
 </div>`)
-	tableOfContentSectionSetTmpl = newHTMLTemplate("toc section", `<ul class="sectlevel{{.Level}}">
+	tableOfContentSectionSetTmpl = newHTMLTemplate("table-of-contents-sections", `<ul class="sectlevel{{.Level}}">
 {{ range .Elements }}<li><a href="#{{.Href}}">{{.Title}}</a>{{ if .Subelements }}
 {{.Subelements}}
 </li>{{else}}</li>{{end}}
@@ -42,11 +42,11 @@ type TableOfContentSection struct {
 
 func renderTableOfContent(ctx *renderer.Context, m types.TableOfContentsMacro) ([]byte, error) {
 	result := bytes.NewBuffer(nil)
-	renderedSections, err := renderTableOfContentSections(ctx, ctx.Document.Elements, 1)
+	renderedSections, err := renderTableOfContentSections(ctx, ctx.Document.Elements)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error while rendering table of content")
 	}
-	err = tableOfContentTmpl.Execute(result, TableOfContent{
+	err = executeTemplate(ctx, &tableOfContentTmpl, result, TableOfContent{
 		Content: *renderedSections,
 	})
 	if err != nil {
@@ -56,7 +56,9 @@ func renderTableOfContent(ctx *renderer.Context, m types.TableOfContentsMacro) (
 	return result.Bytes(), nil
 }
 
-func renderTableOfContentSections(ctx *renderer.Context, elements []interface{}, currentLevel int) (*template.HTML, error) {
+// renderTableOfContentSections renders the given sections and their subsections down to the `toclevels` level.
+// The parts of a book (level 0 sections) are always included, along with their chapters.
+func renderTableOfContentSections(ctx *renderer.Context, elements []interface{}) (*template.HTML, error) {
 	sections := make([]TableOfContentSection, 0)
 	for _, element := range elements {
 		log.Debugf("traversing document element of type %T", element)
@@ -71,8 +73,8 @@ func renderTableOfContentSections(ctx *renderer.Context, elements []interface{},
 				return nil, errors.Wrapf(err, "error while rendering table of content section")
 			}
 			var renderedChildSections *template.HTML
-			if currentLevel < *tocLevels {
-				renderedChildSections, err = renderTableOfContentSections(ctx, section.Elements, currentLevel+1)
+			if section.Level < *tocLevels {
+				renderedChildSections, err = renderTableOfContentSections(ctx, section.Elements)
 				if err != nil {
 					return nil, errors.Wrapf(err, "error while rendering table of content section")
 				}
@@ -81,7 +83,7 @@ func renderTableOfContentSections(ctx *renderer.Context, elements []interface{},
 			if i, ok := section.Title.Attributes[types.AttrID].(string); ok {
 				id = i
 			}
-			renderedTitleStr := strings.TrimSpace(string(renderedTitle))
+			renderedTitleStr := template.HTMLEscapeString(ctx.SectionNumber(id)) + strings.TrimSpace(string(renderedTitle))
 			sections = append(sections, TableOfContentSection{
 				Level:       section.Level,
 				Href:        id,
@@ -93,9 +95,16 @@ func renderTableOfContentSections(ctx *renderer.Context, elements []interface{},
 	if len(sections) == 0 {
 		return nil, nil
 	}
+	// the level of the group is that of its highest sections (eg: the parts of a book after a preface)
+	level := sections[0].Level
+	for _, s := range sections {
+		if s.Level < level {
+			level = s.Level
+		}
+	}
 	resultBuf := bytes.NewBuffer(nil)
-	tableOfContentSectionSetTmpl.Execute(resultBuf, TableOfContentSectionGroup{
-		Level:    sections[0].Level,
+	executeTemplate(ctx, &tableOfContentSectionSetTmpl, resultBuf, TableOfContentSectionGroup{
+		Level:    level,
 		Elements: sections,
 	})
 	log.Debugf("retrieved sections for TOC: %+v", sections)
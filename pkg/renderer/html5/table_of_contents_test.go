@@ -171,4 +171,157 @@ A preamble...
 		})
 
 	})
+	Context("document with numbered sections", func() {
+
+		It("toc with section numbers", func() {
+			actualContent := `= A title
+:toc:
+:sectnums:
+
+== Section A
+
+=== Section A.a
+
+== Section B`
+			expectedResult := `<div id="toc" class="toc">
+<div id="toctitle">Table of Contents</div>
+<ul class="sectlevel1">
+<li><a href="#_section_a">1. Section A</a>
+<ul class="sectlevel2">
+<li><a href="#_section_a_a">1.1. Section A.a</a></li>
+</ul>
+</li>
+<li><a href="#_section_b">2. Section B</a></li>
+</ul>
+</div>
+<div id="preamble">
+<div class="sectionbody">
+
+</div>
+</div>
+<div class="sect1">
+<h2 id="_section_a">1. Section A</h2>
+<div class="sectionbody">
+<div class="sect2">
+<h3 id="_section_a_a">1.1. Section A.a</h3>
+</div>
+</div>
+</div>
+<div class="sect1">
+<h2 id="_section_b">2. Section B</h2>
+<div class="sectionbody">
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+	})
+	Context("book with parts", func() {
+
+		It("toc with parts, chapters and appendix", func() {
+			actualContent := `= The Book
+:doctype: book
+:toc:
+:sectnums:
+
+= First Part
+
+== First Chapter
+
+=== First Section
+
+= Second Part
+
+== Second Chapter
+
+[appendix]
+== The Appendix`
+			expectedResult := `<div id="toc" class="toc">
+<div id="toctitle">Table of Contents</div>
+<ul class="sectlevel0">
+<li><a href="#_first_part">First Part</a>
+<ul class="sectlevel1">
+<li><a href="#_first_chapter">1. First Chapter</a>
+<ul class="sectlevel2">
+<li><a href="#_first_section">1.1. First Section</a></li>
+</ul>
+</li>
+</ul>
+</li>
+<li><a href="#_second_part">Second Part</a>
+<ul class="sectlevel1">
+<li><a href="#_second_chapter">2. Second Chapter</a></li>
+<li><a href="#_the_appendix">Appendix A: The Appendix</a></li>
+</ul>
+</li>
+</ul>
+</div>
+<div id="preamble">
+<div class="sectionbody">
+
+</div>
+</div>
+<h1 id="_first_part" class="sect0">First Part</h1>
+<div class="sect1">
+<h2 id="_first_chapter">1. First Chapter</h2>
+<div class="sectionbody">
+<div class="sect2">
+<h3 id="_first_section">1.1. First Section</h3>
+</div>
+</div>
+</div>
+<h1 id="_second_part" class="sect0">Second Part</h1>
+<div class="sect1">
+<h2 id="_second_chapter">2. Second Chapter</h2>
+<div class="sectionbody">
+</div>
+</div>
+<div class="sect1 appendix">
+<h2 id="_the_appendix">Appendix A: The Appendix</h2>
+<div class="sectionbody">
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("toc with a preface before the parts", func() {
+			actualContent := `= The Book
+:doctype: book
+:toc:
+
+[preface]
+== Preface
+
+= First Part
+
+== First Chapter`
+			expectedResult := `<div id="toc" class="toc">
+<div id="toctitle">Table of Contents</div>
+<ul class="sectlevel0">
+<li><a href="#_preface">Preface</a></li>
+<li><a href="#_first_part">First Part</a>
+<ul class="sectlevel1">
+<li><a href="#_first_chapter">First Chapter</a></li>
+</ul>
+</li>
+</ul>
+</div>
+<div id="preamble">
+<div class="sectionbody">
+
+</div>
+</div>
+<div class="sect1 preface">
+<h2 id="_preface">Preface</h2>
+<div class="sectionbody">
+</div>
+</div>
+<h1 id="_first_part" class="sect0">First Part</h1>
+<div class="sect1">
+<h2 id="_first_chapter">First Chapter</h2>
+<div class="sectionbody">
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+	})
 })
@@ -0,0 +1,113 @@
+package html5_test
+
+import . "github.com/onsi/ginkgo"
+
+var _ = Describe("tables", func() {
+
+	It("1-line table with 2 cells", func() {
+		actualContent := `|===
+| *cookie* cake | pasta
+|===`
+		expectedResult := `<table class="tableblock frame-all grid-all stretch">
+<colgroup>
+<col style="width: 50%;">
+<col style="width: 50%;">
+</colgroup>
+<tbody>
+<tr>
+<td class="tableblock halign-left valign-top"><p class="tableblock"><strong>cookie</strong> cake</p></td>
+<td class="tableblock halign-left valign-top"><p class="tableblock">pasta</p></td>
+</tr>
+</tbody>
+</table>`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+
+	It("table with implicit header", func() {
+		actualContent := `|===
+| Column 1 | Column 2
+
+| A
+| B
+|===`
+		expectedResult := `<table class="tableblock frame-all grid-all stretch">
+<colgroup>
+<col style="width: 50%;">
+<col style="width: 50%;">
+</colgroup>
+<thead>
+<tr>
+<th class="tableblock halign-left valign-top">Column 1</th>
+<th class="tableblock halign-left valign-top">Column 2</th>
+</tr>
+</thead>
+<tbody>
+<tr>
+<td class="tableblock halign-left valign-top"><p class="tableblock">A</p></td>
+<td class="tableblock halign-left valign-top"><p class="tableblock">B</p></td>
+</tr>
+</tbody>
+</table>`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+
+	It("table with title, cols, footer and spans", func() {
+		actualContent := `.table title
+[cols="1,1,1", options="footer"]
+|===
+2+>| A | B
+.2+| C | D | E
+| F | G
+|===`
+		expectedResult := `<table class="tableblock frame-all grid-all stretch">
+<caption class="title">Table 1. table title</caption>
+<colgroup>
+<col style="width: 33.3333%;">
+<col style="width: 33.3333%;">
+<col style="width: 33.3334%;">
+</colgroup>
+<tbody>
+<tr>
+<td class="tableblock halign-right valign-top" colspan="2"><p class="tableblock">A</p></td>
+<td class="tableblock halign-left valign-top"><p class="tableblock">B</p></td>
+</tr>
+<tr>
+<td class="tableblock halign-left valign-top" rowspan="2"><p class="tableblock">C</p></td>
+<td class="tableblock halign-left valign-top"><p class="tableblock">D</p></td>
+<td class="tableblock halign-left valign-top"><p class="tableblock">E</p></td>
+</tr>
+</tbody>
+<tfoot>
+<tr>
+<td class="tableblock halign-left valign-top"><p class="tableblock">F</p></td>
+<td class="tableblock halign-left valign-top"><p class="tableblock">G</p></td>
+</tr>
+</tfoot>
+</table>`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+
+	It("table with styled columns", func() {
+		actualContent := `[cols="e,m,s,l"]
+|===
+| emphasis | mono | strong | literal
+|===`
+		expectedResult := `<table class="tableblock frame-all grid-all stretch">
+<colgroup>
+<col style="width: 25%;">
+<col style="width: 25%;">
+<col style="width: 25%;">
+<col style="width: 25%;">
+</colgroup>
+<tbody>
+<tr>
+<td class="tableblock halign-left valign-top"><p class="tableblock"><em>emphasis</em></p></td>
+<td class="tableblock halign-left valign-top"><p class="tableblock"><code>mono</code></p></td>
+<td class="tableblock halign-left valign-top"><p class="tableblock"><strong>strong</strong></p></td>
+<td class="tableblock halign-left valign-top"><div class="literal"><pre>literal</pre></div></td>
+</tr>
+</tbody>
+</table>`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+})
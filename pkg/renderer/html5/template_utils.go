@@ -2,15 +2,25 @@ package html5
 
 import (
 	htmltemplate "html/template"
+	"io"
 	texttemplate "text/template"
 
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
 	log "github.com/sirupsen/logrus"
 )
 
+// templateFuncs the functions of the built-in templates, indexed by the name of the templates,
+// which are also available in the custom templates which override them
+var templateFuncs = map[string]texttemplate.FuncMap{}
+
 func newHTMLTemplate(name, src string, funcs ...htmltemplate.FuncMap) htmltemplate.Template {
 	t := htmltemplate.New(name)
+	templateFuncs[name] = texttemplate.FuncMap{}
 	for _, f := range funcs {
 		t.Funcs(f)
+		for k, v := range f {
+			templateFuncs[name][k] = v
+		}
 	}
 	t, err := t.Parse(src)
 	if err != nil {
@@ -21,8 +31,12 @@ func newHTMLTemplate(name, src string, funcs ...htmltemplate.FuncMap) htmltempla
 
 func newTextTemplate(name, src string, funcs ...texttemplate.FuncMap) texttemplate.Template {
 	t := texttemplate.New(name)
+	templateFuncs[name] = texttemplate.FuncMap{}
 	for _, f := range funcs {
 		t.Funcs(f)
+		for k, v := range f {
+			templateFuncs[name][k] = v
+		}
 	}
 	t, err := t.Parse(src)
 	if err != nil {
@@ -30,3 +44,41 @@ func newTextTemplate(name, src string, funcs ...texttemplate.FuncMap) texttempla
 	}
 	return *t
 }
+
+// executable a built-in template (either an HTML or a text template)
+type executable interface {
+	Name() string
+	Execute(w io.Writer, data interface{}) error
+}
+
+// executeTemplate executes the custom template registered in the context for the node type of the given built-in template,
+// or the built-in template itself if there is no such custom template.
+// The custom template is given the same data as the built-in template, wrapped in a ContextualPipeline if needed.
+func executeTemplate(ctx *renderer.Context, tmpl executable, output io.Writer, data interface{}) error {
+	custom, err := ctx.Templates().Lookup(tmpl.Name(), templateFuncs[tmpl.Name()])
+	if err != nil {
+		return err
+	}
+	if custom == nil {
+		return tmpl.Execute(output, data)
+	}
+	log.Debugf("rendering with the custom '%s' template", tmpl.Name())
+	switch data.(type) {
+	case ContextualPipeline, *ContextualPipeline:
+		return custom.Execute(output, data)
+	default:
+		return custom.Execute(output, ContextualPipeline{
+			Context: ctx,
+			Data:    data,
+		})
+	}
+}
+
+// checkTemplates warns about the custom templates which do not override any built-in template (eg: because of a typo in their name)
+func checkTemplates(ctx *renderer.Context) {
+	for _, name := range ctx.Templates().Names() {
+		if _, found := templateFuncs[name]; !found {
+			log.Warnf("the custom '%s' template does not match any built-in template", name)
+		}
+	}
+}
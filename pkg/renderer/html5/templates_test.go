@@ -0,0 +1,66 @@
+package html5_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/parser"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer/html5"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	. "github.com/onsi/ginkgo"
+	"github.com/stretchr/testify/require"
+)
+
+var _ = Describe("custom templates", func() {
+
+	It("paragraph with custom template", func() {
+		templates := renderer.NewTemplateRegistry()
+		templates.Register("paragraph", `{{ $ctx := .Context }}{{ with .Data }}<p{{ if .ID }} id="{{ .ID }}"{{ end }} class="custom">{{ renderElements $ctx .Lines | printf "%s" }}</p>{{ end }}`)
+		actualContent := `[#foo]
+a *paragraph*
+
+another paragraph`
+		expectedResult := `<p id="foo" class="custom">a <strong>paragraph</strong></p>
+<p class="custom">another paragraph</p>`
+		verify(GinkgoT(), expectedResult, actualContent, renderer.Templates(templates))
+	})
+
+	It("section title and bold text with custom templates", func() {
+		templates := renderer.NewTemplateRegistry()
+		// these built-in templates are not given a ContextualPipeline, but the custom ones are
+		templates.Register("section-title", `<h{{ .Data.Level }} id="{{ .Data.ID }}" class="custom">{{ .Data.Content }}</h{{ .Data.Level }}>`)
+		templates.Register("bold-text", `<b>{{ .Data }}</b>`)
+		actualContent := `[[section_a]]
+== Section *A*`
+		expectedResult := `<div class="sect1">
+<h2 id="section_a" class="custom">Section <b>A</b></h2>
+<div class="sectionbody">
+</div>
+</div>`
+		verify(GinkgoT(), expectedResult, actualContent, renderer.Templates(templates))
+	})
+
+	It("custom template replaced in the registry", func() {
+		templates := renderer.NewTemplateRegistry()
+		templates.Register("italic-text", `<i>{{ .Data }}</i>`)
+		templates.Register("italic-text", `<span class="italic">{{ .Data }}</span>`)
+		actualContent := `an _italic_ word`
+		expectedResult := `<div class="paragraph">
+<p>an <span class="italic">italic</span> word</p>
+</div>`
+		verify(GinkgoT(), expectedResult, actualContent, renderer.Templates(templates))
+	})
+
+	It("fail with invalid custom template", func() {
+		templates := renderer.NewTemplateRegistry()
+		templates.Register("paragraph", `{{ unknownFunc .Data }}`)
+		doc, err := parser.ParseReader("", strings.NewReader("a paragraph"))
+		require.NoError(GinkgoT(), err)
+		ctx := renderer.Wrap(context.Background(), doc.(types.Document), renderer.Templates(templates))
+		_, err = html5.Render(ctx, bytes.NewBuffer(nil))
+		require.Error(GinkgoT(), err)
+		require.Contains(GinkgoT(), err.Error(), "unable to parse the custom 'paragraph' template")
+	})
+})
@@ -14,7 +14,7 @@ var unorderedListTmpl texttemplate.Template
 
 // initializes the templates
 func init() {
-	unorderedListTmpl = newTextTemplate("unordered list",
+	unorderedListTmpl = newTextTemplate("unordered-list",
 		`{{ $ctx := .Context }}{{ with .Data }}<div{{ if hasID .Attributes }} id="{{ getID .Attributes }}"{{ end }} class="ulist">
 <ul>
 {{ $items := .Items }}{{ range $itemIndex, $item := $items }}<li>
@@ -41,7 +41,7 @@ func renderUnorderedList(ctx *renderer.Context, l types.UnorderedList) ([]byte,
 
 	result := bytes.NewBuffer(nil)
 	// here we must preserve the HTML tags
-	err := unorderedListTmpl.Execute(result, ContextualPipeline{
+	err := executeTemplate(ctx, &unorderedListTmpl, result, ContextualPipeline{
 		Context: ctx,
 		Data:    l,
 	})
@@ -0,0 +1,36 @@
+package manpage
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+)
+
+// renderCalloutList renders the callout list as an ordered list, whose markers are the numbers of the callouts (eg: `(1)`)
+func renderCalloutList(ctx *renderer.Context, l types.CalloutList) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	items := make([]listItem, len(l.Items))
+	for i, item := range l.Items {
+		items[i] = listItem{
+			Marker:   fmt.Sprintf("(%d)", item.Ref),
+			Elements: item.Elements,
+		}
+	}
+	err := orderedListTmpl.Execute(result, ContextualPipeline{
+		Context: ctx,
+		Data: struct {
+			Title string
+			Items []listItem
+		}{
+			Title: escape(getTitle(l.Attributes)),
+			Items: items,
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render callout list")
+	}
+	return result.Bytes(), nil
+}
@@ -0,0 +1,35 @@
+package manpage
+
+import (
+	"fmt"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+)
+
+// renderCrossReference renders the text of the cross reference, since a man page has no links: its label if it has one,
+// or the title of the target if it is a section, or the ID of the target between brackets otherwise
+func renderCrossReference(ctx *renderer.Context, xref types.CrossReference) ([]byte, error) {
+	var target interface{}
+	fallback := fmt.Sprintf("[%s]", xref.ID)
+	if xref.IsInterDocument() && !ctx.IsCurrentDocument(xref) {
+		target, _ = ctx.ResolveCrossReference(xref)
+		fallback = xref.Path
+	} else if t, found := ctx.Document.ElementReferences[xref.ID]; found {
+		target = t
+	} else {
+		ctx.Diagnostics().Warnf("dangling-xref", xref.Location, "unable to resolve cross reference to '%s'", xref.ID)
+	}
+	if xref.Label != "" {
+		return []byte(escape(xref.Label)), nil
+	}
+	if t, ok := target.(types.SectionTitle); ok {
+		content, err := renderInlineElements(ctx, t.Content)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error while rendering cross reference")
+		}
+		return content, nil
+	}
+	return []byte(escape(fallback)), nil
+}
@@ -0,0 +1,238 @@
+package manpage
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+var listingBlockTmpl texttemplate.Template
+var indentedBlockTmpl texttemplate.Template
+var verseBlockTmpl texttemplate.Template
+
+// initializes the templates
+func init() {
+	listingBlockTmpl = newTextTemplate("listing block",
+		`.sp
+{{ template "title" .Title }}.if n .RS 4
+.nf
+.fam C
+{{ .Content }}
+.fam
+.fi
+.if n .RE`+blockTitleTmpl)
+	indentedBlockTmpl = newTextTemplate("indented block",
+		`.sp
+{{ template "title" .Title }}.RS 4{{ if .Content }}
+{{ .Content }}{{ end }}{{ template "attribution" . }}
+.RE`+blockTitleTmpl+attributionTmpl)
+	verseBlockTmpl = newTextTemplate("verse block",
+		`.sp
+{{ template "title" .Title }}.RS 4
+.nf
+{{ .Content }}
+.fi{{ template "attribution" . }}
+.RE`+blockTitleTmpl+attributionTmpl)
+}
+
+// attributionTmpl the author and title of the cited work in a quote or a verse block
+const attributionTmpl = `{{ define "attribution" }}{{ if or .Author .CiteTitle }}
+.sp
+\(em {{ .Author }}{{ if and .Author .CiteTitle }}, {{ end }}{{ if .CiteTitle }}\fI{{ .CiteTitle }}\fP{{ end }}{{ end }}{{ end }}`
+
+// block the data of a delimited block, for the templates
+type block struct {
+	Title     string
+	Author    string
+	CiteTitle string
+	Content   string
+}
+
+func newBlock(attributes map[string]interface{}, content string) block {
+	return block{
+		Title:   escape(getTitle(attributes)),
+		Content: content,
+	}
+}
+
+func renderDelimitedBlock(ctx *renderer.Context, b types.DelimitedBlock) ([]byte, error) {
+	log.Debugf("rendering delimited block")
+	result := bytes.NewBuffer(nil)
+	var content []byte
+	var err error
+	elements := discardTrailingBlankLines(b.Elements)
+	kind := b.Attributes[types.AttrBlockKind]
+	switch kind {
+	case types.Fenced, types.Listing, types.Source:
+		var listing string
+		if isVerbatim(elements) {
+			listing = renderVerbatimLines(elements)
+		} else {
+			listing, err = renderListingContent(ctx, elements)
+			if err != nil {
+				return nil, errors.Wrapf(err, "unable to render delimited block")
+			}
+		}
+		err = listingBlockTmpl.Execute(result, newBlock(b.Attributes, listing))
+	case types.Example, types.Sidebar:
+		content, err = renderElements(ctx, elements)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render delimited block")
+		}
+		if k, ok := b.Attributes[types.AttrAdmonitionKind].(types.AdmonitionKind); ok {
+			err = renderAdmonition(result, k, escape(getTitle(b.Attributes)), string(content))
+		} else {
+			err = indentedBlockTmpl.Execute(result, newBlock(b.Attributes, string(content)))
+		}
+	case types.Verse:
+		if len(elements) > 0 {
+			if p, ok := elements[0].(types.Paragraph); ok {
+				content, err = renderLines(ctx, p.Lines)
+				if err != nil {
+					return nil, errors.Wrapf(err, "unable to render delimited block")
+				}
+			}
+		}
+		data := newBlock(b.Attributes, string(content))
+		data.Author, _ = b.Attributes[types.AttrVerseAuthor].(string)
+		data.CiteTitle, _ = b.Attributes[types.AttrVerseTitle].(string)
+		data.Author, data.CiteTitle = escape(data.Author), escape(data.CiteTitle)
+		err = verseBlockTmpl.Execute(result, data)
+	case types.Quote:
+		content, err = renderElements(ctx, elements)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render delimited block")
+		}
+		data := newBlock(b.Attributes, string(content))
+		data.Author, _ = b.Attributes[types.AttrQuoteAuthor].(string)
+		data.CiteTitle, _ = b.Attributes[types.AttrQuoteTitle].(string)
+		data.Author, data.CiteTitle = escape(data.Author), escape(data.CiteTitle)
+		err = indentedBlockTmpl.Execute(result, data)
+	case types.Open:
+		// roff has no equivalent for open blocks, so only their content is rendered
+		content, err = renderElements(ctx, elements)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render delimited block")
+		}
+		result.Write(content)
+	case types.PassthroughBlock:
+		lines := make([]string, 0, len(elements))
+		for _, e := range elements {
+			if s, ok := e.(types.StringElement); ok {
+				if ctx.SafeMode() >= renderer.SafeModeServer {
+					// the safe mode does not allow raw content in the output
+					lines = append(lines, escapeLine(escape(s.Content)))
+				} else {
+					// the content of the passthrough blocks is not escaped
+					lines = append(lines, s.Content)
+				}
+			}
+		}
+		result.WriteString(strings.Trim(strings.Join(lines, "\n"), "\n"))
+	case types.Comment:
+	default:
+		err = errors.Errorf("no template for block of kind %v", kind)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render delimited block")
+	}
+	return result.Bytes(), nil
+}
+
+func renderLiteralBlock(ctx *renderer.Context, b types.LiteralBlock) ([]byte, error) {
+	lines := strings.Split(b.Content, "\n")
+	for i, l := range lines {
+		lines[i] = escapeLine(escape(l))
+	}
+	result := bytes.NewBuffer(nil)
+	err := listingBlockTmpl.Execute(result, block{
+		Content: strings.Join(lines, "\n"),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render literal block")
+	}
+	return result.Bytes(), nil
+}
+
+func renderListingContent(ctx *renderer.Context, elements []interface{}) (string, error) {
+	buff := bytes.NewBuffer(nil)
+	for _, e := range elements {
+		var s string
+		var err error
+		switch e := e.(type) {
+		case types.Paragraph:
+			lines := make([]string, len(e.Lines))
+			for i, l := range e.Lines {
+				if lines[i], err = renderPlainString(ctx, l); err != nil {
+					return "", errors.Wrapf(err, "unable to render listing content")
+				}
+				lines[i] = escapeLine(lines[i])
+			}
+			s = strings.Join(lines, "\n")
+		case types.BlankLine:
+			s = "\n\n"
+		default:
+			if s, err = renderPlainString(ctx, e); err != nil {
+				return "", errors.Wrapf(err, "unable to render listing content")
+			}
+		}
+		buff.WriteString(s)
+	}
+	return strings.Trim(buff.String(), "\n"), nil
+}
+
+func isVerbatim(elements []interface{}) bool {
+	if len(elements) == 0 {
+		return false
+	}
+	_, ok := elements[0].(types.VerbatimLine)
+	return ok
+}
+
+// renderVerbatimLines renders the given lines, without their leading and trailing blank lines.
+// The callouts are rendered in bold after the content of their line (eg: `(1)`).
+func renderVerbatimLines(elements []interface{}) string {
+	lines := make([]types.VerbatimLine, 0, len(elements))
+	for _, e := range elements {
+		if l, ok := e.(types.VerbatimLine); ok {
+			lines = append(lines, l)
+		}
+	}
+	for len(lines) > 0 && strings.TrimSpace(lines[0].Content) == "" && len(lines[0].Callouts) == 0 {
+		lines = lines[1:]
+	}
+	for len(lines) > 0 && strings.TrimSpace(lines[len(lines)-1].Content) == "" && len(lines[len(lines)-1].Callouts) == 0 {
+		lines = lines[:len(lines)-1]
+	}
+	buff := bytes.NewBuffer(nil)
+	for i, l := range lines {
+		if i > 0 {
+			buff.WriteString("\n")
+		}
+		buff.WriteString(escapeLine(escape(l.Content)))
+		for j, c := range l.Callouts {
+			if j > 0 {
+				buff.WriteString(" ")
+			}
+			buff.WriteString(fmt.Sprintf(`\fB(%d)\fP`, c.Ref))
+		}
+	}
+	return buff.String()
+}
+
+func discardTrailingBlankLines(elements []interface{}) []interface{} {
+	result := elements
+	for len(result) > 0 {
+		if _, ok := result[len(result)-1].(types.BlankLine); !ok {
+			break
+		}
+		result = result[:len(result)-1]
+	}
+	return result
+}
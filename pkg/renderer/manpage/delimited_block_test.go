@@ -0,0 +1,104 @@
+package manpage_test
+
+import (
+	. "github.com/onsi/ginkgo"
+)
+
+var _ = Describe("delimited blocks", func() {
+
+	It("listing block", func() {
+		actualContent := `.Example
+----
+$ git foo --bar
+.hidden
+----`
+		expectedResult := `.sp
+\fBExample\fP
+.br
+.if n .RS 4
+.nf
+.fam C
+$ git foo \-\-bar
+\&.hidden
+.fam
+.fi
+.if n .RE`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+
+	It("literal block", func() {
+		actualContent := `  some literal content`
+		expectedResult := `.sp
+.if n .RS 4
+.nf
+.fam C
+  some literal content
+.fam
+.fi
+.if n .RE`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+
+	It("example block", func() {
+		actualContent := `====
+an example
+====`
+		expectedResult := `.sp
+.RS 4
+.sp
+an example
+.RE`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+
+	It("admonition block", func() {
+		actualContent := `[WARNING]
+.Careful
+====
+a warning
+====`
+		expectedResult := `.sp
+.RS 4
+\fBWarning\fP
+.br
+\fBCareful\fP
+.br
+.sp
+a warning
+.RE`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+
+	It("quote block", func() {
+		actualContent := `[quote, John Doe, A Book]
+____
+a quote
+____`
+		expectedResult := `.sp
+.RS 4
+.sp
+a quote
+.sp
+\(em John Doe, \fIA Book\fP
+.RE`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+
+	It("verse block", func() {
+		actualContent := `[verse, John Doe]
+____
+a verse
+on two lines
+____`
+		expectedResult := `.sp
+.RS 4
+.nf
+a verse
+on two lines
+.fi
+.sp
+\(em John Doe
+.RE`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+})
@@ -0,0 +1,174 @@
+package manpage
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+var documentTmpl texttemplate.Template
+
+const (
+	// AttrManManual the attribute which sets the name of the manual that the man page belongs to (eg: `Git Manual`)
+	AttrManManual string = "manmanual"
+	// AttrManSource the attribute which sets the source of the man page, i.e., the name and version of the software (eg: `Git 1.0`)
+	AttrManSource string = "mansource"
+)
+
+func init() {
+	documentTmpl = newTextTemplate("root document",
+		`'\" t
+.\"     Title: {{ .Title }}{{ if .Authors }}
+.\"    Author: {{ join .Authors ", " }}{{ end }}
+.\" Generator: libasciidoc{{ if .Date }}
+.\"      Date: {{ .Date }}{{ end }}{{ if .Manual }}
+.\"    Manual: {{ .Manual }}{{ end }}{{ if .Source }}
+.\"    Source: {{ .Source }}{{ end }}
+.\"  Language: English
+.\"
+.TH "{{ quote (upper .Title) }}" "{{ quote .VolNum }}" "{{ quote .Date }}" "{{ quote .Source }}" "{{ quote .Manual }}"
+.nh
+.ad l{{ if .Content }}
+{{ .Content }}{{ end }}{{ if .Footnotes }}
+.SH "NOTES"{{ range .Footnotes }}
+.IP "[{{ .ID }}]" 4
+{{ .Content }}{{ end }}{{ end }}{{ if .Authors }}
+.SH "AUTHOR{{ if gt (len .Authors) 1 }}S{{ end }}"{{ range .Authors }}
+.sp
+{{ escape . }}{{ end }}{{ end }}
+`,
+		texttemplate.FuncMap{
+			"join":   strings.Join,
+			"upper":  strings.ToUpper,
+			"quote":  quote,
+			"escape": escape,
+		})
+}
+
+type footnote struct {
+	ID      int
+	Content string
+}
+
+func renderDocument(ctx *renderer.Context, output io.Writer) (map[string]interface{}, error) {
+	metadata := make(map[string]interface{})
+	renderedTitle, err := renderDocumentTitle(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render full document")
+	}
+	renderedElements, err := renderElements(ctx, ctx.Document.Elements)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render full document")
+	}
+	if ctx.IncludeHeaderFooter() {
+		log.Debugf("Rendering full document...")
+		footnotes, err := renderFootnotes(ctx)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render full document")
+		}
+		err = documentTmpl.Execute(output, struct {
+			Title     string
+			VolNum    string
+			Date      string
+			Manual    string
+			Source    string
+			Authors   []string
+			Content   string
+			Footnotes []footnote
+		}{
+			Title:     manTitle(ctx, renderedTitle),
+			VolNum:    manVolNum(ctx),
+			Date:      getAttribute(ctx, "revdate"),
+			Manual:    getAttribute(ctx, AttrManManual),
+			Source:    getAttribute(ctx, AttrManSource),
+			Authors:   documentAuthors(ctx),
+			Content:   string(renderedElements),
+			Footnotes: footnotes,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render full document")
+		}
+	} else {
+		output.Write(renderedElements)
+	}
+	// copy all document attributes, and override the title with its rendered value instead of the `types.Section` struct
+	for k, v := range ctx.Document.Attributes {
+		switch k {
+		case "doctitle":
+			metadata[k] = renderedTitle
+		default:
+			metadata[k] = v
+		}
+	}
+	return metadata, nil
+}
+
+// manTitle returns the name of the man page, which is the `mantitle` attribute in a document with the `manpage` doctype,
+// or the title of the document otherwise
+func manTitle(ctx *renderer.Context, title string) string {
+	if t, ok := ctx.Document.Attributes[types.AttrManTitle].(string); ok {
+		return t
+	}
+	return title
+}
+
+// manVolNum returns the volume of the man page, which is the `manvolnum` attribute in a document with the `manpage` doctype,
+// or `1` (the volume of the user commands) otherwise
+func manVolNum(ctx *renderer.Context) string {
+	if v, ok := ctx.Document.Attributes[types.AttrManVolNum].(string); ok {
+		return v
+	}
+	return "1"
+}
+
+// getAttribute returns the value of the document attribute with the given name, or an empty string
+func getAttribute(ctx *renderer.Context, name string) string {
+	value, _ := ctx.Document.Attributes[name].(string)
+	return value
+}
+
+// documentAuthors returns the full names of the authors declared in the document header
+func documentAuthors(ctx *renderer.Context) []string {
+	result := []string{}
+	for i := 1; ; i++ {
+		key := "author"
+		if i > 1 {
+			key = fmt.Sprintf("author_%d", i)
+		}
+		author := getAttribute(ctx, key)
+		if author == "" {
+			return result
+		}
+		result = append(result, author)
+	}
+}
+
+// renderDocumentTitle renders the document title, without any markup
+func renderDocumentTitle(ctx *renderer.Context) (string, error) {
+	documentTitle, err := ctx.Document.Attributes.GetTitle()
+	if err != nil {
+		return "", errors.Wrapf(err, "unable to render document title")
+	}
+	title := strings.Builder{}
+	err = types.Walk(types.VisitorFunc(func(element types.Visitable) error {
+		if s, ok := element.(types.StringElement); ok {
+			title.WriteString(s.Content)
+		}
+		return nil
+	}), documentTitle.Content)
+	if err != nil {
+		return "", errors.Wrapf(err, "unable to render document title")
+	}
+	return title.String(), nil
+}
+
+// quote escapes the given text as an argument of a roff request, which is enclosed in double quotes
+func quote(s string) string {
+	return strings.Replace(escape(s), `"`, `\(dq`, -1)
+}
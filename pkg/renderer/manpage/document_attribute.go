@@ -0,0 +1,26 @@
+package manpage
+
+import (
+	"fmt"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+)
+
+func processAttributeDeclaration(ctx *renderer.Context, attr types.DocumentAttributeDeclaration) error {
+	ctx.Document.Attributes.AddAttribute(attr)
+	return nil
+}
+
+func processAttributeReset(ctx *renderer.Context, attr types.DocumentAttributeReset) error {
+	ctx.Document.Attributes.Reset(attr)
+	return nil
+}
+
+func renderAttributeSubstitution(ctx *renderer.Context, attr types.DocumentAttributeSubstitution) ([]byte, error) {
+	if value, found := ctx.Document.Attributes[attr.Name]; found {
+		return []byte(escape(fmt.Sprintf("%v", value))), nil
+	}
+	ctx.Diagnostics().Warnf("unknown-attribute", attr.Location, "unknown attribute: '%s'", attr.Name)
+	return []byte(escape(fmt.Sprintf("{%s}", attr.Name))), nil
+}
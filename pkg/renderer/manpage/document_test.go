@@ -0,0 +1,121 @@
+package manpage_test
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	. "github.com/onsi/ginkgo"
+)
+
+var _ = Describe("documents", func() {
+
+	It("man page with header", func() {
+		actualContent := `= git-foo(1)
+Kismet R. Lee <kismet@asciidoctor.org>
+v1.0, 2019-01-01: First draft
+:doctype: manpage
+:manmanual: Git Manual
+:mansource: Git 1.0
+
+== NAME
+
+git-foo - does foo
+
+== DESCRIPTION
+
+a paragraph`
+		expectedResult := `'\" t
+.\"     Title: git-foo
+.\"    Author: Kismet R. Lee
+.\" Generator: libasciidoc
+.\"      Date: 2019-01-01
+.\"    Manual: Git Manual
+.\"    Source: Git 1.0
+.\"  Language: English
+.\"
+.TH "GIT\-FOO" "1" "2019\-01\-01" "Git 1.0" "Git Manual"
+.nh
+.ad l
+.SH "NAME"
+git\-foo \- does foo
+.SH "DESCRIPTION"
+.sp
+a paragraph
+.SH "AUTHOR"
+.sp
+Kismet R. Lee
+`
+		verify(GinkgoT(), expectedResult, actualContent, renderer.IncludeHeaderFooter(true))
+	})
+
+	It("man page with multiple authors and footnotes", func() {
+		actualContent := `= git-foo(1)
+Kismet Lee <kismet@asciidoctor.org>; Anne Smith <anne@asciidoctor.org>
+:doctype: manpage
+
+== NAME
+
+git-foo, git-bar - do foo and bar
+
+== DESCRIPTION
+
+a paragraph with a footnote:[the footnote] and another footnote:[the other footnote]`
+		expectedResult := `'\" t
+.\"     Title: git-foo
+.\"    Author: Kismet Lee, Anne Smith
+.\" Generator: libasciidoc
+.\"  Language: English
+.\"
+.TH "GIT\-FOO" "1" "" "" ""
+.nh
+.ad l
+.SH "NAME"
+git\-foo, git\-bar \- do foo and bar
+.SH "DESCRIPTION"
+.sp
+a paragraph with a [1] and another [2]
+.SH "NOTES"
+.IP "[1]" 4
+the footnote
+.IP "[2]" 4
+the other footnote
+.SH "AUTHORS"
+.sp
+Kismet Lee
+.sp
+Anne Smith
+`
+		verify(GinkgoT(), expectedResult, actualContent, renderer.IncludeHeaderFooter(true))
+	})
+
+	It("article rendered as a man page", func() {
+		actualContent := `= The Title
+
+== Section A
+
+a paragraph`
+		expectedResult := `'\" t
+.\"     Title: The Title
+.\" Generator: libasciidoc
+.\"  Language: English
+.\"
+.TH "THE TITLE" "1" "" "" ""
+.nh
+.ad l
+.SH "SECTION A"
+.sp
+a paragraph
+`
+		verify(GinkgoT(), expectedResult, actualContent, renderer.IncludeHeaderFooter(true))
+	})
+
+	It("embedded man page", func() {
+		actualContent := `= git-foo(1)
+:doctype: manpage
+
+== NAME
+
+git-foo - does foo`
+		expectedResult := `.SH "NAME"
+git\-foo \- does foo`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+})
@@ -0,0 +1,16 @@
+package manpage
+
+import (
+	"fmt"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+)
+
+// renderLink renders the text of the link followed by its URL between angle brackets, or the URL alone if the link has no text
+func renderLink(ctx *renderer.Context, l types.Link) ([]byte, error) {
+	if text := l.Text(); text != "" {
+		return []byte(fmt.Sprintf(`%s \(la%s\(ra`, escape(text), escape(l.URL))), nil
+	}
+	return []byte(escape(l.URL)), nil
+}
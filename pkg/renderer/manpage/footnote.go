@@ -0,0 +1,38 @@
+package manpage
+
+import (
+	"fmt"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+)
+
+// renderFootnote renders the number of the given footnote between brackets (eg: `[1]`), whereas its content
+// is rendered in the `NOTES` section at the end of the man page
+func renderFootnote(ctx *renderer.Context, f types.Footnote) ([]byte, error) {
+	if f.Ref != "" {
+		if _, found := ctx.Document.FootnoteReferences[f.Ref]; !found {
+			ctx.Diagnostics().Warnf("unresolved-footnote-ref", f.Location, "unable to resolve footnote reference '%s'", f.Ref)
+			return []byte(fmt.Sprintf("[%s]", escape(f.Ref))), nil
+		} else if f.ID == 0 {
+			// the reference is used before the definition of the footnote (which was already reported)
+			return []byte(fmt.Sprintf("[%s]", escape(f.Ref))), nil
+		}
+	}
+	return []byte(fmt.Sprintf("[%d]", f.ID)), nil
+}
+
+// renderFootnotes renders the content of all the footnotes of the document, if any
+func renderFootnotes(ctx *renderer.Context) ([]footnote, error) {
+	footnotes := make([]footnote, len(ctx.Document.Footnotes))
+	for i, f := range ctx.Document.Footnotes {
+		content, err := renderLines(ctx, []types.InlineElements{f.Elements})
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render footnotes")
+		}
+		footnotes[i].ID = f.ID
+		footnotes[i].Content = string(content)
+	}
+	return footnotes, nil
+}
@@ -0,0 +1,46 @@
+package manpage
+
+import (
+	"bytes"
+	"fmt"
+	texttemplate "text/template"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+)
+
+var blockImageTmpl texttemplate.Template
+
+// initializes the templates
+func init() {
+	blockImageTmpl = newTextTemplate("block image",
+		`.sp
+{{ template "title" .Title }}[{{ .Alt }}]`+blockTitleTmpl)
+}
+
+// renderBlockImage renders the alternate text of the image, since images can't be displayed in a man page
+func renderBlockImage(ctx *renderer.Context, img types.BlockImage) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	// the title of the block takes precedence over the `title` attribute of the macro
+	title := getTitle(img.Attributes)
+	if title == "" {
+		title = img.Macro.Title()
+	}
+	err := blockImageTmpl.Execute(result, struct {
+		Title string
+		Alt   string
+	}{
+		Title: escape(title),
+		Alt:   escape(img.Macro.Alt()),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render block image")
+	}
+	return result.Bytes(), nil
+}
+
+// renderInlineImage renders the alternate text of the image, since images can't be displayed in a man page
+func renderInlineImage(ctx *renderer.Context, img types.InlineImage) ([]byte, error) {
+	return []byte(fmt.Sprintf("[%s]", escape(img.Macro.Alt()))), nil
+}
@@ -0,0 +1,43 @@
+package manpage_test
+
+import (
+	. "github.com/onsi/ginkgo"
+)
+
+var _ = Describe("inline elements", func() {
+
+	It("quoted text", func() {
+		actualContent := "*bold*, _italic_ and `monospace`"
+		expectedResult := `.sp
+\fBbold\fP, \fIitalic\fP and \f(CRmonospace\fP`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+
+	It("links", func() {
+		actualContent := `see https://example.com[the site] or https://example.com`
+		expectedResult := `.sp
+see the site \(lahttps://example.com\(ra or https://example.com`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+
+	It("cross references", func() {
+		actualContent := `== Options
+
+see <<_options>>, <<_options,the options>>, <<unknown>> and <<other.adoc#,the other page>>`
+		expectedResult := `.SH "OPTIONS"
+.sp
+see Options, the options, [unknown] and the other page`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+
+	It("images and passthroughs", func() {
+		actualContent := `image:foo.png[a foo] and +++\fBraw\fP+++
+
+image::bar.png[a bar]`
+		expectedResult := `.sp
+[a foo] and \fBraw\fP
+.sp
+[a bar]`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+})
@@ -0,0 +1,69 @@
+package manpage
+
+import (
+	"bytes"
+	texttemplate "text/template"
+
+	"github.com/bytesparadise/libasciidoc/pkg/parser"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+var labeledListTmpl texttemplate.Template
+
+// initializes the templates
+func init() {
+	labeledListTmpl = newTextTemplate("labeled list",
+		`{{ $ctx := .Context }}{{ with .Data }}{{ if .Title }}.sp
+{{ template "title" .Title }}{{ end }}{{ range $index, $item := .Items }}{{ if $index }}
+{{ end }}.sp
+{{ renderTerm $ctx .Term }}
+.RS 4{{ with renderListItem $ctx .Elements }}
+{{ . }}{{ end }}
+.RE{{ end }}{{ end }}`+blockTitleTmpl,
+		texttemplate.FuncMap{
+			"renderListItem": renderListItem,
+			"renderTerm":     renderLabeledListTerm,
+		})
+}
+
+func renderLabeledList(ctx *renderer.Context, l types.LabeledList) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	err := labeledListTmpl.Execute(result, ContextualPipeline{
+		Context: ctx,
+		Data: struct {
+			Title string
+			Items []types.LabeledListItem
+		}{
+			Title: escape(getTitle(l.Attributes)),
+			Items: l.Items,
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render labeled list")
+	}
+	return result.Bytes(), nil
+}
+
+// renderLabeledListTerm renders the given term with its inline formatting (eg: `*-o*=_FILE_`), or in bold
+// if it has no formatting at all (since the roff fonts can't be nested)
+func renderLabeledListTerm(ctx *renderer.Context, term string) (string, error) {
+	result, err := parser.Parse("", []byte(term), parser.Entrypoint("InlineElements"))
+	if err != nil {
+		log.Debugf("unable to parse the labeled list term '%s': %v", term, err)
+		return `\fB` + escape(term) + `\fP`, nil
+	}
+	elements, _ := result.(types.InlineElements)
+	content, err := renderInlineElements(ctx, elements)
+	if err != nil {
+		return "", errors.Wrapf(err, "unable to render labeled list term")
+	}
+	for _, e := range elements {
+		if _, ok := e.(types.QuotedText); ok {
+			return string(content), nil
+		}
+	}
+	return `\fB` + string(content) + `\fP`, nil
+}
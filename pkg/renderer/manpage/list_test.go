@@ -0,0 +1,97 @@
+package manpage_test
+
+import (
+	. "github.com/onsi/ginkgo"
+)
+
+var _ = Describe("lists", func() {
+
+	It("unordered list with nested list", func() {
+		actualContent := `.a title
+* item 1
+** item 1.1
+** item 1.2
+* item 2`
+		expectedResult := `.sp
+\fBa title\fP
+.br
+.RS 4
+.IP \(bu 2
+item 1
+.sp
+.RS 4
+.IP \(bu 2
+item 1.1
+.IP \(bu 2
+item 1.2
+.RE
+.IP \(bu 2
+item 2
+.RE`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+
+	It("ordered list", func() {
+		actualContent := `a. item a
+b. item b`
+		expectedResult := `.sp
+.RS 4
+.IP "a." 4
+item a
+.IP "b." 4
+item b
+.RE`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+
+	It("labeled list", func() {
+		actualContent := `-v, --verbose:: print more details
+--help:: print the usage
++
+with an additional paragraph`
+		expectedResult := `.sp
+\fB\-v, \-\-verbose\fP
+.RS 4
+print more details
+.RE
+.sp
+\fB\-\-help\fP
+.RS 4
+print the usage
+.sp
+with an additional paragraph
+.RE`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+
+	It("labeled list with formatted terms", func() {
+		actualContent := `*-o, --out-file*=_OUT_FILE_:: write the output to _OUT_FILE_`
+		expectedResult := `.sp
+\fB\-o, \-\-out\-file\fP=\fIOUT_FILE\fP
+.RS 4
+write the output to \fIOUT_FILE\fP
+.RE`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+
+	It("listing block with callouts", func() {
+		actualContent := "----\n" +
+			"import \"fmt\" <1>\n" +
+			"----\n" +
+			"<1> the import"
+		expectedResult := `.sp
+.if n .RS 4
+.nf
+.fam C
+import "fmt" \fB(1)\fP
+.fam
+.fi
+.if n .RE
+.sp
+.RS 4
+.IP "(1)" 4
+the import
+.RE`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+})
@@ -0,0 +1,15 @@
+package manpage_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+
+	_ "github.com/bytesparadise/libasciidoc/pkg/log"
+)
+
+func TestManpage(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Manpage Suite")
+}
@@ -0,0 +1,74 @@
+package manpage
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+)
+
+var orderedListTmpl texttemplate.Template
+
+// initializes the templates
+func init() {
+	orderedListTmpl = newTextTemplate("ordered list",
+		`{{ $ctx := .Context }}{{ with .Data }}.sp
+{{ template "title" .Title }}.RS 4{{ range .Items }}
+.IP "{{ .Marker }}" 4
+{{ renderListItem $ctx .Elements }}{{ end }}
+.RE{{ end }}`+blockTitleTmpl,
+		texttemplate.FuncMap{
+			"renderListItem": renderListItem,
+		})
+}
+
+// listItem an item of an ordered list or a callout list, with its marker (eg: `1.` or `a.`)
+type listItem struct {
+	Marker   string
+	Elements []interface{}
+}
+
+func renderOrderedList(ctx *renderer.Context, l types.OrderedList) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	items := make([]listItem, len(l.Items))
+	for i, item := range l.Items {
+		items[i] = listItem{
+			Marker:   marker(item.NumberingStyle, i+1),
+			Elements: item.Elements,
+		}
+	}
+	err := orderedListTmpl.Execute(result, ContextualPipeline{
+		Context: ctx,
+		Data: struct {
+			Title string
+			Items []listItem
+		}{
+			Title: escape(getTitle(l.Attributes)),
+			Items: items,
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render ordered list")
+	}
+	return result.Bytes(), nil
+}
+
+// marker returns the marker of the item at the given position (starting at 1) in a list with the given numbering style
+func marker(style types.NumberingStyle, position int) string {
+	switch style {
+	case types.LowerAlpha:
+		return fmt.Sprintf("%c.", 'a'+rune(position-1)%26)
+	case types.UpperAlpha:
+		return fmt.Sprintf("%c.", 'A'+rune(position-1)%26)
+	case types.LowerRoman:
+		return strings.ToLower(renderer.RomanNumeral(position)) + "."
+	case types.UpperRoman:
+		return renderer.RomanNumeral(position) + "."
+	default:
+		return fmt.Sprintf("%d.", position)
+	}
+}
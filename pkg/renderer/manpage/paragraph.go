@@ -0,0 +1,76 @@
+package manpage
+
+import (
+	"bytes"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+)
+
+var paragraphTmpl texttemplate.Template
+var admonitionTmpl texttemplate.Template
+
+// initializes the templates
+func init() {
+	paragraphTmpl = newTextTemplate("paragraph",
+		`.sp
+{{ template "title" .Title }}{{ .Content }}`+blockTitleTmpl)
+	admonitionTmpl = newTextTemplate("admonition",
+		`.sp
+.RS 4
+\fB{{ .Label }}\fP
+.br
+{{ template "title" .Title }}{{ .Content }}
+.RE`+blockTitleTmpl)
+}
+
+func renderParagraph(ctx *renderer.Context, p types.Paragraph) ([]byte, error) {
+	if len(p.Lines) == 0 {
+		return nil, nil
+	}
+	content, err := renderLines(ctx, p.Lines)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render paragraph")
+	}
+	if len(content) == 0 {
+		// eg: a paragraph with comment lines only
+		return nil, nil
+	}
+	result := bytes.NewBuffer(nil)
+	title := escape(getTitle(p.Attributes))
+	if k, ok := p.Attributes[types.AttrAdmonitionKind].(types.AdmonitionKind); ok {
+		err = renderAdmonition(result, k, title, string(content))
+	} else {
+		err = paragraphTmpl.Execute(result, struct {
+			Title   string
+			Content string
+		}{
+			Title:   title,
+			Content: string(content),
+		})
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render paragraph")
+	}
+	return result.Bytes(), nil
+}
+
+// renderAdmonition renders an admonition of the given kind with the given (already rendered) content,
+// which is indented below the label of the admonition (eg: `Note`)
+func renderAdmonition(result *bytes.Buffer, kind types.AdmonitionKind, title, content string) error {
+	if kind == types.Unknown {
+		return errors.New("failed to render admonition with unknown kind")
+	}
+	return admonitionTmpl.Execute(result, struct {
+		Label   string
+		Title   string
+		Content string
+	}{
+		Label:   strings.Title(string(kind)),
+		Title:   title,
+		Content: content,
+	})
+}
@@ -0,0 +1,56 @@
+package manpage_test
+
+import (
+	. "github.com/onsi/ginkgo"
+)
+
+var _ = Describe("paragraphs", func() {
+
+	It("paragraph with escaped characters", func() {
+		actualContent := `use the --verbose option
+  with a C:\path\to\file
+.and a line starting with a dot
+'and a line starting with a quote`
+		expectedResult := `.sp
+use the \-\-verbose option
+with a C:\(rspath\(rsto\(rsfile
+\&.and a line starting with a dot
+\&'and a line starting with a quote`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+
+	It("paragraph with title", func() {
+		actualContent := `.a title
+a paragraph`
+		expectedResult := `.sp
+\fBa title\fP
+.br
+a paragraph`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+
+	It("admonition paragraph", func() {
+		actualContent := `NOTE: this is a note`
+		expectedResult := `.sp
+.RS 4
+\fBNote\fP
+.br
+this is a note
+.RE`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+
+	It("paragraph with comment lines only", func() {
+		actualContent := `a paragraph
+
+// a comment
+// another comment
+
+another paragraph`
+		expectedResult := `.sp
+a paragraph
+.sp
+another paragraph`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+})
@@ -0,0 +1,26 @@
+package manpage
+
+import (
+	"fmt"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+)
+
+func renderQuotedText(ctx *renderer.Context, t types.QuotedText) ([]byte, error) {
+	content, err := renderInlineElements(ctx, t.Elements)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render quoted text")
+	}
+	switch t.Kind {
+	case types.Bold:
+		return []byte(fmt.Sprintf(`\fB%s\fP`, content)), nil
+	case types.Italic:
+		return []byte(fmt.Sprintf(`\fI%s\fP`, content)), nil
+	case types.Monospace:
+		return []byte(fmt.Sprintf(`\f(CR%s\fP`, content)), nil
+	default:
+		return nil, errors.Errorf("unsupported quoted text kind: %v", t.Kind)
+	}
+}
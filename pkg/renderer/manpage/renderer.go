@@ -0,0 +1,202 @@
+package manpage
+
+import (
+	"bytes"
+	"io"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// Render renders the document in the given context as a man page in the roff format (see man(7)), and writes the result in the given output.
+// Returns the document metadata (title, etc.) or an error if a problem occurred
+func Render(ctx *renderer.Context, output io.Writer) (map[string]interface{}, error) {
+	return renderDocument(ctx, output)
+}
+
+func renderElement(ctx *renderer.Context, element interface{}) (result []byte, err error) {
+	log.Debugf("rendering element of type `%T`", element)
+	defer func() {
+		// report the location of the innermost element which could not be rendered
+		err = types.WrapWithLocation(err, element)
+	}()
+	switch e := element.(type) {
+	case types.TableOfContentsMacro:
+		return nil, nil // man pages have no table of contents
+	case types.Section:
+		return renderSection(ctx, e)
+	case types.Preamble:
+		return renderElements(ctx, e.Elements)
+	case types.BlankLine:
+		return nil, nil
+	case types.LabeledList:
+		return renderLabeledList(ctx, e)
+	case types.OrderedList:
+		return renderOrderedList(ctx, e)
+	case types.UnorderedList:
+		return renderUnorderedList(ctx, e)
+	case types.CalloutList:
+		return renderCalloutList(ctx, e)
+	case types.Paragraph:
+		return renderParagraph(ctx, e)
+	case types.CrossReference:
+		return renderCrossReference(ctx, e)
+	case types.InlineAnchor:
+		return nil, nil // man pages have no anchors
+	case types.Footnote:
+		return renderFootnote(ctx, e)
+	case types.QuotedText:
+		return renderQuotedText(ctx, e)
+	case types.Passthrough:
+		return renderPassthrough(ctx, e)
+	case types.BlockImage:
+		return renderBlockImage(ctx, e)
+	case types.InlineImage:
+		return renderInlineImage(ctx, e)
+	case types.DelimitedBlock:
+		return renderDelimitedBlock(ctx, e)
+	case types.LiteralBlock:
+		return renderLiteralBlock(ctx, e)
+	case types.Table:
+		return renderTable(ctx, e)
+	case types.InlineElements:
+		return renderInlineElements(ctx, e)
+	case types.Link:
+		return renderLink(ctx, e)
+	case types.StringElement:
+		return []byte(escape(e.Content)), nil
+	case types.DocumentAttributeDeclaration:
+		// 'process' function do not return any rendered content, but may return an error
+		return nil, processAttributeDeclaration(ctx, e)
+	case types.DocumentAttributeReset:
+		// 'process' function do not return any rendered content, but may return an error
+		return nil, processAttributeReset(ctx, e)
+	case types.DocumentAttributeSubstitution:
+		return renderAttributeSubstitution(ctx, e)
+	case types.SingleLineComment:
+		return nil, nil // nothing to do
+	default:
+		return nil, errors.Errorf("unsupported type of element: %T", element)
+	}
+}
+
+// renderElements renders the given elements, with a `\n` character in-between
+func renderElements(ctx *renderer.Context, elements []interface{}) ([]byte, error) {
+	buff := bytes.NewBuffer(nil)
+	hasContent := false
+	for _, element := range elements {
+		content, err := renderElement(ctx, element)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to render the elements")
+		}
+		if len(content) == 0 {
+			continue
+		}
+		if hasContent {
+			buff.WriteString("\n")
+		}
+		buff.Write(content)
+		hasContent = true
+	}
+	return buff.Bytes(), nil
+}
+
+func renderInlineElements(ctx *renderer.Context, elements types.InlineElements) ([]byte, error) {
+	buff := bytes.NewBuffer(nil)
+	for _, element := range elements {
+		renderedElement, err := renderElement(ctx, element)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render inline elements")
+		}
+		buff.Write(renderedElement)
+	}
+	return buff.Bytes(), nil
+}
+
+// renderLines renders the given lines, with a `\n` character in-between.
+// The leading spaces of the lines are removed, since they would cause a break in the output.
+func renderLines(ctx *renderer.Context, lines []types.InlineElements) ([]byte, error) {
+	buff := bytes.NewBuffer(nil)
+	for i, l := range lines {
+		renderedLine, err := renderInlineElements(ctx, l)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to render lines")
+		}
+		if i > 0 {
+			buff.WriteString("\n")
+		}
+		buff.WriteString(escapeLine(strings.TrimLeft(string(renderedLine), " \t")))
+	}
+	return bytes.TrimSpace(buff.Bytes()), nil
+}
+
+// renderPlainString renders the given element without any markup, but with the roff special characters escaped
+func renderPlainString(ctx *renderer.Context, element interface{}) (string, error) {
+	switch element := element.(type) {
+	case types.SectionTitle:
+		return renderPlainString(ctx, element.Content)
+	case types.InlineElements:
+		buff := bytes.NewBuffer(nil)
+		for _, e := range element {
+			s, err := renderPlainString(ctx, e)
+			if err != nil {
+				return "", err
+			}
+			buff.WriteString(s)
+		}
+		return buff.String(), nil
+	case types.QuotedText:
+		return renderPlainString(ctx, types.InlineElements(element.Elements))
+	case types.Passthrough:
+		return renderPlainString(ctx, types.InlineElements(element.Elements))
+	case types.InlineImage:
+		return escape(element.Macro.Alt()), nil
+	case types.Link:
+		if text := element.Text(); text != "" {
+			return escape(text), nil
+		}
+		return escape(element.URL), nil
+	case types.StringElement:
+		return escape(element.Content), nil
+	case types.DocumentAttributeSubstitution:
+		s, err := renderAttributeSubstitution(ctx, element)
+		return string(s), err
+	case types.Footnote, types.CrossReference, types.InlineAnchor:
+		return "", nil
+	default:
+		return "", errors.Errorf("unexpected type of element to render as a plain string: %T", element)
+	}
+}
+
+// escaper escapes the roff special characters in text content: the backslashes, and the hyphens which would
+// otherwise be rendered as typographic hyphens (eg: in the command options)
+var escaper = strings.NewReplacer(`\`, `\(rs`, "-", `\-`)
+
+// escape escapes the roff special characters in the given text content
+func escape(s string) string {
+	return escaper.Replace(s)
+}
+
+// escapeLine escapes the given line of text if it starts with a `.` or a `'`, which would otherwise be interpreted
+// as a roff request
+func escapeLine(line string) string {
+	if strings.HasPrefix(line, ".") || strings.HasPrefix(line, "'") {
+		return `\&` + line
+	}
+	return line
+}
+
+// getTitle returns the value for the entry with key `types.AttrTitle` in the given map
+func getTitle(attributes map[string]interface{}) string {
+	title, _ := attributes[types.AttrTitle].(string)
+	return title
+}
+
+// renderContent renders the given elements as a string, for use in the templates
+func renderContent(ctx *renderer.Context, elements []interface{}) (string, error) {
+	result, err := renderElements(ctx, elements)
+	return string(result), err
+}
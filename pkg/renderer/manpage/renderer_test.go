@@ -0,0 +1,36 @@
+package manpage_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/parser"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer/manpage"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/davecgh/go-spew/spew"
+	. "github.com/onsi/ginkgo"
+	"github.com/sergi/go-diff/diffmatchpatch"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func verify(t GinkgoTInterface, expectedResult, content string, rendererOpts ...renderer.Option) {
+	t.Logf("processing '%s'", content)
+	reader := strings.NewReader(content)
+	doc, err := parser.ParseReader("", reader)
+	require.NoError(t, err, "Error found while parsing the document")
+	t.Logf("actual document: `%s`", spew.Sdump(doc))
+	buff := bytes.NewBuffer(nil)
+	rendererCtx := renderer.Wrap(context.Background(), doc.(types.Document), rendererOpts...)
+	_, err = manpage.Render(rendererCtx, buff)
+	require.NoError(t, err)
+	result := buff.String()
+	expectedResult = strings.Replace(expectedResult, "\t", "", -1) // remove tabs that can be inserted by VSCode while formatting the tests code
+	t.Logf("** Actual output:\n`%s`\n", result)
+	t.Logf("** expectedResult output:\n`%s`\n", expectedResult)
+	dmp := diffmatchpatch.New()
+	diffs := dmp.DiffMain(result, expectedResult, true)
+	assert.Equal(t, expectedResult, result, dmp.DiffPrettyText(diffs))
+}
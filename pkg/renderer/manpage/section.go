@@ -0,0 +1,111 @@
+package manpage
+
+import (
+	"bytes"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+var sectionTmpl texttemplate.Template
+var nameSectionTmpl texttemplate.Template
+
+// initializes the templates
+func init() {
+	sectionTmpl = newTextTemplate("section",
+		`{{ if gt .Level 1 }}.SS{{ else }}.SH{{ end }} "{{ .Title }}"{{ if .Elements }}
+{{ .Elements }}{{ end }}`)
+	nameSectionTmpl = newTextTemplate("name section",
+		`.SH "{{ .Title }}"
+{{ escape .Name }} \- {{ escape .Purpose }}{{ if .Elements }}
+{{ .Elements }}{{ end }}`,
+		texttemplate.FuncMap{
+			"escape": escape,
+		})
+}
+
+// renderSection renders the section with a `.SH` request (or a `.SS` request for the subsections), in which
+// the title of the level 1 sections is in uppercase, as customary in the man pages
+func renderSection(ctx *renderer.Context, s types.Section) ([]byte, error) {
+	log.Debugf("Rendering section level %d", s.Level)
+	content := s.Title.Content
+	if s.Level <= 1 {
+		c, err := types.Rewrite(types.RewriterFunc(toUpper), s.Title.Content)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error while rendering section")
+		}
+		content = c.(types.InlineElements)
+	}
+	renderedTitle, err := renderInlineElements(ctx, content)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error while rendering section")
+	}
+	tmpl := sectionTmpl
+	elements := s.Elements
+	name, purpose := getAttribute(ctx, types.AttrManName), getAttribute(ctx, types.AttrManPurpose)
+	if isNameSection(ctx, s) && name != "" {
+		// the paragraph with the name and purpose of the command is replaced by the values which were extracted from it
+		tmpl = nameSectionTmpl
+		elements = withoutFirstParagraph(elements)
+	}
+	renderedElements, err := renderElements(ctx, elements)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error while rendering section")
+	}
+	result := bytes.NewBuffer(nil)
+	err = tmpl.Execute(result, struct {
+		Level    int
+		Title    string
+		Name     string
+		Purpose  string
+		Elements string
+	}{
+		Level:    s.Level,
+		Title:    strings.Replace(strings.TrimSpace(string(renderedTitle)), `"`, `\(dq`, -1),
+		Name:     name,
+		Purpose:  purpose,
+		Elements: string(renderedElements),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "error while rendering section")
+	}
+	return result.Bytes(), nil
+}
+
+// toUpper converts the text of the given element to uppercase
+func toUpper(element interface{}) (interface{}, error) {
+	if s, ok := element.(types.StringElement); ok {
+		s.Content = strings.ToUpper(s.Content)
+		return s, nil
+	}
+	return element, nil
+}
+
+// isNameSection returns true if the given section is the `NAME` section of a man page, i.e., its first section
+func isNameSection(ctx *renderer.Context, s types.Section) bool {
+	if ctx.Document.Attributes.GetDocType() != types.DocTypeManpage {
+		return false
+	}
+	for _, element := range ctx.Document.Elements {
+		if first, ok := element.(types.Section); ok {
+			return first.Title.Attributes[types.AttrID] == s.Title.Attributes[types.AttrID]
+		}
+	}
+	return false
+}
+
+// withoutFirstParagraph returns the given elements, without the first paragraph
+func withoutFirstParagraph(elements []interface{}) []interface{} {
+	for i, element := range elements {
+		if _, ok := element.(types.Paragraph); ok {
+			result := make([]interface{}, 0, len(elements)-1)
+			result = append(result, elements[:i]...)
+			return append(result, elements[i+1:]...)
+		}
+	}
+	return elements
+}
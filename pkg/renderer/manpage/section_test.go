@@ -0,0 +1,38 @@
+package manpage_test
+
+import (
+	. "github.com/onsi/ginkgo"
+)
+
+var _ = Describe("sections", func() {
+
+	It("sections and subsections", func() {
+		actualContent := `== Options
+
+a paragraph
+
+=== General "options"
+
+another paragraph
+
+==== Level 3
+
+yet another paragraph`
+		expectedResult := `.SH "OPTIONS"
+.sp
+a paragraph
+.SS "General \(dqoptions\(dq"
+.sp
+another paragraph
+.SS "Level 3"
+.sp
+yet another paragraph`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+
+	It("section with quoted text in title", func() {
+		actualContent := `== The *foo* command`
+		expectedResult := `.SH "THE \fBFOO\fP COMMAND"`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+})
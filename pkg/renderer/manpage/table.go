@@ -0,0 +1,91 @@
+package manpage
+
+import (
+	"bytes"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+)
+
+var tableTmpl texttemplate.Template
+
+// initializes the templates
+func init() {
+	tableTmpl = newTextTemplate("table",
+		`.sp
+{{ template "title" .Title }}.TS
+{{ if .Box }}{{ .Box }} {{ end }}tab(:);{{ range .Formats }}
+{{ . }}{{ end }}.{{ range .Rows }}
+{{ range $index, $cell := . }}{{ if $index }}:{{ end }}T{
+{{ $cell }}
+T}{{ end }}{{ end }}
+.TE`+blockTitleTmpl)
+}
+
+// the tbl alignment keys for the horizontal alignments of the cells
+var alignments = map[types.HAlignment]string{
+	types.HAlignLeft:   "l",
+	types.HAlignCenter: "c",
+	types.HAlignRight:  "r",
+}
+
+// renderTable renders the table with the tbl preprocessor (see tbl(1)), with a format line for each row
+func renderTable(ctx *renderer.Context, t types.Table) ([]byte, error) {
+	box := "allbox"
+	if t.Attributes[types.AttrGrid] == "none" {
+		box = "box"
+		if t.Attributes[types.AttrFrame] == "none" {
+			box = ""
+		}
+	}
+	formats := []string{}
+	rows := [][]string{}
+	for i, row := range append(append([]types.TableRow{t.Header}, t.Rows...), t.Footer) {
+		if len(row.Cells) == 0 {
+			continue
+		}
+		format := make([]string, 0, len(row.Cells))
+		cells := make([]string, 0, len(row.Cells))
+		for _, c := range row.Cells {
+			content, err := renderLines(ctx, c.Lines)
+			if err != nil {
+				return nil, errors.Wrapf(err, "unable to render table")
+			}
+			key := alignments[c.HAlign]
+			if key == "" {
+				key = "l"
+			}
+			key += "t" // the content of the cells is rendered in text blocks
+			if i == 0 || c.Style == types.HeaderCellStyle || c.Style == types.StrongCellStyle {
+				// the first row is the header
+				key += "B"
+			}
+			format = append(format, key)
+			for j := 1; j < c.ColSpan; j++ {
+				format = append(format, "s")
+			}
+			cells = append(cells, string(content))
+		}
+		formats = append(formats, strings.Join(format, " "))
+		rows = append(rows, cells)
+	}
+	result := bytes.NewBuffer(nil)
+	err := tableTmpl.Execute(result, struct {
+		Title   string
+		Box     string
+		Formats []string
+		Rows    [][]string
+	}{
+		Title:   escape(getTitle(t.Attributes)),
+		Box:     box,
+		Formats: formats,
+		Rows:    rows,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render table")
+	}
+	return result.Bytes(), nil
+}
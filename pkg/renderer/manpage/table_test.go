@@ -0,0 +1,67 @@
+package manpage_test
+
+import (
+	. "github.com/onsi/ginkgo"
+)
+
+var _ = Describe("tables", func() {
+
+	It("table with header and alignments", func() {
+		actualContent := `.Options
+[cols="<,>"]
+|===
+| Option | Default
+
+| --verbose | false
+| --level | 3
+|===`
+		expectedResult := `.sp
+\fBOptions\fP
+.br
+.TS
+allbox tab(:);
+ltB rtB
+lt rt
+lt rt.
+T{
+Option
+T}:T{
+Default
+T}
+T{
+\-\-verbose
+T}:T{
+false
+T}
+T{
+\-\-level
+T}:T{
+3
+T}
+.TE`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+
+	It("table without grid and with a column span", func() {
+		actualContent := `[grid=none]
+|===
+2+| a cell spanning 2 columns
+| a | b
+|===`
+		expectedResult := `.sp
+.TS
+box tab(:);
+lt s
+lt lt.
+T{
+a cell spanning 2 columns
+T}
+T{
+a
+T}:T{
+b
+T}
+.TE`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+})
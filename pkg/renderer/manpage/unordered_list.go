@@ -0,0 +1,69 @@
+package manpage
+
+import (
+	"bytes"
+	texttemplate "text/template"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+)
+
+var unorderedListTmpl texttemplate.Template
+
+// initializes the templates
+func init() {
+	unorderedListTmpl = newTextTemplate("unordered list",
+		`{{ $ctx := .Context }}{{ with .Data }}.sp
+{{ template "title" .Title }}.RS 4{{ range .Items }}
+.IP \(bu 2
+{{ renderListItem $ctx .Elements }}{{ end }}
+.RE{{ end }}`+blockTitleTmpl,
+		texttemplate.FuncMap{
+			"renderListItem": renderListItem,
+		})
+}
+
+func renderUnorderedList(ctx *renderer.Context, l types.UnorderedList) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	err := unorderedListTmpl.Execute(result, ContextualPipeline{
+		Context: ctx,
+		Data: struct {
+			Title string
+			Items []types.UnorderedListItem
+		}{
+			Title: escape(getTitle(l.Attributes)),
+			Items: l.Items,
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render unordered list")
+	}
+	return result.Bytes(), nil
+}
+
+// renderListItem renders the elements of a list item, in which the lines of the first paragraph
+// are rendered directly after the marker of the item (eg: `.IP \(bu 2`), without any vertical space
+func renderListItem(ctx *renderer.Context, elements []interface{}) (string, error) {
+	if len(elements) == 0 {
+		return "", nil
+	}
+	result := bytes.NewBuffer(nil)
+	if p, ok := elements[0].(types.Paragraph); ok && p.Attributes[types.AttrAdmonitionKind] == nil {
+		lines, err := renderLines(ctx, p.Lines)
+		if err != nil {
+			return "", errors.Wrapf(err, "unable to render list item")
+		}
+		result.Write(lines)
+		elements = elements[1:]
+	}
+	content, err := renderElements(ctx, elements)
+	if err != nil {
+		return "", errors.Wrapf(err, "unable to render list item")
+	}
+	if result.Len() > 0 && len(content) > 0 {
+		result.WriteString("\n")
+	}
+	result.Write(content)
+	return result.String(), nil
+}
@@ -1,6 +1,11 @@
 package renderer
 
-import "time"
+import (
+	"strings"
+	"time"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+)
 
 //Option the options when rendering a document
 type Option func(ctx *Context)
@@ -13,6 +18,20 @@ const (
 	keyIncludeHeaderFooter string = "IncludeHeaderFooter"
 	//keyEntrypoint a bool value to indicate if the entrypoint to start with when parsing the document
 	keyEntrypoint string = "Entrypoint"
+	//keyIncludeResolver the FileResolver to use when processing the `include::` directives (and reading the stylesheets and the images)
+	keyIncludeResolver string = "IncludeResolver"
+	//keyHighlighter the SyntaxHighlighter to use when rendering the source blocks
+	keyHighlighter string = "Highlighter"
+	//keyReferences the ReferenceCatalog to use when rendering the cross references to other documents
+	keyReferences string = "References"
+	//keyTemplates the TemplateRegistry with the custom templates to use instead of the built-in ones
+	keyTemplates string = "Templates"
+	//keyAttributes the attributes set from outside of the document
+	keyAttributes string = "Attributes"
+	//keySafeMode the safe mode to apply on the document
+	keySafeMode string = "SafeMode"
+	//keySanitizer the SanitizerPolicy to apply on the raw HTML content and on the links
+	keySanitizer string = "Sanitizer"
 	// LastUpdatedFormat the time format for the `last updated` document attribute
 	LastUpdatedFormat string = "2006/01/02 15:04:05 MST"
 )
@@ -38,6 +57,64 @@ func Entrypoint(entrypoint string) Option {
 	}
 }
 
+// IncludeResolver function to set the `include resolver` option in the renderer context,
+// i.e., the FileResolver used to open the files referenced by the `include::` directives, as well as the custom stylesheets
+// and the images embedded with the `data-uri` attribute (default is the local file system)
+func IncludeResolver(resolver FileResolver) Option {
+	return func(ctx *Context) {
+		ctx.options[keyIncludeResolver] = resolver
+	}
+}
+
+// Highlighter function to set the `highlighter` option in the renderer context,
+// i.e., the SyntaxHighlighter used to highlight the content of the source blocks (default is none)
+func Highlighter(highlighter SyntaxHighlighter) Option {
+	return func(ctx *Context) {
+		ctx.options[keyHighlighter] = highlighter
+	}
+}
+
+// References function to set the `references` option in the renderer context, i.e., the catalog used to resolve
+// and check the cross references to other documents (default is none, in which case these cross references are not checked)
+func References(catalog ReferenceCatalog) Option {
+	return func(ctx *Context) {
+		ctx.options[keyReferences] = catalog
+	}
+}
+
+// Templates function to set the `templates` option in the renderer context, i.e., the registry of the custom templates
+// which override the built-in templates of the renderer (default is none)
+func Templates(registry *TemplateRegistry) Option {
+	return func(ctx *Context) {
+		ctx.options[keyTemplates] = registry
+	}
+}
+
+// Attributes function to set the `attributes` option in the renderer context, i.e., the attributes to set (or unset) in the document
+// before it is preprocessed, parsed and rendered. These attributes take precedence over their declarations in the document,
+// unless they are soft set with the `@` suffix (see `types.NewAttributeOverrides`)
+func Attributes(attributes map[string]string) Option {
+	return func(ctx *Context) {
+		ctx.options[keyAttributes] = types.NewAttributeOverrides(attributes)
+	}
+}
+
+// Safe function to set the `safe mode` option in the renderer context, which restricts the features
+// that the documents can use (see `SafeMode`)
+func Safe(mode SafeMode) Option {
+	return func(ctx *Context) {
+		ctx.options[keySafeMode] = mode
+	}
+}
+
+// Sanitizer function to set the `sanitizer` option in the renderer context, i.e., the SanitizerPolicy
+// which filters the content of the passthroughs and the targets of the links (the html5 renderer uses its default policy otherwise)
+func Sanitizer(policy SanitizerPolicy) Option {
+	return func(ctx *Context) {
+		ctx.options[keySanitizer] = policy
+	}
+}
+
 // LastUpdated returns the value of the 'LastUpdated' Option if it was present,
 // otherwise it returns the current time using the `2006/01/02 15:04:05 MST` format
 func (ctx *Context) LastUpdated() string {
@@ -59,3 +136,72 @@ func (ctx *Context) IncludeHeaderFooter() bool {
 	}
 	return false
 }
+
+// IncludeResolver returns the value of the 'IncludeResolver' Option if it was present,
+// otherwise it returns a FileResolver which opens the files on the local file system
+func (ctx *Context) IncludeResolver() FileResolver {
+	if resolver, found := ctx.options[keyIncludeResolver].(FileResolver); found {
+		return resolver
+	}
+	return fileSystemResolver{}
+}
+
+// Highlighter returns the value of the 'Highlighter' Option if it was present,
+// otherwise it returns `nil`, meaning that the source blocks are not highlighted
+func (ctx *Context) Highlighter() SyntaxHighlighter {
+	if highlighter, found := ctx.options[keyHighlighter].(SyntaxHighlighter); found {
+		return highlighter
+	}
+	return nil
+}
+
+// References returns the value of the 'References' Option if it was present,
+// otherwise it returns `nil`, meaning that the cross references to other documents are not resolved
+func (ctx *Context) References() ReferenceCatalog {
+	if catalog, found := ctx.options[keyReferences].(ReferenceCatalog); found {
+		return catalog
+	}
+	return nil
+}
+
+// Templates returns the value of the 'Templates' Option if it was present,
+// otherwise it returns `nil`, meaning that only the built-in templates are used
+func (ctx *Context) Templates() *TemplateRegistry {
+	if registry, found := ctx.options[keyTemplates].(*TemplateRegistry); found {
+		return registry
+	}
+	return nil
+}
+
+// Attributes returns the attributes set (or unset) with the 'Attributes' Option, along with the attributes
+// set by the safe mode (eg: `safe-mode-name`). The attributes of the 'Attributes' Option take precedence
+// over the restrictions of the safe mode, since they are set by the caller, not by the document.
+func (ctx *Context) Attributes() types.AttributeOverrides {
+	result := ctx.SafeMode().overrides()
+	if overrides, found := ctx.options[keyAttributes].(types.AttributeOverrides); found {
+		for name, o := range overrides {
+			if !strings.HasPrefix(name, "safe-mode-") {
+				result[name] = o
+			}
+		}
+	}
+	return result
+}
+
+// SafeMode returns the value of the 'SafeMode' Option if it was present,
+// otherwise it returns `SafeModeUnsafe`
+func (ctx *Context) SafeMode() SafeMode {
+	if mode, found := ctx.options[keySafeMode].(SafeMode); found {
+		return mode
+	}
+	return SafeModeUnsafe
+}
+
+// Sanitizer returns the value of the 'Sanitizer' Option if it was present,
+// otherwise it returns `nil`
+func (ctx *Context) Sanitizer() SanitizerPolicy {
+	if policy, found := ctx.options[keySanitizer].(SanitizerPolicy); found {
+		return policy
+	}
+	return nil
+}
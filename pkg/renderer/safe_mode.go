@@ -0,0 +1,92 @@
+package renderer
+
+import (
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+)
+
+// SafeMode the level of trust in the documents being converted, which restricts the features which can access
+// the file system or write raw content in the output, following the Asciidoctor safe modes
+type SafeMode int
+
+const (
+	// SafeModeUnsafe no restriction, for the trusted documents (default)
+	SafeModeUnsafe SafeMode = 0
+	// SafeModeSafe the included files must be in the directory of the document (or one of its subdirectories),
+	// and the document cannot set the `allow-uri-read` attribute
+	SafeModeSafe SafeMode = 1
+	// SafeModeServer same as SafeModeSafe, and the content of the passthroughs (`+++`, `pass:[]` and `++++`) is escaped
+	SafeModeServer SafeMode = 10
+	// SafeModeSecure same as SafeModeServer, and the include directives are replaced by links to their target,
+	// the stylesheet is always linked (`linkcss`) and never copied (`copycss`), and the images are never embedded (`data-uri`)
+	SafeModeSecure SafeMode = 20
+)
+
+// String returns the name of the safe mode
+func (m SafeMode) String() string {
+	switch m {
+	case SafeModeUnsafe:
+		return "unsafe"
+	case SafeModeSafe:
+		return "safe"
+	case SafeModeServer:
+		return "server"
+	case SafeModeSecure:
+		return "secure"
+	default:
+		return "unknown"
+	}
+}
+
+// ParseSafeMode returns the safe mode with the given name (`unsafe`, `safe`, `server` or `secure`)
+func ParseSafeMode(name string) (SafeMode, error) {
+	switch strings.ToLower(name) {
+	case "unsafe":
+		return SafeModeUnsafe, nil
+	case "safe":
+		return SafeModeSafe, nil
+	case "server":
+		return SafeModeServer, nil
+	case "secure":
+		return SafeModeSecure, nil
+	default:
+		return 0, errors.Errorf("unknown safe mode: '%s'", name)
+	}
+}
+
+// overrides returns the attributes which are set (or unset) by the safe mode, and which cannot be overridden by the documents
+func (m SafeMode) overrides() types.AttributeOverrides {
+	result := types.AttributeOverrides{
+		"safe-mode-name":          {Value: m.String()},
+		"safe-mode-" + m.String(): {},
+		"safe-mode-level":         {Value: strconv.Itoa(int(m))},
+	}
+	if m >= SafeModeSafe {
+		result["allow-uri-read"] = types.AttributeOverride{Unset: true}
+	}
+	if m >= SafeModeSecure {
+		result["linkcss"] = types.AttributeOverride{}
+		result["copycss"] = types.AttributeOverride{Unset: true}
+		result["data-uri"] = types.AttributeOverride{Unset: true}
+	}
+	return result
+}
+
+// WithinDir returns true if the file at the given path is in the given directory (or in one of its subdirectories).
+// In the `safe` mode (and higher), the files outside of the directory of the document cannot be read.
+func WithinDir(dir, path string) bool {
+	base, err := filepath.Abs(dir)
+	if err != nil {
+		return false
+	}
+	target, err := filepath.Abs(path)
+	if err != nil {
+		return false
+	}
+	rel, err := filepath.Rel(base, target)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
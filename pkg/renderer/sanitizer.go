@@ -0,0 +1,10 @@
+package renderer
+
+// SanitizerPolicy the interface of the component which filters the raw HTML content written by the documents
+// (ie, the content of the passthroughs) and the targets of their links, to prevent cross-site scripting (XSS)
+type SanitizerPolicy interface {
+	// SanitizeHTML returns the given HTML fragment without the elements and attributes which are not allowed
+	SanitizeHTML(fragment string) string
+	// AllowURL returns true if the given URL can be written as the target of a link
+	AllowURL(url string) bool
+}
@@ -0,0 +1,209 @@
+package renderer
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// AttrSectionNumbers the attribute which enables the numbering of the sections
+	AttrSectionNumbers string = "sectnums"
+	// AttrSectionNumberLevels the attribute which sets the deepest level of the numbered sections (default is 3)
+	AttrSectionNumberLevels string = "sectnumlevels"
+	// AttrPartNumbers the attribute which enables the numbering of the parts of a book
+	AttrPartNumbers string = "partnums"
+	// AttrPartSignifier the attribute which sets the label of the numbered parts of a book (eg: `Part`)
+	AttrPartSignifier string = "part-signifier"
+	// AttrChapterSignifier the attribute which sets the label of the numbered chapters of a book (eg: `Chapter`)
+	AttrChapterSignifier string = "chapter-signifier"
+	// AttrAppendixCaption the attribute which sets the label of the appendices (default is `Appendix`)
+	AttrAppendixCaption string = "appendix-caption"
+)
+
+// SectionNumbers the prefixes of the titles of the numbered sections (eg: `3.2.1. `, `Appendix A: ` or `A.1. `),
+// indexed by the ID of the sections
+type SectionNumbers map[string]string
+
+// NumberSections returns the numbers of the sections of the given document. The sections are numbered when the
+// `sectnums` attribute is set, down to the `sectnumlevels` level. This attribute can be set or unset (`:sectnums!:`)
+// in the body of the document, in which case it only applies to the following sections.
+// The level 1 sections with the `appendix` style are lettered (`A`, `B`, etc.), whether the other sections are numbered or not,
+// whereas the other special sections (eg: `preface` or `glossary`) and their subsections are not numbered.
+// In a book, the chapters are numbered across the parts, which are numbered with roman numerals if the `partnums` attribute is set.
+func NumberSections(doc types.Document) SectionNumbers {
+	n := &sectionNumberer{
+		attributes: types.DocumentAttributes{},
+		numbers:    SectionNumbers{},
+	}
+	for k, v := range doc.Attributes {
+		n.attributes[k] = v
+	}
+	if err := types.Walk(n, doc.Elements...); err != nil {
+		log.Warnf("unable to number the sections: %v", err)
+	}
+	return n.numbers
+}
+
+// sectionNumberer the visitor which numbers the sections in the order of the document
+type sectionNumberer struct {
+	attributes types.DocumentAttributes // the attributes declared so far
+	ordinals   []int                    // the ordinals of the current section and its parents, indexed by level
+	appendix   string                   // the letter of the current appendix, if any
+	appendices int
+	parts      int
+	special    bool // true within a special section which is not numbered (eg: a preface)
+	numbers    SectionNumbers
+}
+
+// BeforeVisit Implements Visitor#BeforeVisit()
+func (n *sectionNumberer) BeforeVisit(element types.Visitable) error {
+	return nil
+}
+
+// Visit Implements Visitor#Visit()
+func (n *sectionNumberer) Visit(element types.Visitable) error {
+	switch e := element.(type) {
+	case types.DocumentAttributeDeclaration:
+		n.attributes.AddAttribute(e)
+	case types.DocumentAttributeReset:
+		n.attributes.Reset(e)
+		if e.Name == AttrAppendixCaption {
+			// unlike the other attributes, the appendix caption has a default value when it is not declared
+			n.attributes[AttrAppendixCaption] = ""
+		}
+	case types.Section:
+		n.number(e)
+	}
+	return nil
+}
+
+// AfterVisit Implements Visitor#AfterVisit()
+func (n *sectionNumberer) AfterVisit(element types.Visitable) error {
+	return nil
+}
+
+func (n *sectionNumberer) number(s types.Section) {
+	id, _ := s.Title.Attributes[types.AttrID].(string)
+	_, numbered := n.attributes[AttrSectionNumbers]
+	switch s.Level {
+	case 0:
+		n.parts++
+		n.special = false
+		if _, found := n.attributes[AttrPartNumbers]; found {
+			n.numbers[id] = n.signifier(AttrPartSignifier) + RomanNumeral(n.parts) + ": "
+		}
+		return
+	case 1:
+		n.appendix = ""
+		n.special = false
+		switch s.Title.Style() {
+		case "":
+		case types.SectionStyleAppendix:
+			// the subsections of the appendix are numbered from 1 again
+			if len(n.ordinals) > 1 {
+				n.ordinals = n.ordinals[:1]
+			}
+			n.appendices++
+			n.appendix = string(rune('A' + n.appendices - 1))
+			if caption := n.appendixCaption(); caption != "" {
+				n.numbers[id] = caption + " " + n.appendix + ": "
+			} else if numbered {
+				n.numbers[id] = n.appendix + ". "
+			}
+			return
+		default:
+			n.special = true
+			return
+		}
+	}
+	if !numbered || n.special || s.Level > n.levels() {
+		return
+	}
+	// the ordinals of the levels which were skipped are kept to 0
+	for len(n.ordinals) < s.Level {
+		n.ordinals = append(n.ordinals, 0)
+	}
+	n.ordinals = n.ordinals[:s.Level]
+	n.ordinals[s.Level-1]++
+	parts := make([]string, 0, s.Level)
+	for i, o := range n.ordinals {
+		if i == 0 && n.appendix != "" {
+			parts = append(parts, n.appendix)
+			continue
+		}
+		parts = append(parts, strconv.Itoa(o))
+	}
+	number := strings.Join(parts, ".") + ". "
+	if s.Level == 1 && n.appendix == "" && n.attributes.GetDocType() == types.DocTypeBook {
+		number = n.signifier(AttrChapterSignifier) + number
+	}
+	n.numbers[id] = number
+}
+
+// signifier returns the value of the given signifier attribute followed by a space, or an empty string if it is not set
+func (n *sectionNumberer) signifier(attr string) string {
+	if s := n.attributes.GetAsString(attr); s != nil && *s != "" {
+		return *s + " "
+	}
+	return ""
+}
+
+// levels returns the deepest level of the numbered sections
+func (n *sectionNumberer) levels() int {
+	if l := n.attributes.GetAsString(AttrSectionNumberLevels); l != nil {
+		if levels, err := strconv.Atoi(*l); err == nil {
+			return levels
+		}
+		log.Warnf("invalid value of the '%s' attribute: '%s'", AttrSectionNumberLevels, *l)
+	}
+	return 3
+}
+
+// appendixCaption returns the label of the appendices, which is empty if the `appendix-caption` attribute was unset
+func (n *sectionNumberer) appendixCaption() string {
+	if caption := n.attributes.GetAsString(AttrAppendixCaption); caption != nil {
+		return *caption
+	}
+	return "Appendix"
+}
+
+const sectionNumbers string = "sectionNumbers"
+
+// SectionNumbers returns the numbers of the sections of the document, which are computed upon the first call to this method
+func (ctx *Context) SectionNumbers() SectionNumbers {
+	numbers, found := ctx.options[sectionNumbers].(SectionNumbers)
+	if !found {
+		numbers = NumberSections(ctx.Document)
+		ctx.options[sectionNumbers] = numbers
+	}
+	return numbers
+}
+
+// SectionNumber returns the prefix of the title of the section with the given ID (eg: `3.2.1. `),
+// or an empty string if the section is not numbered
+func (ctx *Context) SectionNumber(id string) string {
+	return ctx.SectionNumbers()[id]
+}
+
+var romanNumerals = []struct {
+	value  int
+	symbol string
+}{
+	{1000, "M"}, {900, "CM"}, {500, "D"}, {400, "CD"}, {100, "C"}, {90, "XC"},
+	{50, "L"}, {40, "XL"}, {10, "X"}, {9, "IX"}, {5, "V"}, {4, "IV"}, {1, "I"},
+}
+
+// RomanNumeral returns the given (positive) number in roman numerals
+func RomanNumeral(n int) string {
+	result := strings.Builder{}
+	for _, r := range romanNumerals {
+		for n >= r.value {
+			result.WriteString(r.symbol)
+			n -= r.value
+		}
+	}
+	return result.String()
+}
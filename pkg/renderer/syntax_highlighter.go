@@ -0,0 +1,10 @@
+package renderer
+
+// SyntaxHighlighter the interface of the component which highlights the content of the source blocks
+type SyntaxHighlighter interface {
+	// Name returns the name of the highlighter, which is used as a CSS class on the `<pre>` element
+	Name() string
+	// Highlight returns the given source code written in the given language as HTML,
+	// including the markup for the highlighting. The returned content must be HTML-escaped.
+	Highlight(source, language string) (string, error)
+}
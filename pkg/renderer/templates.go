@@ -0,0 +1,101 @@
+package renderer
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	texttemplate "text/template"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// TemplateExtension the extension of the files which contain the custom templates (eg: `paragraph.tmpl`)
+const TemplateExtension string = ".tmpl"
+
+// TemplateRegistry the custom templates which override the built-in templates of a renderer, indexed by the name
+// of the node type that they render (eg: `paragraph` or `section-title`).
+// The templates are Go text templates, which are parsed with the functions of the built-in template upon their first use.
+type TemplateRegistry struct {
+	sources map[string]string
+	mu      sync.Mutex
+	parsed  map[string]*texttemplate.Template
+}
+
+// NewTemplateRegistry returns a new, empty registry
+func NewTemplateRegistry() *TemplateRegistry {
+	return &TemplateRegistry{
+		sources: map[string]string{},
+		parsed:  map[string]*texttemplate.Template{},
+	}
+}
+
+// LoadTemplates returns a new registry with the templates in the `.tmpl` files of the given directory,
+// each of them overriding the template of the node type with the same name as the file (eg: `paragraph.tmpl`)
+func LoadTemplates(dir string) (*TemplateRegistry, error) {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to load the templates in %s", dir)
+	}
+	r := NewTemplateRegistry()
+	for _, f := range files {
+		if f.IsDir() || filepath.Ext(f.Name()) != TemplateExtension {
+			continue
+		}
+		src, err := ioutil.ReadFile(filepath.Join(dir, f.Name()))
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to load the templates in %s", dir)
+		}
+		r.Register(strings.TrimSuffix(f.Name(), TemplateExtension), string(src))
+	}
+	return r, nil
+}
+
+// Register registers the given template source for the node type with the given name, replacing any previous one
+func (r *TemplateRegistry) Register(name, src string) {
+	log.Debugf("registering custom template for '%s'", name)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.sources[name] = src
+	delete(r.parsed, name)
+}
+
+// Names returns the sorted names of the node types which have a custom template (if any)
+func (r *TemplateRegistry) Names() []string {
+	if r == nil {
+		return nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	names := make([]string, 0, len(r.sources))
+	for name := range r.sources {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Lookup returns the custom template for the node type with the given name, parsed with the given functions,
+// or `nil` if there is no such template (or no registry at all)
+func (r *TemplateRegistry) Lookup(name string, funcs texttemplate.FuncMap) (*texttemplate.Template, error) {
+	if r == nil {
+		return nil, nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if t, found := r.parsed[name]; found {
+		return t, nil
+	}
+	src, found := r.sources[name]
+	if !found {
+		return nil, nil
+	}
+	t, err := texttemplate.New(name).Funcs(funcs).Parse(src)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to parse the custom '%s' template", name)
+	}
+	r.parsed[name] = t
+	return t, nil
+}
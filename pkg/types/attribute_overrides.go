@@ -0,0 +1,93 @@
+package types
+
+import (
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// AttributeOverride an attribute set (or unset) from outside of the document, eg: with the API or the command line
+type AttributeOverride struct {
+	Value string
+	Unset bool // true if the attribute is unset (eg: `name!`)
+	Soft  bool // true if the document can override the attribute (eg: `name=value@`)
+}
+
+// AttributeOverrides the attributes set from outside of the document, indexed by name.
+// Following the Asciidoctor precedence rules, the attributes which are set (or unset) take precedence over their declarations
+// in the document, which are ignored, unless they are soft set (with the `@` suffix), in which case they are only
+// default values that the document can override.
+type AttributeOverrides map[string]AttributeOverride
+
+// NewAttributeOverrides returns the overrides of the given attributes, indexed by name. The names may end (or start) with `!` to unset
+// the attributes, and the names or the values may end with `@` to soft set (or unset) them, eg: `{"sectnums": "", "toc!": "", "icons": "font@"}`
+func NewAttributeOverrides(attributes map[string]string) AttributeOverrides {
+	result := AttributeOverrides{}
+	for name, value := range attributes {
+		o := AttributeOverride{
+			Value: value,
+		}
+		if strings.HasSuffix(name, "@") {
+			name = strings.TrimSuffix(name, "@")
+			o.Soft = true
+		}
+		if strings.HasSuffix(o.Value, "@") {
+			o.Value = strings.TrimSuffix(o.Value, "@")
+			o.Soft = true
+		}
+		if strings.HasSuffix(name, "!") || strings.HasPrefix(name, "!") {
+			name = strings.Trim(name, "!")
+			o.Unset = true
+			o.Value = ""
+		}
+		result[name] = o
+	}
+	return result
+}
+
+// IsLocked returns true if the attribute with the given name is set (or unset) and cannot be overridden by the document
+func (o AttributeOverrides) IsLocked(name string) bool {
+	a, found := o[name]
+	return found && !a.Soft
+}
+
+// Apply applies the overrides on the given attributes, which were declared in the header of a document (or in an including document)
+func (o AttributeOverrides) Apply(attributes map[string]interface{}) {
+	for name, a := range o {
+		switch {
+		case a.Unset && a.Soft:
+			// nothing to do, the document can set the attribute
+		case a.Unset:
+			delete(attributes, name)
+		case a.Soft:
+			if _, found := attributes[name]; !found {
+				attributes[name] = a.Value
+			}
+		default:
+			attributes[name] = a.Value
+		}
+	}
+}
+
+// removeLockedDeclarations returns the given elements without the declarations and resets of the locked attributes
+func (o AttributeOverrides) removeLockedDeclarations(elements []interface{}) ([]interface{}, error) {
+	result, err := rewriteSlice(RewriterFunc(func(element interface{}) (interface{}, error) {
+		switch e := element.(type) {
+		case DocumentAttributeDeclaration:
+			if o.IsLocked(e.Name) {
+				log.Debugf("ignoring the declaration of the locked '%s' attribute", e.Name)
+				return nil, nil
+			}
+		case DocumentAttributeReset:
+			if o.IsLocked(e.Name) {
+				log.Debugf("ignoring the reset of the locked '%s' attribute", e.Name)
+				return nil, nil
+			}
+		}
+		return element, nil
+	}), elements)
+	if err != nil {
+		return nil, err
+	}
+	return result.([]interface{}), nil
+}
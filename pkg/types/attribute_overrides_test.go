@@ -0,0 +1,52 @@
+package types_test
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	. "github.com/onsi/ginkgo"
+	"github.com/stretchr/testify/assert"
+)
+
+var _ = Describe("attribute overrides", func() {
+
+	It("parse the overrides", func() {
+		overrides := types.NewAttributeOverrides(map[string]string{
+			"sectnums": "",
+			"toc!":     "",
+			"!icons":   "",
+			"product":  "libasciidoc@",
+			"edition@": "community",
+		})
+		assert.Equal(GinkgoT(), types.AttributeOverrides{
+			"sectnums": {},
+			"toc":      {Unset: true},
+			"icons":    {Unset: true},
+			"product":  {Value: "libasciidoc", Soft: true},
+			"edition":  {Value: "community", Soft: true},
+		}, overrides)
+		assert.True(GinkgoT(), overrides.IsLocked("sectnums"))
+		assert.True(GinkgoT(), overrides.IsLocked("toc"))
+		assert.False(GinkgoT(), overrides.IsLocked("product"))
+		assert.False(GinkgoT(), overrides.IsLocked("unknown"))
+	})
+
+	It("apply the overrides", func() {
+		attributes := map[string]interface{}{
+			"toc":     "left",
+			"product": "asciidoc",
+			"author":  "Xavier",
+		}
+		types.NewAttributeOverrides(map[string]string{
+			"sectnums": "",
+			"toc!":     "",
+			"product":  "libasciidoc@",
+			"edition@": "community",
+			"author!@": "",
+		}).Apply(attributes)
+		assert.Equal(GinkgoT(), map[string]interface{}{
+			"sectnums": "",
+			"product":  "asciidoc",
+			"edition":  "community",
+			"author":   "Xavier",
+		}, attributes)
+	})
+})
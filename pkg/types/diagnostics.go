@@ -0,0 +1,107 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// Severity the severity of a diagnostic
+type Severity int
+
+const (
+	// SeverityInfo a diagnostic which does not affect the output
+	SeverityInfo Severity = iota + 1
+	// SeverityWarning a problem in the document, for which a fallback was rendered
+	SeverityWarning
+	// SeverityError a problem which prevents the document (or a part of it) from being rendered
+	SeverityError
+)
+
+// String returns the name of the severity
+func (s Severity) String() string {
+	switch s {
+	case SeverityInfo:
+		return "info"
+	case SeverityWarning:
+		return "warning"
+	case SeverityError:
+		return "error"
+	default:
+		return "unknown"
+	}
+}
+
+// ParseSeverity returns the severity with the given name (`info`, `warning` (or `warn`) or `error`)
+func ParseSeverity(name string) (Severity, error) {
+	switch strings.ToLower(name) {
+	case "info":
+		return SeverityInfo, nil
+	case "warning", "warn":
+		return SeverityWarning, nil
+	case "error":
+		return SeverityError, nil
+	default:
+		return 0, errors.Errorf("unknown severity: '%s'", name)
+	}
+}
+
+// Diagnostic a problem found while processing a document
+type Diagnostic struct {
+	Severity Severity
+	Rule     string // the ID of the rule which reported the problem (eg: `dangling-xref`)
+	Message  string
+	Location Location // the location of the element concerned by the problem (may be unknown)
+}
+
+// String returns the diagnostic in the `file:line:col: severity: message [rule]` format
+func (d Diagnostic) String() string {
+	if d.Location.IsZero() {
+		return fmt.Sprintf("%s: %s [%s]", d.Severity, d.Message, d.Rule)
+	}
+	return fmt.Sprintf("%s: %s: %s [%s]", d.Location, d.Severity, d.Message, d.Rule)
+}
+
+// Diagnostics the diagnostics collected while processing a document, in the order in which they were reported
+type Diagnostics []Diagnostic
+
+// Report appends a new diagnostic to this collector, or logs it if the collector is nil
+// (the owner of a collector is in charge of reporting the problems it contains)
+func (d *Diagnostics) Report(severity Severity, rule string, location Location, format string, args ...interface{}) {
+	diagnostic := Diagnostic{
+		Severity: severity,
+		Rule:     rule,
+		Message:  fmt.Sprintf(format, args...),
+		Location: location,
+	}
+	if d != nil {
+		*d = append(*d, diagnostic)
+		return
+	}
+	switch severity {
+	case SeverityError:
+		log.Error(diagnostic.String())
+	case SeverityWarning:
+		log.Warn(diagnostic.String())
+	default:
+		log.Info(diagnostic.String())
+	}
+}
+
+// Warnf reports a new diagnostic with the `warning` severity
+func (d *Diagnostics) Warnf(rule string, location Location, format string, args ...interface{}) {
+	d.Report(SeverityWarning, rule, location, format, args...)
+}
+
+// AtLeast returns the diagnostics whose severity is at least the given one
+func (d Diagnostics) AtLeast(severity Severity) Diagnostics {
+	result := Diagnostics{}
+	for _, diagnostic := range d {
+		if diagnostic.Severity >= severity {
+			result = append(result, diagnostic)
+		}
+	}
+	return result
+}
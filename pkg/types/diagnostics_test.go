@@ -0,0 +1,46 @@
+package types_test
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	. "github.com/onsi/ginkgo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var _ = Describe("diagnostics", func() {
+
+	It("report diagnostics and filter by severity", func() {
+		diagnostics := types.Diagnostics{}
+		diagnostics.Report(types.SeverityInfo, "some-info", types.Location{}, "some info")
+		diagnostics.Warnf("dangling-xref", types.Location{
+			File:  "test.adoc",
+			Start: types.Position{Line: 3, Col: 5, Offset: 20},
+		}, "unable to resolve cross reference to '%s'", "foo")
+		require.Len(GinkgoT(), diagnostics, 2)
+		assert.Equal(GinkgoT(), "info: some info [some-info]", diagnostics[0].String())
+		assert.Equal(GinkgoT(), "test.adoc:3:5: warning: unable to resolve cross reference to 'foo' [dangling-xref]", diagnostics[1].String())
+		assert.Equal(GinkgoT(), types.Diagnostics{diagnostics[1]}, diagnostics.AtLeast(types.SeverityWarning))
+		assert.Empty(GinkgoT(), diagnostics.AtLeast(types.SeverityError))
+	})
+
+	It("report diagnostics without collector", func() {
+		var diagnostics *types.Diagnostics
+		// no panic
+		diagnostics.Warnf("dangling-xref", types.Location{}, "unable to resolve cross reference to '%s'", "foo")
+	})
+
+	It("parse severities", func() {
+		for name, expected := range map[string]types.Severity{
+			"info":    types.SeverityInfo,
+			"warn":    types.SeverityWarning,
+			"WARNING": types.SeverityWarning,
+			"error":   types.SeverityError,
+		} {
+			s, err := types.ParseSeverity(name)
+			require.NoError(GinkgoT(), err)
+			assert.Equal(GinkgoT(), expected, s)
+		}
+		_, err := types.ParseSeverity("fatal")
+		assert.Error(GinkgoT(), err)
+	})
+})
@@ -13,8 +13,27 @@ type DocumentAttributes map[string]interface{}
 const (
 	title     string = "doctitle"
 	toclevels string = "toclevels"
+	doctype   string = "doctype"
 )
 
+const (
+	// DocTypeArticle the default type of document
+	DocTypeArticle string = "article"
+	// DocTypeBook the type of the documents with parts (level 0 sections) and chapters
+	DocTypeBook string = "book"
+	// DocTypeManpage the type of the documents which are man pages, whose title is the name and volume of the page (eg: `git-foo(1)`)
+	// and whose first section is the `NAME` section
+	DocTypeManpage string = "manpage"
+)
+
+// GetDocType returns the value of the `doctype` attribute if it was specified, or `article` as the default value
+func (m DocumentAttributes) GetDocType() string {
+	if t, ok := m[doctype].(string); ok && t != "" {
+		return t
+	}
+	return DocTypeArticle
+}
+
 // GetTOCLevels returns the value of the `toclevels` attribute if it was specified,
 // or `2` as the default value
 func (m DocumentAttributes) GetTOCLevels() (*int, error) {
@@ -0,0 +1,59 @@
+package types
+
+import (
+	log "github.com/sirupsen/logrus"
+)
+
+// Footnotes the footnotes of a document, in order of appearance
+type Footnotes []Footnote
+
+// FootnoteReferences the footnotes with a reference, indexed by their reference
+type FootnoteReferences map[string]Footnote
+
+// FootnotesCollector the rewriter that traverses the whole document structure in search for footnotes,
+// and numbers them in order of appearance
+type FootnotesCollector struct {
+	Footnotes          Footnotes
+	FootnoteReferences FootnoteReferences
+	diagnostics        *Diagnostics
+}
+
+// NewFootnotesCollector initializes a new FootnotesCollector, which reports the problems in the given
+// diagnostics collector (which may be nil)
+func NewFootnotesCollector(diagnostics *Diagnostics) *FootnotesCollector {
+	return &FootnotesCollector{
+		diagnostics: diagnostics,
+	}
+}
+
+// Rewrite Implements Rewriter#Rewrite(): returns the given footnote with its number. A reference to a footnote
+// which was already defined has the number of this footnote and no content, whereas a reference used
+// before its definition is not numbered.
+func (c *FootnotesCollector) Rewrite(element interface{}) (interface{}, error) {
+	f, ok := element.(Footnote)
+	if !ok {
+		return element, nil
+	}
+	if f.Ref != "" {
+		if ref, found := c.FootnoteReferences[f.Ref]; found {
+			// reference to a footnote which was already defined
+			f.ID = ref.ID
+			f.Elements = nil
+			return f, nil
+		}
+		if len(f.Elements) == 0 {
+			c.diagnostics.Warnf("footnote-ref-before-definition", f.Location, "footnote reference '%s' is used before being defined", f.Ref)
+			return f, nil
+		}
+	}
+	f.ID = len(c.Footnotes) + 1
+	log.Debugf("adding footnote #%d", f.ID)
+	c.Footnotes = append(c.Footnotes, f)
+	if f.Ref != "" {
+		if c.FootnoteReferences == nil {
+			c.FootnoteReferences = FootnoteReferences{}
+		}
+		c.FootnoteReferences[f.Ref] = f
+	}
+	return f, nil
+}
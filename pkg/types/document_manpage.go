@@ -0,0 +1,89 @@
+package types
+
+import (
+	"regexp"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// AttrManTitle the attribute which holds the name of a man page, as given in its title (eg: `git-foo` in `git-foo(1)`)
+	AttrManTitle string = "mantitle"
+	// AttrManVolNum the attribute which holds the volume of a man page, as given in its title (eg: `1` in `git-foo(1)`)
+	AttrManVolNum string = "manvolnum"
+	// AttrManName the attribute which holds the name(s) of the command described in a man page, as given in its `NAME` section
+	AttrManName string = "manname"
+	// AttrManPurpose the attribute which holds the purpose of the command described in a man page, as given in its `NAME` section
+	AttrManPurpose string = "manpurpose"
+)
+
+// manTitleRegexp the title of a man page, i.e., the name of the page followed by its volume between parentheses
+var manTitleRegexp = regexp.MustCompile(`^(.+?)\s*\((.+)\)$`)
+
+// processManpageHeader sets the `mantitle` and `manvolnum` attributes from the title of the document
+// and the `manname` and `manpurpose` attributes from the first paragraph of its first section (the `NAME` section,
+// eg: `git-foo - does foo`), unless they were declared in the document header.
+// The document falls back to the `article` doctype (with an `invalid-manpage` warning) when its header is not valid.
+func processManpageHeader(attributes DocumentAttributes, elements []interface{}, diagnostics *Diagnostics) {
+	if _, found := attributes[AttrManVolNum]; !found {
+		title, _ := attributes.GetTitle()
+		m := manTitleRegexp.FindStringSubmatch(plainText(title.Content))
+		if m == nil {
+			diagnostics.Warnf("invalid-manpage", Location{}, "the title of a man page must be its name followed by its volume (eg: 'git-foo(1)'), falling back to the article doctype")
+			attributes[doctype] = DocTypeArticle
+			return
+		}
+		attributes.AddNonEmpty(AttrManTitle, m[1])
+		attributes[AttrManVolNum] = m[2]
+	}
+	if _, found := attributes[AttrManName]; found {
+		return
+	}
+	section, found := nameSection(elements)
+	if !found {
+		diagnostics.Warnf("invalid-manpage", Location{}, "a man page must start with a NAME section, falling back to the article doctype")
+		attributes[doctype] = DocTypeArticle
+		return
+	}
+	for _, element := range section.Elements {
+		if p, ok := element.(Paragraph); ok {
+			lines := make([]string, len(p.Lines))
+			for i, l := range p.Lines {
+				lines[i] = plainText(l)
+			}
+			name := strings.SplitN(strings.Join(lines, " "), " - ", 2)
+			if len(name) < 2 {
+				break
+			}
+			attributes[AttrManName] = strings.TrimSpace(name[0])
+			attributes[AttrManPurpose] = strings.TrimSpace(name[1])
+			log.Debugf("man page name: '%s', purpose: '%s'", attributes[AttrManName], attributes[AttrManPurpose])
+			return
+		}
+	}
+	diagnostics.Warnf("invalid-manpage", section.Location, "the NAME section of a man page must contain the name and purpose of the command (eg: 'git-foo - does foo'), falling back to the article doctype")
+	attributes[doctype] = DocTypeArticle
+}
+
+// nameSection returns the first section of the given elements
+func nameSection(elements []interface{}) (Section, bool) {
+	for _, element := range elements {
+		if s, ok := element.(Section); ok {
+			return s, true
+		}
+	}
+	return Section{}, false
+}
+
+// plainText returns the text of the given element and its children, without any markup
+func plainText(element interface{}) string {
+	result := strings.Builder{}
+	Walk(VisitorFunc(func(element Visitable) error {
+		if s, ok := element.(StringElement); ok {
+			result.WriteString(s.Content)
+		}
+		return nil
+	}), element)
+	return result.String()
+}
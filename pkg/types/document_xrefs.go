@@ -11,12 +11,15 @@ type ElementReferences map[string]interface{}
 // ElementReferencesCollector the visitor that traverses the whole document structure in search for elements with an ID
 type ElementReferencesCollector struct {
 	ElementReferences ElementReferences
+	diagnostics       *Diagnostics
 }
 
-// NewElementReferencesCollector initializes a new ElementReferencesCollector
-func NewElementReferencesCollector() *ElementReferencesCollector {
+// NewElementReferencesCollector initializes a new ElementReferencesCollector, which reports the duplicate IDs
+// in the given diagnostics collector (which may be nil)
+func NewElementReferencesCollector(diagnostics *Diagnostics) *ElementReferencesCollector {
 	return &ElementReferencesCollector{
 		ElementReferences: ElementReferences{},
+		diagnostics:       diagnostics,
 	}
 }
 
@@ -31,15 +34,51 @@ func (c *ElementReferencesCollector) Visit(element Visitable) error {
 	case Section:
 		elementID := e.Title.Attributes[AttrID]
 		if elementID, ok := elementID.(string); ok {
-			log.Debugf("Adding element reference: %v", elementID)
-			c.ElementReferences[elementID] = e.Title
+			c.add(elementID, e.Title, e.Location)
 		} else {
 			return errors.Errorf("unexpected type of element id: %T", elementID)
 		}
+	case Paragraph:
+		c.addWithAttributes(e.Attributes, e, e.Location)
+	case OrderedList:
+		c.addWithAttributes(e.Attributes, e, e.Location)
+	case OrderedListItem:
+		c.addWithAttributes(e.Attributes, e, e.Location)
+	case UnorderedList:
+		c.addWithAttributes(e.Attributes, e, e.Location)
+	case LabeledList:
+		c.addWithAttributes(e.Attributes, e, e.Location)
+	case CalloutList:
+		c.addWithAttributes(e.Attributes, e, e.Location)
+	case DelimitedBlock:
+		c.addWithAttributes(e.Attributes, e, e.Location)
+	case BlockImage:
+		c.addWithAttributes(e.Attributes, e, e.Location)
+	case Table:
+		c.addWithAttributes(e.Attributes, e, e.Location)
+	case InlineAnchor:
+		c.add(e.ID, e, e.Location)
 	}
 	return nil
 }
 
+// addWithAttributes adds a reference to the given element if its attributes contain an ID
+func (c *ElementReferencesCollector) addWithAttributes(attributes map[string]interface{}, element interface{}, location Location) {
+	if elementID, ok := attributes[AttrID].(string); ok && elementID != "" {
+		c.add(elementID, element, location)
+	}
+}
+
+// add adds a reference to the given element, unless an element with the same ID was already found
+func (c *ElementReferencesCollector) add(elementID string, element interface{}, location Location) {
+	log.Debugf("Adding element reference: %v", elementID)
+	if _, found := c.ElementReferences[elementID]; found {
+		c.diagnostics.Warnf("duplicate-id", location, "duplicate ID: '%s'", elementID)
+		return
+	}
+	c.ElementReferences[elementID] = element
+}
+
 // AfterVisit Implements Visitable#AfterVisit()
 func (c *ElementReferencesCollector) AfterVisit(element Visitable) error {
 	return nil
@@ -37,13 +37,17 @@ type Visitor interface {
 
 // Document the top-level structure for a document
 type Document struct {
-	Attributes        DocumentAttributes
-	Elements          []interface{}
-	ElementReferences ElementReferences
+	Attributes         DocumentAttributes
+	Elements           []interface{}
+	ElementReferences  ElementReferences
+	Footnotes          Footnotes
+	FootnoteReferences FootnoteReferences
 }
 
-// NewDocument initializes a new `Document` from the given lines
-func NewDocument(frontmatter, header interface{}, blocks []interface{}) (Document, error) {
+// NewDocument initializes a new `Document` from the given lines.
+// The attributes set from outside of the document are given as overrides (which may be nil), and
+// the problems found in the document are reported in the given diagnostics collector (which may be nil)
+func NewDocument(frontmatter, header interface{}, blocks []interface{}, overrides AttributeOverrides, diagnostics *Diagnostics) (Document, error) {
 	log.Debugf("initializing a new Document with %d blocks(s)", len(blocks))
 	// elements := convertBlocksTointerface{}s(blocks)
 	// elements := filterEmptyElements(blocks, filterBlankLine(), filterEmptyPreamble())
@@ -58,53 +62,78 @@ func NewDocument(frontmatter, header interface{}, blocks []interface{}) (Documen
 	if header != nil {
 		for attrName, attrValue := range header.(DocumentHeader).Content {
 			attributes[attrName] = attrValue
-			if attrName == "toc" {
-				// insert a TableOfContentsMacro element if `toc` value is:
-				// - "auto" (or empty)
-				// - "preamble"
-				switch attrValue {
-				case "", "auto":
-					// insert TableOfContentsMacro at first position
-					elements = append([]interface{}{TableOfContentsMacro{}}, elements...)
-				case "preamble":
-					// lookup preamble in elements (should be first)
-					preambleIndex := 0
-					for i, e := range elements {
-						if _, ok := e.(Preamble); ok {
-							preambleIndex = i
-							break
-						}
-					}
-					// insert TableOfContentsMacro just after preamble
-					remainingElements := make([]interface{}, len(elements)-(preambleIndex+1))
-					copy(remainingElements, elements[preambleIndex+1:])
-					elements = append(elements[0:preambleIndex+1], TableOfContentsMacro{})
-					elements = append(elements, remainingElements...)
-				case "macro":
-				default:
-					log.Warnf("invalid value for 'toc' attribute: '%s'", attrValue)
-
+		}
+	}
+	// the attributes set from outside of the document take precedence over those declared in the document (unless they are soft set)
+	overrides.Apply(attributes)
+	if len(overrides) > 0 {
+		var err error
+		if elements, err = overrides.removeLockedDeclarations(elements); err != nil {
+			return Document{}, errors.Wrap(err, "error while initializing a document")
+		}
+	}
+	if attrValue, found := attributes["toc"]; found {
+		// insert a TableOfContentsMacro element if `toc` value is:
+		// - "auto" (or empty)
+		// - "preamble"
+		switch attrValue {
+		case "", "auto":
+			// insert TableOfContentsMacro at first position
+			elements = append([]interface{}{TableOfContentsMacro{}}, elements...)
+		case "preamble":
+			// lookup preamble in elements (should be first)
+			preambleIndex := 0
+			for i, e := range elements {
+				if _, ok := e.(Preamble); ok {
+					preambleIndex = i
+					break
 				}
 			}
+			// insert TableOfContentsMacro just after preamble
+			remainingElements := make([]interface{}, len(elements)-(preambleIndex+1))
+			copy(remainingElements, elements[preambleIndex+1:])
+			elements = append(elements[0:preambleIndex+1], TableOfContentsMacro{})
+			elements = append(elements, remainingElements...)
+		case "macro":
+		default:
+			diagnostics.Warnf("invalid-toc", Location{}, "invalid value for 'toc' attribute: '%s'", attrValue)
 		}
 	}
 
-	c := NewElementReferencesCollector()
-	for _, e := range elements {
-		if v, ok := e.(Visitable); ok {
-			v.Accept(c)
-		}
-	}
-	document := Document{
-		Attributes:        attributes,
-		Elements:          elements,
-		ElementReferences: c.ElementReferences,
+	if DocumentAttributes(attributes).GetDocType() == DocTypeManpage {
+		processManpageHeader(attributes, elements, diagnostics)
 	}
 
 	// visit all elements in the `AST` to retrieve their reference (ie, their ElementID if they have any)
+	elements, references, footnotes, footnoteReferences, err := collectReferences(elements, diagnostics)
+	if err != nil {
+		return Document{}, errors.Wrap(err, "error while initializing a document")
+	}
+	document := Document{
+		Attributes:         attributes,
+		Elements:           elements,
+		ElementReferences:  references,
+		Footnotes:          footnotes,
+		FootnoteReferences: footnoteReferences,
+	}
 	return document, nil
 }
 
+// collectReferences returns the given elements with their footnotes numbered, along with the element references and the footnotes
+func collectReferences(elements []interface{}, diagnostics *Diagnostics) ([]interface{}, ElementReferences, Footnotes, FootnoteReferences, error) {
+	f := NewFootnotesCollector(diagnostics)
+	result, err := rewriteSlice(f, elements)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+	elements = result.([]interface{})
+	c := NewElementReferencesCollector(diagnostics)
+	if err := Walk(c, elements...); err != nil {
+		return nil, nil, nil, nil, err
+	}
+	return elements, c.ElementReferences, f.Footnotes, f.FootnoteReferences, nil
+}
+
 func insertPreamble(blocks []interface{}) []interface{} {
 	// log.Debugf("generating preamble from %d blocks", len(blocks))
 	preamble := NewEmptyPreamble()
@@ -411,6 +440,23 @@ func NewDocumentAttributeDeclaration(name []interface{}, value []interface{}) (D
 	}, nil
 }
 
+// Accept implements Visitable#Accept(Visitor)
+func (a DocumentAttributeDeclaration) Accept(v Visitor) error {
+	err := v.BeforeVisit(a)
+	if err != nil {
+		return errors.Wrapf(err, "error while pre-visiting document attribute declaration")
+	}
+	err = v.Visit(a)
+	if err != nil {
+		return errors.Wrapf(err, "error while visiting document attribute declaration")
+	}
+	err = v.AfterVisit(a)
+	if err != nil {
+		return errors.Wrapf(err, "error while post-visiting document attribute declaration")
+	}
+	return nil
+}
+
 // DocumentAttributeReset the type for DocumentAttributeReset
 type DocumentAttributeReset struct {
 	Name string
@@ -426,9 +472,27 @@ func NewDocumentAttributeReset(name []interface{}) (DocumentAttributeReset, erro
 	return DocumentAttributeReset{Name: attrName}, nil
 }
 
+// Accept implements Visitable#Accept(Visitor)
+func (a DocumentAttributeReset) Accept(v Visitor) error {
+	err := v.BeforeVisit(a)
+	if err != nil {
+		return errors.Wrapf(err, "error while pre-visiting document attribute reset")
+	}
+	err = v.Visit(a)
+	if err != nil {
+		return errors.Wrapf(err, "error while visiting document attribute reset")
+	}
+	err = v.AfterVisit(a)
+	if err != nil {
+		return errors.Wrapf(err, "error while post-visiting document attribute reset")
+	}
+	return nil
+}
+
 // DocumentAttributeSubstitution the type for DocumentAttributeSubstitution
 type DocumentAttributeSubstitution struct {
-	Name string
+	Name     string
+	Location Location
 }
 
 // NewDocumentAttributeSubstitution initializes a new Document Attribute Substitutions
@@ -441,6 +505,23 @@ func NewDocumentAttributeSubstitution(name []interface{}) (DocumentAttributeSubs
 	return DocumentAttributeSubstitution{Name: attrName}, nil
 }
 
+// Accept implements Visitable#Accept(Visitor)
+func (a DocumentAttributeSubstitution) Accept(v Visitor) error {
+	err := v.BeforeVisit(a)
+	if err != nil {
+		return errors.Wrapf(err, "error while pre-visiting document attribute substitution")
+	}
+	err = v.Visit(a)
+	if err != nil {
+		return errors.Wrapf(err, "error while visiting document attribute substitution")
+	}
+	err = v.AfterVisit(a)
+	if err != nil {
+		return errors.Wrapf(err, "error while post-visiting document attribute substitution")
+	}
+	return nil
+}
+
 // ------------------------------------------
 // Element kinds
 // ------------------------------------------
@@ -461,6 +542,16 @@ const (
 	Comment
 	// Verse a verse block
 	Verse
+	// Source a source block, i.e., a listing or fenced block with the `source` style
+	Source
+	// Quote a quote block
+	Quote
+	// Sidebar a sidebar block
+	Sidebar
+	// Open an open block
+	Open
+	// PassthroughBlock a passthrough block (not to be confused with the inline `Passthrough`)
+	PassthroughBlock
 )
 
 // ------------------------------------------
@@ -471,6 +562,23 @@ const (
 type TableOfContentsMacro struct {
 }
 
+// Accept implements Visitable#Accept(Visitor)
+func (m TableOfContentsMacro) Accept(v Visitor) error {
+	err := v.BeforeVisit(m)
+	if err != nil {
+		return errors.Wrapf(err, "error while pre-visiting table of contents macro")
+	}
+	err = v.Visit(m)
+	if err != nil {
+		return errors.Wrapf(err, "error while visiting table of contents macro")
+	}
+	err = v.AfterVisit(m)
+	if err != nil {
+		return errors.Wrapf(err, "error while post-visiting table of contents macro")
+	}
+	return nil
+}
+
 // ------------------------------------------
 // Preamble
 // ------------------------------------------
@@ -487,6 +595,31 @@ func NewEmptyPreamble() Preamble {
 	}
 }
 
+// Accept implements Visitable#Accept(Visitor)
+func (p Preamble) Accept(v Visitor) error {
+	err := v.BeforeVisit(p)
+	if err != nil {
+		return errors.Wrapf(err, "error while pre-visiting preamble")
+	}
+	err = v.Visit(p)
+	if err != nil {
+		return errors.Wrapf(err, "error while visiting preamble")
+	}
+	for _, element := range p.Elements {
+		if visitable, ok := element.(Visitable); ok {
+			err = visitable.Accept(v)
+			if err != nil {
+				return errors.Wrapf(err, "error while visiting preamble element")
+			}
+		}
+	}
+	err = v.AfterVisit(p)
+	if err != nil {
+		return errors.Wrapf(err, "error while post-visiting preamble")
+	}
+	return nil
+}
+
 // ------------------------------------------
 // Front Matter
 // ------------------------------------------
@@ -516,6 +649,7 @@ type Section struct {
 	Level    int
 	Title    SectionTitle
 	Elements []interface{}
+	Location Location
 }
 
 // NewSection initializes a new `Section` from the given section title and elements
@@ -540,6 +674,10 @@ func (s Section) Accept(v Visitor) error {
 	if err != nil {
 		return errors.Wrapf(err, "error while visiting section")
 	}
+	err = s.Title.Accept(v)
+	if err != nil {
+		return errors.Wrapf(err, "error while visiting section title")
+	}
 	for _, element := range s.Elements {
 		if visitable, ok := element.(Visitable); ok {
 			err = visitable.Accept(v)
@@ -566,6 +704,44 @@ type SectionTitle struct {
 	Content    InlineElements
 }
 
+// the styles of the special sections, which are set as an attribute of their title (eg: `[appendix]`)
+const (
+	// SectionStyleAppendix the style of the appendices
+	SectionStyleAppendix string = "appendix"
+	// SectionStylePreface the style of the preface of a book
+	SectionStylePreface string = "preface"
+	// SectionStyleGlossary the style of the glossary
+	SectionStyleGlossary string = "glossary"
+	// SectionStyleBibliography the style of the bibliography
+	SectionStyleBibliography string = "bibliography"
+	// SectionStyleIndex the style of the index
+	SectionStyleIndex string = "index"
+	// SectionStyleColophon the style of the colophon of a book
+	SectionStyleColophon string = "colophon"
+	// SectionStyleAbstract the style of the abstract
+	SectionStyleAbstract string = "abstract"
+)
+
+var sectionStyles = []string{
+	SectionStyleAppendix,
+	SectionStylePreface,
+	SectionStyleGlossary,
+	SectionStyleBibliography,
+	SectionStyleIndex,
+	SectionStyleColophon,
+	SectionStyleAbstract,
+}
+
+// Style returns the style of the section if it is a special section (eg: `appendix` or `preface`), or an empty string otherwise
+func (t SectionTitle) Style() string {
+	for _, style := range sectionStyles {
+		if _, found := t.Attributes[style]; found {
+			return style
+		}
+	}
+	return ""
+}
+
 // NewSectionTitle initializes a new `SectionTitle`` from the given level and content, with the optional attributes.
 // In the attributes, only the ElementID is retained
 func NewSectionTitle(inlineContent InlineElements, attributes []interface{}) (SectionTitle, error) {
@@ -590,6 +766,27 @@ func NewSectionTitle(inlineContent InlineElements, attributes []interface{}) (Se
 	return sectionTitle, nil
 }
 
+// Accept implements Visitable#Accept(Visitor)
+func (t SectionTitle) Accept(v Visitor) error {
+	err := v.BeforeVisit(t)
+	if err != nil {
+		return errors.Wrapf(err, "error while pre-visiting section title")
+	}
+	err = v.Visit(t)
+	if err != nil {
+		return errors.Wrapf(err, "error while visiting section title")
+	}
+	err = t.Content.Accept(v)
+	if err != nil {
+		return errors.Wrapf(err, "error while visiting section title content")
+	}
+	err = v.AfterVisit(t)
+	if err != nil {
+		return errors.Wrapf(err, "error while post-visiting section title")
+	}
+	return nil
+}
+
 // ------------------------------------------
 // Lists
 // ------------------------------------------
@@ -703,6 +900,7 @@ func newList(items []ListItem, attributes []interface{}) (List, error) {
 type OrderedList struct {
 	Attributes map[string]interface{}
 	Items      []OrderedListItem
+	Location   Location
 }
 
 // NumberingStyle the type of numbering for items in an ordered list
@@ -810,10 +1008,38 @@ func NewOrderedList(elements []ListItem, attributes []interface{}) (OrderedList,
 		}
 	}
 
-	return OrderedList{
+	list := OrderedList{
 		Attributes: mergeAttributes(attributes),
 		Items:      result,
-	}, nil
+	}
+	if len(result) > 0 {
+		// the location of a list spans over its items (the location of a top-level list is set by the parser)
+		list.Location = spanLocation(result[0].Location, result[len(result)-1].Location)
+	}
+	return list, nil
+}
+
+// Accept implements Visitable#Accept(Visitor)
+func (l OrderedList) Accept(v Visitor) error {
+	err := v.BeforeVisit(l)
+	if err != nil {
+		return errors.Wrapf(err, "error while pre-visiting ordered list")
+	}
+	err = v.Visit(l)
+	if err != nil {
+		return errors.Wrapf(err, "error while visiting ordered list")
+	}
+	for _, element := range l.Items {
+		err = element.Accept(v)
+		if err != nil {
+			return errors.Wrapf(err, "error while visiting ordered list element")
+		}
+	}
+	err = v.AfterVisit(l)
+	if err != nil {
+		return errors.Wrapf(err, "error while post-visiting ordered list")
+	}
+	return nil
 }
 
 func toOrderedList(items []*OrderedListItem) OrderedList {
@@ -831,6 +1057,7 @@ func toOrderedList(items []*OrderedListItem) OrderedList {
 		item.NumberingStyle = items[0].NumberingStyle
 		result.Items = append(result.Items, *item)
 	}
+	result.Location = spanLocation(items[0].Location, items[len(items)-1].Location)
 	return result
 }
 
@@ -841,6 +1068,7 @@ type OrderedListItem struct {
 	NumberingStyle NumberingStyle
 	Elements       []interface{}
 	Attributes     map[string]interface{}
+	Location       Location
 }
 
 // making sure that the `ListItem` interface is implemented by `OrderedListItem`
@@ -859,6 +1087,31 @@ func NewOrderedListItem(prefix OrderedListItemPrefix, elements []interface{}, at
 	}, nil
 }
 
+// Accept implements Visitable#Accept(Visitor)
+func (i OrderedListItem) Accept(v Visitor) error {
+	err := v.BeforeVisit(i)
+	if err != nil {
+		return errors.Wrapf(err, "error while pre-visiting ordered list item")
+	}
+	err = v.Visit(i)
+	if err != nil {
+		return errors.Wrapf(err, "error while visiting ordered list item")
+	}
+	for _, element := range i.Elements {
+		if visitable, ok := element.(Visitable); ok {
+			err = visitable.Accept(v)
+			if err != nil {
+				return errors.Wrapf(err, "error while visiting ordered list item element")
+			}
+		}
+	}
+	err = v.AfterVisit(i)
+	if err != nil {
+		return errors.Wrapf(err, "error while post-visiting ordered list item")
+	}
+	return nil
+}
+
 // AddChild appends the given item to the content of this OrderedListItem
 func (i *OrderedListItem) AddChild(item interface{}) {
 	log.Debugf("Adding item %v to %v", item, i.Elements)
@@ -910,6 +1163,7 @@ func NewOrderedListItemPrefix(s NumberingStyle, l int) (OrderedListItemPrefix, e
 type UnorderedList struct {
 	Attributes map[string]interface{}
 	Items      []UnorderedListItem
+	Location   Location
 }
 
 // NewUnorderedList initializes a new `UnorderedList` from the given content
@@ -957,12 +1211,7 @@ func NewUnorderedList(elements []ListItem, attributes []interface{}) (UnorderedL
 				log.Debugf("merging previously buffered items at level '%d' in parent", l)
 				parentLayer := bufferedItemsPerLevel[l-2]
 				parentItem := parentLayer[len(parentLayer)-1]
-				childList := UnorderedList{
-					Attributes: map[string]interface{}{}, // avoid nil `attributes`
-				}
-				for _, i := range bufferedItemsPerLevel[l-1] {
-					childList.Items = append(childList.Items, *i)
-				}
+				childList := toUnorderedList(bufferedItemsPerLevel[l-1])
 				parentItem.Elements = append(parentItem.Elements, childList)
 				// clear the previously buffered items at level 'previousLevel'
 				delete(bufferedItemsPerLevel, l-1)
@@ -989,22 +1238,59 @@ func NewUnorderedList(elements []ListItem, attributes []interface{}) (UnorderedL
 				result = append(result, *item)
 			}
 		} else {
-			childList := UnorderedList{
-				Attributes: map[string]interface{}{}, // avoid nil `attributes`
-			}
-			for _, item := range items {
-				childList.Items = append(childList.Items, *item)
-			}
+			childList := toUnorderedList(items)
 			parentLayer := bufferedItemsPerLevel[level-1]
 			parentItem := parentLayer[len(parentLayer)-1]
 			parentItem.Elements = append(parentItem.Elements, childList)
 		}
 	}
 
-	return UnorderedList{
+	list := UnorderedList{
 		Attributes: mergeAttributes(attributes),
 		Items:      result,
-	}, nil
+	}
+	if len(result) > 0 {
+		// the location of a list spans over its items (the location of a top-level list is set by the parser)
+		list.Location = spanLocation(result[0].Location, result[len(result)-1].Location)
+	}
+	return list, nil
+}
+
+// Accept implements Visitable#Accept(Visitor)
+func (l UnorderedList) Accept(v Visitor) error {
+	err := v.BeforeVisit(l)
+	if err != nil {
+		return errors.Wrapf(err, "error while pre-visiting unordered list")
+	}
+	err = v.Visit(l)
+	if err != nil {
+		return errors.Wrapf(err, "error while visiting unordered list")
+	}
+	for _, element := range l.Items {
+		err = element.Accept(v)
+		if err != nil {
+			return errors.Wrapf(err, "error while visiting unordered list element")
+		}
+	}
+	err = v.AfterVisit(l)
+	if err != nil {
+		return errors.Wrapf(err, "error while post-visiting unordered list")
+	}
+	return nil
+}
+
+func toUnorderedList(items []*UnorderedListItem) UnorderedList {
+	result := UnorderedList{
+		Attributes: map[string]interface{}{}, // avoid nil `attributes`
+	}
+	if len(items) == 0 {
+		return result
+	}
+	for _, item := range items {
+		result.Items = append(result.Items, *item)
+	}
+	result.Location = spanLocation(items[0].Location, items[len(items)-1].Location)
+	return result
 }
 
 // UnorderedListItem the structure for the unordered list items
@@ -1012,6 +1298,7 @@ type UnorderedListItem struct {
 	Level       int
 	BulletStyle BulletStyle
 	Elements    []interface{}
+	Location    Location
 }
 
 // NewUnorderedListItem initializes a new `UnorderedListItem` from the given content
@@ -1025,6 +1312,31 @@ func NewUnorderedListItem(prefix UnorderedListItemPrefix, elements []interface{}
 	}, nil
 }
 
+// Accept implements Visitable#Accept(Visitor)
+func (i UnorderedListItem) Accept(v Visitor) error {
+	err := v.BeforeVisit(i)
+	if err != nil {
+		return errors.Wrapf(err, "error while pre-visiting unordered list item")
+	}
+	err = v.Visit(i)
+	if err != nil {
+		return errors.Wrapf(err, "error while visiting unordered list item")
+	}
+	for _, element := range i.Elements {
+		if visitable, ok := element.(Visitable); ok {
+			err = visitable.Accept(v)
+			if err != nil {
+				return errors.Wrapf(err, "error while visiting unordered list item element")
+			}
+		}
+	}
+	err = v.AfterVisit(i)
+	if err != nil {
+		return errors.Wrapf(err, "error while post-visiting unordered list item")
+	}
+	return nil
+}
+
 // AddChild appends the given item to the content of this UnorderedListItem
 func (i *UnorderedListItem) AddChild(item interface{}) {
 	i.Elements = append(i.Elements, item)
@@ -1132,6 +1444,23 @@ func NewListItemContinuation() (ListItemContinuation, error) {
 	return ListItemContinuation{}, nil
 }
 
+// Accept implements Visitable#Accept(Visitor)
+func (c ListItemContinuation) Accept(v Visitor) error {
+	err := v.BeforeVisit(c)
+	if err != nil {
+		return errors.Wrapf(err, "error while pre-visiting list item continuation")
+	}
+	err = v.Visit(c)
+	if err != nil {
+		return errors.Wrapf(err, "error while visiting list item continuation")
+	}
+	err = v.AfterVisit(c)
+	if err != nil {
+		return errors.Wrapf(err, "error while post-visiting list item continuation")
+	}
+	return nil
+}
+
 // ------------------------------------------
 // Labeled List
 // ------------------------------------------
@@ -1140,6 +1469,7 @@ func NewListItemContinuation() (ListItemContinuation, error) {
 type LabeledList struct {
 	Attributes map[string]interface{}
 	Items      []LabeledListItem
+	Location   Location
 }
 
 // NewLabeledList initializes a new `LabeledList` from the given content
@@ -1152,16 +1482,45 @@ func NewLabeledList(elements []ListItem, attributes []interface{}) (LabeledList,
 		}
 	}
 	log.Debugf("Initialized a new LabeledList with %d root item(s)", len(items))
-	return LabeledList{
+	list := LabeledList{
 		Attributes: mergeAttributes(attributes),
 		Items:      items,
-	}, nil
+	}
+	if len(items) > 0 {
+		// the location of a list spans over its items (the location of a top-level list is set by the parser)
+		list.Location = spanLocation(items[0].Location, items[len(items)-1].Location)
+	}
+	return list, nil
+}
+
+// Accept implements Visitable#Accept(Visitor)
+func (l LabeledList) Accept(v Visitor) error {
+	err := v.BeforeVisit(l)
+	if err != nil {
+		return errors.Wrapf(err, "error while pre-visiting labeled list")
+	}
+	err = v.Visit(l)
+	if err != nil {
+		return errors.Wrapf(err, "error while visiting labeled list")
+	}
+	for _, element := range l.Items {
+		err = element.Accept(v)
+		if err != nil {
+			return errors.Wrapf(err, "error while visiting labeled list element")
+		}
+	}
+	err = v.AfterVisit(l)
+	if err != nil {
+		return errors.Wrapf(err, "error while post-visiting labeled list")
+	}
+	return nil
 }
 
 // LabeledListItem an item in a labeled
 type LabeledListItem struct {
 	Term     string
 	Elements []interface{}
+	Location Location
 }
 
 // NewLabeledListItem initializes a new LabeledListItem
@@ -1177,6 +1536,31 @@ func NewLabeledListItem(term []interface{}, elements []interface{}) (LabeledList
 	}, nil
 }
 
+// Accept implements Visitable#Accept(Visitor)
+func (i LabeledListItem) Accept(v Visitor) error {
+	err := v.BeforeVisit(i)
+	if err != nil {
+		return errors.Wrapf(err, "error while pre-visiting labeled list item")
+	}
+	err = v.Visit(i)
+	if err != nil {
+		return errors.Wrapf(err, "error while visiting labeled list item")
+	}
+	for _, element := range i.Elements {
+		if visitable, ok := element.(Visitable); ok {
+			err = visitable.Accept(v)
+			if err != nil {
+				return errors.Wrapf(err, "error while visiting labeled list item element")
+			}
+		}
+	}
+	err = v.AfterVisit(i)
+	if err != nil {
+		return errors.Wrapf(err, "error while post-visiting labeled list item")
+	}
+	return nil
+}
+
 // AddChild appends the given item to the content of this LabeledListItem
 func (i *LabeledListItem) AddChild(item interface{}) {
 	log.Debugf("Adding item %v to %v", item, i.Elements)
@@ -1194,6 +1578,7 @@ var _ ListItem = &LabeledListItem{}
 type Paragraph struct {
 	Attributes map[string]interface{}
 	Lines      []InlineElements
+	Location   Location
 }
 
 // NewParagraph initializes a new `Paragraph`
@@ -1217,6 +1602,29 @@ func NewParagraph(lines []interface{}, attributes []interface{}) (Paragraph, err
 	}, nil
 }
 
+// Accept implements Visitable#Accept(Visitor)
+func (p Paragraph) Accept(v Visitor) error {
+	err := v.BeforeVisit(p)
+	if err != nil {
+		return errors.Wrapf(err, "error while pre-visiting paragraph")
+	}
+	err = v.Visit(p)
+	if err != nil {
+		return errors.Wrapf(err, "error while visiting paragraph")
+	}
+	for _, line := range p.Lines {
+		err = line.Accept(v)
+		if err != nil {
+			return errors.Wrapf(err, "error while visiting paragraph line")
+		}
+	}
+	err = v.AfterVisit(p)
+	if err != nil {
+		return errors.Wrapf(err, "error while post-visiting paragraph")
+	}
+	return nil
+}
+
 // NewAdmonitionParagraph returns a new Paragraph with an extra admonition attribute
 func NewAdmonitionParagraph(lines []interface{}, admonitionKind AdmonitionKind, attributes []interface{}) (Paragraph, error) {
 	p, err := NewParagraph(lines, attributes)
@@ -1291,23 +1699,162 @@ func (e InlineElements) Accept(v Visitor) error {
 // Cross References
 // ------------------------------------------
 
-// CrossReference the struct for Cross References
+// CrossReference the struct for Cross References, defined with the `<<id>>`, `<<id,label>>` or `xref:id[label]` forms.
+// The target of a cross reference to another document is prefixed with the path of its source file (eg: `<<other.adoc#id>>`)
 type CrossReference struct {
-	ID string
+	Path     string // the path of the targeted document, relative to the current one (empty if the target is in the same document)
+	ID       string // the ID of the targeted element (may be empty if the cross reference targets another document)
+	Label    string // the custom text of the cross reference (optional)
+	Location Location
+}
+
+// NewCrossReference initializes a new `CrossReference` from the given target and optional label.
+// The target is the ID of an element in the same document, or the path of another document followed by
+// an optional `#` and the ID of an element in this document (eg: `other.adoc#id` or `other#id`)
+func NewCrossReference(target string, label interface{}) (CrossReference, error) {
+	log.Debugf("initializing a new CrossReference with target=%s", target)
+	result := CrossReference{ID: target}
+	if i := strings.Index(target, "#"); i >= 0 {
+		result.Path = target[:i]
+		result.ID = target[i+1:]
+		if result.Path != "" && filepath.Ext(result.Path) == "" {
+			// the extension of the targeted document may be omitted
+			result.Path += ".adoc"
+		}
+	} else if IsAsciidocFile(target) {
+		result.Path = target
+		result.ID = ""
+	}
+	if label, ok := label.(string); ok {
+		result.Label = strings.TrimSpace(label)
+	}
+	return result, nil
+}
+
+// IsInterDocument returns true if the cross reference targets another document
+func (x CrossReference) IsInterDocument() bool {
+	return x.Path != ""
+}
+
+// IsAsciidocFile returns true if the given path has one of the extensions of the Asciidoc files
+// (`.adoc`, `.asciidoc`, `.asc` or `.ad`)
+func IsAsciidocFile(path string) bool {
+	switch filepath.Ext(path) {
+	case ".adoc", ".asciidoc", ".asc", ".ad":
+		return true
+	default:
+		return false
+	}
 }
 
-// NewCrossReference initializes a new `CrossReference` from the given ID
-func NewCrossReference(id string) (CrossReference, error) {
-	log.Debugf("initializing a new CrossReference with ID=%s", id)
-	return CrossReference{ID: id}, nil
+// Accept implements Visitable#Accept(Visitor)
+func (x CrossReference) Accept(v Visitor) error {
+	err := v.BeforeVisit(x)
+	if err != nil {
+		return errors.Wrapf(err, "error while pre-visiting cross reference")
+	}
+	err = v.Visit(x)
+	if err != nil {
+		return errors.Wrapf(err, "error while visiting cross reference")
+	}
+	err = v.AfterVisit(x)
+	if err != nil {
+		return errors.Wrapf(err, "error while post-visiting cross reference")
+	}
+	return nil
 }
 
 // ------------------------------------------
-// Images
+// Inline Anchors
 // ------------------------------------------
 
-const (
-	// AttrImageAlt the image `alt` attribute
+// InlineAnchor an anchor in the inline content, defined with the `[[id]]`, `[[id,label]]` or `anchor:id[label]` forms,
+// which can be the target of cross references
+type InlineAnchor struct {
+	ID       string
+	Label    string // the default text of the cross references to this anchor (optional)
+	Location Location
+}
+
+// NewInlineAnchor initializes a new `InlineAnchor` from the given ID and optional label
+func NewInlineAnchor(id string, label interface{}) (InlineAnchor, error) {
+	log.Debugf("initializing a new InlineAnchor with ID=%s", id)
+	result := InlineAnchor{ID: id}
+	if label, ok := label.(string); ok {
+		result.Label = strings.TrimSpace(label)
+	}
+	return result, nil
+}
+
+// Accept implements Visitable#Accept(Visitor)
+func (a InlineAnchor) Accept(v Visitor) error {
+	err := v.BeforeVisit(a)
+	if err != nil {
+		return errors.Wrapf(err, "error while pre-visiting inline anchor")
+	}
+	err = v.Visit(a)
+	if err != nil {
+		return errors.Wrapf(err, "error while visiting inline anchor")
+	}
+	err = v.AfterVisit(a)
+	if err != nil {
+		return errors.Wrapf(err, "error while post-visiting inline anchor")
+	}
+	return nil
+}
+
+// ------------------------------------------
+// Footnotes
+// ------------------------------------------
+
+// Footnote a foot note, defined with the `footnote:[text]`, `footnote:ref[text]` or `footnoteref:[ref,text]` macros,
+// or a reference to a previously defined foot note, with the `footnote:ref[]` or `footnoteref:[ref]` macros
+type Footnote struct {
+	ID       int // the number of the foot note in the document, set when the document footnotes are collected
+	Ref      string
+	Elements InlineElements
+	Location Location
+}
+
+// NewFootnote initializes a new `Footnote` with the given optional reference and content
+func NewFootnote(ref string, elements interface{}) (Footnote, error) {
+	log.Debugf("initializing a new Footnote with ref='%s'", ref)
+	result := Footnote{
+		Ref: ref,
+	}
+	if elements, ok := elements.(InlineElements); ok {
+		result.Elements = elements
+	}
+	return result, nil
+}
+
+// Accept implements Visitable#Accept(Visitor)
+func (f Footnote) Accept(v Visitor) error {
+	err := v.BeforeVisit(f)
+	if err != nil {
+		return errors.Wrapf(err, "error while pre-visiting footnote")
+	}
+	err = v.Visit(f)
+	if err != nil {
+		return errors.Wrapf(err, "error while visiting footnote")
+	}
+	err = f.Elements.Accept(v)
+	if err != nil {
+		return errors.Wrapf(err, "error while visiting footnote content")
+	}
+	err = v.AfterVisit(f)
+	if err != nil {
+		return errors.Wrapf(err, "error while post-visiting footnote")
+	}
+	return nil
+}
+
+// ------------------------------------------
+// Images
+// ------------------------------------------
+
+const (
+	// AttrImageAlt the image `alt` attribute
 	AttrImageAlt string = "alt"
 	// AttrImageWidth the image `width` attribute
 	AttrImageWidth string = "width"
@@ -1315,12 +1862,23 @@ const (
 	AttrImageHeight string = "height"
 	// AttrImageTitle the image `title` attribute
 	AttrImageTitle string = "title"
+	// AttrImageLink the image `link` attribute
+	AttrImageLink string = "link"
+	// AttrImageAlign the image `align` attribute (`left`, `center` or `right`)
+	AttrImageAlign string = "align"
+	// AttrImageFloat the image `float` attribute (`left` or `right`)
+	AttrImageFloat string = "float"
+	// AttrImageRole the image `role` attribute
+	AttrImageRole string = "role"
+	// AttrImageScaledWidth the image `scaledwidth` attribute (eg: `50%`), which only applies to the printed formats
+	AttrImageScaledWidth string = "scaledwidth"
 )
 
 // BlockImage the structure for the block images
 type BlockImage struct {
 	Macro      ImageMacro
 	Attributes map[string]interface{}
+	Location   Location
 }
 
 // NewBlockImage initializes a new `BlockImage`
@@ -1331,9 +1889,27 @@ func NewBlockImage(imageMacro ImageMacro, attributes []interface{}) (BlockImage,
 	}, nil
 }
 
+// Accept implements Visitable#Accept(Visitor)
+func (i BlockImage) Accept(v Visitor) error {
+	err := v.BeforeVisit(i)
+	if err != nil {
+		return errors.Wrapf(err, "error while pre-visiting block image")
+	}
+	err = v.Visit(i)
+	if err != nil {
+		return errors.Wrapf(err, "error while visiting block image")
+	}
+	err = v.AfterVisit(i)
+	if err != nil {
+		return errors.Wrapf(err, "error while post-visiting block image")
+	}
+	return nil
+}
+
 // InlineImage the structure for the inline image macros
 type InlineImage struct {
-	Macro ImageMacro
+	Macro    ImageMacro
+	Location Location
 }
 
 // NewInlineImage initializes a new `InlineImage` (similar to BlockImage, but without attributes)
@@ -1343,6 +1919,23 @@ func NewInlineImage(imageMacro ImageMacro) (InlineImage, error) {
 	}, nil
 }
 
+// Accept implements Visitable#Accept(Visitor)
+func (i InlineImage) Accept(v Visitor) error {
+	err := v.BeforeVisit(i)
+	if err != nil {
+		return errors.Wrapf(err, "error while pre-visiting inline image")
+	}
+	err = v.Visit(i)
+	if err != nil {
+		return errors.Wrapf(err, "error while visiting inline image")
+	}
+	err = v.AfterVisit(i)
+	if err != nil {
+		return errors.Wrapf(err, "error while post-visiting inline image")
+	}
+	return nil
+}
+
 // ImageMacro the structure for the block image macros
 type ImageMacro struct {
 	Path       string
@@ -1354,14 +1947,7 @@ func NewImageMacro(path string, attributes map[string]interface{}) (ImageMacro,
 	// use the image filename without the extension as the default `alt` attribute
 	log.Debugf("processing alt: '%s'", attributes[AttrImageAlt])
 	if attributes[AttrImageAlt] == "" {
-		_, filename := filepath.Split(path)
-		log.Debugf("adding alt based on filename '%s'", filename)
-		ext := filepath.Ext(filename)
-		if ext != "" {
-			attributes[AttrImageAlt] = strings.TrimRight(filename, fmt.Sprintf(".%s", ext))
-		} else {
-			attributes[AttrImageAlt] = filename
-		}
+		attributes[AttrImageAlt] = defaultImageAlt(path)
 	}
 	return ImageMacro{
 		Path:       path,
@@ -1369,6 +1955,17 @@ func NewImageMacro(path string, attributes map[string]interface{}) (ImageMacro,
 	}, nil
 }
 
+// defaultImageAlt returns the default `alt` text of the image at the given path, i.e., its filename without the extension
+func defaultImageAlt(path string) string {
+	_, filename := filepath.Split(path)
+	log.Debugf("adding alt based on filename '%s'", filename)
+	ext := filepath.Ext(filename)
+	if ext != "" {
+		return strings.TrimRight(filename, fmt.Sprintf(".%s", ext))
+	}
+	return filename
+}
+
 // Alt returns the `alt` text for the ImageMacro,
 func (i ImageMacro) Alt() string {
 	if alt, ok := i.Attributes[AttrImageAlt].(string); ok {
@@ -1377,6 +1974,13 @@ func (i ImageMacro) Alt() string {
 	return ""
 }
 
+// HasAlt returns true if the `alt` text of the ImageMacro was specified in the document
+// (i.e., if it is not the default one, based on the filename)
+func (i ImageMacro) HasAlt() bool {
+	alt := i.Alt()
+	return alt != "" && alt != defaultImageAlt(i.Path)
+}
+
 // Width returns the `width` text for the ImageMacro,
 func (i ImageMacro) Width() string {
 	if width, ok := i.Attributes[AttrImageWidth].(string); ok {
@@ -1393,6 +1997,43 @@ func (i ImageMacro) Height() string {
 	return ""
 }
 
+// Title returns the `title` text for the ImageMacro,
+func (i ImageMacro) Title() string {
+	return i.stringAttribute(AttrImageTitle)
+}
+
+// Link returns the `link` target for the ImageMacro,
+func (i ImageMacro) Link() string {
+	return i.stringAttribute(AttrImageLink)
+}
+
+// Align returns the `align` value for the ImageMacro,
+func (i ImageMacro) Align() string {
+	return i.stringAttribute(AttrImageAlign)
+}
+
+// Float returns the `float` value for the ImageMacro,
+func (i ImageMacro) Float() string {
+	return i.stringAttribute(AttrImageFloat)
+}
+
+// Role returns the `role` value for the ImageMacro,
+func (i ImageMacro) Role() string {
+	return i.stringAttribute(AttrImageRole)
+}
+
+// ScaledWidth returns the `scaledwidth` value for the ImageMacro,
+func (i ImageMacro) ScaledWidth() string {
+	return i.stringAttribute(AttrImageScaledWidth)
+}
+
+func (i ImageMacro) stringAttribute(key string) string {
+	if value, ok := i.Attributes[key].(string); ok {
+		return value
+	}
+	return ""
+}
+
 // NewImageAttributes returns a map of image attributes, some of which have implict keys (`alt`, `width` and `height`)
 func NewImageAttributes(alt, width, height []interface{}, otherAttrs []interface{}) (map[string]interface{}, error) {
 	result := map[string]interface{}{}
@@ -1412,9 +2053,13 @@ func NewImageAttributes(alt, width, height []interface{}, otherAttrs []interface
 	result[AttrImageWidth] = widthStr
 	result[AttrImageHeight] = heightStr
 	for _, otherAttr := range otherAttrs {
-		if otherAttr, ok := otherAttr.(map[string]interface{}); ok {
+		if otherAttr, ok := otherAttr.(GenericAttribute); ok {
 			for k, v := range otherAttr {
-				result[k] = v
+				if v, ok := v.(string); ok {
+					result[k] = strings.TrimSpace(v)
+				} else {
+					result[k] = v
+				}
 			}
 		}
 	}
@@ -1429,6 +2074,7 @@ func NewImageAttributes(alt, width, height []interface{}, otherAttrs []interface
 type DelimitedBlock struct {
 	Attributes map[string]interface{}
 	Elements   []interface{}
+	Location   Location
 }
 
 // Substitution the substituion group to apply when initializing a delimited block
@@ -1458,6 +2104,10 @@ func Verbatim(content []interface{}) ([]interface{}, error) {
 func NewDelimitedBlock(kind BlockKind, content []interface{}, attributes []interface{}, substitution Substitution) (DelimitedBlock, error) {
 	log.Debugf("Initializing a new DelimitedBlock of kind '%v'", kind)
 	attrbs := NewElementAttributes(attributes)
+	if (kind == Listing || kind == Fenced) && attrbs[AttrBlockKind] == Source {
+		// listing and fenced blocks with the `source` style are source blocks
+		kind = Source
+	}
 	attrbs[AttrBlockKind] = kind
 	elements, err := substitution(content)
 	if err != nil {
@@ -1469,13 +2119,733 @@ func NewDelimitedBlock(kind BlockKind, content []interface{}, attributes []inter
 	}, nil
 }
 
+// Accept implements Visitable#Accept(Visitor)
+func (b DelimitedBlock) Accept(v Visitor) error {
+	err := v.BeforeVisit(b)
+	if err != nil {
+		return errors.Wrapf(err, "error while pre-visiting delimited block")
+	}
+	err = v.Visit(b)
+	if err != nil {
+		return errors.Wrapf(err, "error while visiting delimited block")
+	}
+	for _, element := range b.Elements {
+		if visitable, ok := element.(Visitable); ok {
+			err = visitable.Accept(v)
+			if err != nil {
+				return errors.Wrapf(err, "error while visiting delimited block element")
+			}
+		}
+	}
+	err = v.AfterVisit(b)
+	if err != nil {
+		return errors.Wrapf(err, "error while post-visiting delimited block")
+	}
+	return nil
+}
+
+// ------------------------------------------
+// Callouts
+// ------------------------------------------
+
+// VerbatimLine a line in the verbatim content of a listing, fenced or source block, with its callouts (if any)
+type VerbatimLine struct {
+	Content  string
+	Callouts []Callout
+}
+
+// NewVerbatimLine initializes a new `VerbatimLine` from the given content and optional callouts
+func NewVerbatimLine(content []interface{}, callouts interface{}) (VerbatimLine, error) {
+	s, err := stringify(content)
+	if err != nil {
+		return VerbatimLine{}, errors.Wrapf(err, "unable to initialize a new verbatim line")
+	}
+	var cs []Callout
+	if callouts, ok := callouts.([]interface{}); ok {
+		for _, c := range callouts {
+			if c, ok := c.(Callout); ok {
+				cs = append(cs, c)
+			}
+		}
+	}
+	return VerbatimLine{
+		Content:  s,
+		Callouts: cs,
+	}, nil
+}
+
+// Accept implements Visitable#Accept(Visitor)
+func (l VerbatimLine) Accept(v Visitor) error {
+	err := v.BeforeVisit(l)
+	if err != nil {
+		return errors.Wrapf(err, "error while pre-visiting verbatim line")
+	}
+	err = v.Visit(l)
+	if err != nil {
+		return errors.Wrapf(err, "error while visiting verbatim line")
+	}
+	for _, element := range l.Callouts {
+		err = element.Accept(v)
+		if err != nil {
+			return errors.Wrapf(err, "error while visiting verbatim line element")
+		}
+	}
+	err = v.AfterVisit(l)
+	if err != nil {
+		return errors.Wrapf(err, "error while post-visiting verbatim line")
+	}
+	return nil
+}
+
+// HasCallouts returns true if at least one of the given verbatim lines has callouts
+func HasCallouts(lines []interface{}) bool {
+	for _, l := range lines {
+		if l, ok := l.(VerbatimLine); ok && len(l.Callouts) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// Callout a callout marker (eg: `<1>`) at the end of a line in a listing, fenced or source block
+type Callout struct {
+	Ref int
+}
+
+// NewCallout initializes a new `Callout` from the given reference number
+func NewCallout(ref string) (Callout, error) {
+	r, err := strconv.Atoi(ref)
+	if err != nil {
+		return Callout{}, errors.Wrapf(err, "invalid callout reference: '%s'", ref)
+	}
+	return Callout{
+		Ref: r,
+	}, nil
+}
+
+// Accept implements Visitable#Accept(Visitor)
+func (c Callout) Accept(v Visitor) error {
+	err := v.BeforeVisit(c)
+	if err != nil {
+		return errors.Wrapf(err, "error while pre-visiting callout")
+	}
+	err = v.Visit(c)
+	if err != nil {
+		return errors.Wrapf(err, "error while visiting callout")
+	}
+	err = v.AfterVisit(c)
+	if err != nil {
+		return errors.Wrapf(err, "error while post-visiting callout")
+	}
+	return nil
+}
+
+// CalloutList the structure for the list of callout descriptions following a block with callouts
+type CalloutList struct {
+	Attributes map[string]interface{}
+	Items      []CalloutListItem
+	Location   Location
+}
+
+// NewCalloutList initializes a new `CalloutList` from the given items
+func NewCalloutList(items []interface{}, attributes []interface{}) (CalloutList, error) {
+	log.Debugf("initializing a new CalloutList from %d elements", len(items))
+	result := make([]CalloutListItem, 0, len(items))
+	for _, item := range items {
+		if item, ok := item.(CalloutListItem); ok {
+			result = append(result, item)
+		}
+	}
+	return CalloutList{
+		Attributes: mergeAttributes(attributes),
+		Items:      result,
+	}, nil
+}
+
+// Accept implements Visitable#Accept(Visitor)
+func (l CalloutList) Accept(v Visitor) error {
+	err := v.BeforeVisit(l)
+	if err != nil {
+		return errors.Wrapf(err, "error while pre-visiting callout list")
+	}
+	err = v.Visit(l)
+	if err != nil {
+		return errors.Wrapf(err, "error while visiting callout list")
+	}
+	for _, element := range l.Items {
+		err = element.Accept(v)
+		if err != nil {
+			return errors.Wrapf(err, "error while visiting callout list element")
+		}
+	}
+	err = v.AfterVisit(l)
+	if err != nil {
+		return errors.Wrapf(err, "error while post-visiting callout list")
+	}
+	return nil
+}
+
+// CalloutListItem an item in a callout list
+type CalloutListItem struct {
+	Ref      int
+	Elements []interface{}
+	Location Location
+}
+
+// NewCalloutListItem initializes a new `CalloutListItem` with the given reference and content
+func NewCalloutListItem(ref Callout, elements []interface{}) (CalloutListItem, error) {
+	return CalloutListItem{
+		Ref:      ref.Ref,
+		Elements: elements,
+	}, nil
+}
+
+// Accept implements Visitable#Accept(Visitor)
+func (i CalloutListItem) Accept(v Visitor) error {
+	err := v.BeforeVisit(i)
+	if err != nil {
+		return errors.Wrapf(err, "error while pre-visiting callout list item")
+	}
+	err = v.Visit(i)
+	if err != nil {
+		return errors.Wrapf(err, "error while visiting callout list item")
+	}
+	for _, element := range i.Elements {
+		if visitable, ok := element.(Visitable); ok {
+			err = visitable.Accept(v)
+			if err != nil {
+				return errors.Wrapf(err, "error while visiting callout list item element")
+			}
+		}
+	}
+	err = v.AfterVisit(i)
+	if err != nil {
+		return errors.Wrapf(err, "error while post-visiting callout list item")
+	}
+	return nil
+}
+
+// AddChild appends the given item to the content of this CalloutListItem
+func (i *CalloutListItem) AddChild(item interface{}) {
+	i.Elements = append(i.Elements, item)
+}
+
+// making sure that the `ListItem` interface is implemented by `CalloutListItem`
+var _ ListItem = &CalloutListItem{}
+
+// ------------------------------------------
+// Tables
+// ------------------------------------------
+
+const (
+	// AttrCols the key for the column specifications of a table
+	AttrCols string = "cols"
+	// AttrOptions the key for the options of an element
+	AttrOptions string = "options"
+	// AttrOpts the short key for the options of an element
+	AttrOpts string = "opts"
+	// AttrFrame the key for the frame of a table
+	AttrFrame string = "frame"
+	// AttrGrid the key for the grid of a table
+	AttrGrid string = "grid"
+)
+
+// Table the structure for the tables
+type Table struct {
+	Attributes map[string]interface{}
+	Columns    []TableColumn
+	Header     TableRow
+	Rows       []TableRow
+	Footer     TableRow
+	Location   Location
+}
+
+// HAlignment the horizontal alignment of a table column or cell
+type HAlignment string
+
+const (
+	// HAlignDefault the zero value for the horizontal alignment (ie, inherited from the column)
+	HAlignDefault HAlignment = ""
+	// HAlignLeft the content is aligned on the left (`<`)
+	HAlignLeft HAlignment = "left"
+	// HAlignCenter the content is centered (`^`)
+	HAlignCenter HAlignment = "center"
+	// HAlignRight the content is aligned on the right (`>`)
+	HAlignRight HAlignment = "right"
+)
+
+// VAlignment the vertical alignment of a table column or cell
+type VAlignment string
+
+const (
+	// VAlignDefault the zero value for the vertical alignment (ie, inherited from the column)
+	VAlignDefault VAlignment = ""
+	// VAlignTop the content is aligned at the top (`.<`)
+	VAlignTop VAlignment = "top"
+	// VAlignMiddle the content is aligned in the middle (`.^`)
+	VAlignMiddle VAlignment = "middle"
+	// VAlignBottom the content is aligned at the bottom (`.>`)
+	VAlignBottom VAlignment = "bottom"
+)
+
+// CellStyle the style of a table column or cell
+type CellStyle string
+
+const (
+	// DefaultCellStyle the zero value for the cell style (ie, inherited from the column)
+	DefaultCellStyle CellStyle = ""
+	// AsciidocCellStyle the `a` style
+	AsciidocCellStyle CellStyle = "asciidoc"
+	// EmphasisCellStyle the `e` style
+	EmphasisCellStyle CellStyle = "emphasis"
+	// HeaderCellStyle the `h` style
+	HeaderCellStyle CellStyle = "header"
+	// LiteralCellStyle the `l` style
+	LiteralCellStyle CellStyle = "literal"
+	// MonospaceCellStyle the `m` style
+	MonospaceCellStyle CellStyle = "monospace"
+	// StrongCellStyle the `s` style
+	StrongCellStyle CellStyle = "strong"
+	// VerseCellStyle the `v` style
+	VerseCellStyle CellStyle = "verse"
+	// ParagraphCellStyle the `d` style (ie, the default style)
+	ParagraphCellStyle CellStyle = "paragraph"
+)
+
+var cellStyles = map[string]CellStyle{
+	"a": AsciidocCellStyle,
+	"d": ParagraphCellStyle,
+	"e": EmphasisCellStyle,
+	"h": HeaderCellStyle,
+	"l": LiteralCellStyle,
+	"m": MonospaceCellStyle,
+	"s": StrongCellStyle,
+	"v": VerseCellStyle,
+}
+
+// TableColumn the specification of a column, as declared in the `cols` attribute of a table
+type TableColumn struct {
+	Width     int // relative width. Zero when the column is in `autowidth` mode (`~`)
+	HAlign    HAlignment
+	VAlign    VAlignment
+	Style     CellStyle
+	AutoWidth bool
+}
+
+// TableRow a row in a table
+type TableRow struct {
+	Cells []TableCell
+}
+
+// Accept implements Visitable#Accept(Visitor)
+func (r TableRow) Accept(v Visitor) error {
+	err := v.BeforeVisit(r)
+	if err != nil {
+		return errors.Wrapf(err, "error while pre-visiting table row")
+	}
+	err = v.Visit(r)
+	if err != nil {
+		return errors.Wrapf(err, "error while visiting table row")
+	}
+	for _, element := range r.Cells {
+		err = element.Accept(v)
+		if err != nil {
+			return errors.Wrapf(err, "error while visiting table row element")
+		}
+	}
+	err = v.AfterVisit(r)
+	if err != nil {
+		return errors.Wrapf(err, "error while post-visiting table row")
+	}
+	return nil
+}
+
+// TableCell a cell in a table
+type TableCell struct {
+	ColSpan int
+	RowSpan int
+	HAlign  HAlignment
+	VAlign  VAlignment
+	Style   CellStyle
+	Lines   []InlineElements
+}
+
+// TableLine a line of cells in the source of a table, where the optional `Continuation`
+// is content that belongs to the last cell of the previous line.
+// This is an intermediate structure which is only used while parsing the table.
+type TableLine struct {
+	Continuation InlineElements
+	Cells        []TableCell
+}
+
+// NewTableLine initializes a new TableLine with the given continuation content and cells
+func NewTableLine(continuation InlineElements, cells []interface{}) (TableLine, error) {
+	result := TableLine{
+		Cells: make([]TableCell, 0, len(cells)),
+	}
+	if len(continuation) > 0 {
+		result.Continuation = continuation
+	}
+	for _, c := range cells {
+		cell, ok := c.(TableCell)
+		if !ok {
+			return TableLine{}, errors.Errorf("unexpected type of table cell: %T", c)
+		}
+		result.Cells = append(result.Cells, cell)
+	}
+	return result, nil
+}
+
+// TableCellSpec the specification of a cell (span, alignments and style), eg: `2+^.>m`
+type TableCellSpec struct {
+	ColSpan int
+	RowSpan int
+	HAlign  HAlignment
+	VAlign  VAlignment
+	Style   CellStyle
+}
+
+// NewTableCellSpec initializes a new TableCellSpec from its raw value
+func NewTableCellSpec(spec string) (TableCellSpec, error) {
+	result := TableCellSpec{
+		ColSpan: 1,
+		RowSpan: 1,
+	}
+	s := spec
+	// span factor: `<colspan>+`, `.<rowspan>+` or `<colspan>.<rowspan>+`
+	if i := strings.Index(s, "+"); i >= 0 {
+		colspan, rowspan := s[:i], ""
+		if j := strings.Index(colspan, "."); j >= 0 {
+			colspan, rowspan = colspan[:j], colspan[j+1:]
+		}
+		if colspan != "" {
+			c, err := strconv.Atoi(colspan)
+			if err != nil {
+				return TableCellSpec{}, errors.Wrapf(err, "invalid column span in table cell spec '%s'", spec)
+			}
+			result.ColSpan = c
+		}
+		if rowspan != "" {
+			r, err := strconv.Atoi(rowspan)
+			if err != nil {
+				return TableCellSpec{}, errors.Wrapf(err, "invalid row span in table cell spec '%s'", spec)
+			}
+			result.RowSpan = r
+		}
+		s = s[i+1:]
+	}
+	result.HAlign, result.VAlign, s = parseAlignments(s)
+	if s != "" {
+		style, found := cellStyles[s]
+		if !found {
+			return TableCellSpec{}, errors.Errorf("invalid style in table cell spec '%s'", spec)
+		}
+		result.Style = style
+	}
+	log.Debugf("initialized a new TableCellSpec: %+v", result)
+	return result, nil
+}
+
+// NewTableCell initializes a new TableCell with the given optional spec and content
+func NewTableCell(spec interface{}, content InlineElements) (TableCell, error) {
+	result := TableCell{
+		ColSpan: 1,
+		RowSpan: 1,
+		Lines:   make([]InlineElements, 0),
+	}
+	if spec, ok := spec.(TableCellSpec); ok {
+		result.ColSpan = spec.ColSpan
+		result.RowSpan = spec.RowSpan
+		result.HAlign = spec.HAlign
+		result.VAlign = spec.VAlign
+		result.Style = spec.Style
+	}
+	if len(content) > 0 {
+		result.Lines = append(result.Lines, content)
+	}
+	return result, nil
+}
+
+// Accept implements Visitable#Accept(Visitor)
+func (c TableCell) Accept(v Visitor) error {
+	err := v.BeforeVisit(c)
+	if err != nil {
+		return errors.Wrapf(err, "error while pre-visiting table cell")
+	}
+	err = v.Visit(c)
+	if err != nil {
+		return errors.Wrapf(err, "error while visiting table cell")
+	}
+	for _, element := range c.Lines {
+		err = element.Accept(v)
+		if err != nil {
+			return errors.Wrapf(err, "error while visiting table cell element")
+		}
+	}
+	err = v.AfterVisit(c)
+	if err != nil {
+		return errors.Wrapf(err, "error while post-visiting table cell")
+	}
+	return nil
+}
+
+// parseAlignments parses the optional horizontal and vertical alignments
+// at the beginning of the given spec, and returns the remaining value
+func parseAlignments(s string) (HAlignment, VAlignment, string) {
+	halign := HAlignDefault
+	valign := VAlignDefault
+	if len(s) > 0 {
+		switch s[0] {
+		case '<':
+			halign, s = HAlignLeft, s[1:]
+		case '^':
+			halign, s = HAlignCenter, s[1:]
+		case '>':
+			halign, s = HAlignRight, s[1:]
+		}
+	}
+	if len(s) > 1 && s[0] == '.' {
+		switch s[1] {
+		case '<':
+			valign, s = VAlignTop, s[2:]
+		case '^':
+			valign, s = VAlignMiddle, s[2:]
+		case '>':
+			valign, s = VAlignBottom, s[2:]
+		}
+	}
+	return halign, valign, s
+}
+
+// NewTableColumns parses the value of the `cols` attribute of a table.
+// Each column spec has the following form: `[<multiplier>*][<halign>][.<valign>][<width>][<style>]`,
+// where specs are separated with a comma or a semicolon. Eg: `2*,^.^3m,~`
+func NewTableColumns(cols string) ([]TableColumn, error) {
+	result := make([]TableColumn, 0)
+	for _, spec := range strings.FieldsFunc(cols, func(r rune) bool { return r == ',' || r == ';' }) {
+		s := strings.TrimSpace(spec)
+		multiplier := 1
+		if i := strings.Index(s, "*"); i >= 0 {
+			m, err := strconv.Atoi(s[:i])
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid multiplier in column spec '%s'", spec)
+			}
+			multiplier, s = m, s[i+1:]
+		}
+		col := TableColumn{
+			Width: 1,
+		}
+		col.HAlign, col.VAlign, s = parseAlignments(s)
+		// width: digits (with an optional `%` suffix) or `~` for autowidth
+		width := strings.IndexFunc(s, func(r rune) bool { return r < '0' || r > '9' })
+		if width == -1 {
+			width = len(s)
+		}
+		if width > 0 {
+			w, err := strconv.Atoi(s[:width])
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid width in column spec '%s'", spec)
+			}
+			col.Width = w
+			s = strings.TrimPrefix(s[width:], "%")
+		} else if strings.HasPrefix(s, "~") {
+			col.Width = 0
+			col.AutoWidth = true
+			s = s[1:]
+		}
+		if s != "" {
+			style, found := cellStyles[s]
+			if !found {
+				return nil, errors.Errorf("invalid style in column spec '%s'", spec)
+			}
+			col.Style = style
+		}
+		for i := 0; i < multiplier; i++ {
+			result = append(result, col)
+		}
+	}
+	log.Debugf("initialized %d table column(s) from '%s'", len(result), cols)
+	return result, nil
+}
+
+// NewTable initializes a new `Table` from the given lines of cells and attributes.
+// The cells are dispatched in rows, given the number of columns which is either specified with the `cols` attribute
+// or computed from the number of cells on the first line of the table.
+// The first row is the header if the `header` option is set, or if the first line of the table is followed
+// by a blank line (unless the `noheader` option is set). The last row is the footer if the `footer` option is set.
+func NewTable(lines []interface{}, attributes []interface{}) (Table, error) {
+	attrbs := NewElementAttributes(attributes)
+	options := tableOptions(attrbs)
+	// collect all cells, while appending continuation lines to the previous cell
+	cells := make([]TableCell, 0)
+	var firstLine *TableLine
+	implicitHeader := false
+	for i, l := range lines {
+		line, ok := l.(TableLine)
+		if !ok {
+			continue
+		}
+		if line.Continuation != nil && len(cells) > 0 {
+			cells[len(cells)-1].Lines = append(cells[len(cells)-1].Lines, line.Continuation)
+		}
+		if firstLine == nil && len(line.Cells) > 0 {
+			firstLine = &line
+			if i == 0 && len(lines) > 1 {
+				_, implicitHeader = lines[1].(BlankLine)
+			}
+		}
+		cells = append(cells, line.Cells...)
+	}
+	// columns
+	var columns []TableColumn
+	if cols, ok := attrbs[AttrCols].(string); ok && cols != "" {
+		c, err := NewTableColumns(cols)
+		if err != nil {
+			return Table{}, errors.Wrapf(err, "failed to initialize a new table")
+		}
+		columns = c
+	} else if firstLine != nil {
+		columns = make([]TableColumn, 0)
+		for _, cell := range firstLine.Cells {
+			for i := 0; i < cell.ColSpan; i++ {
+				columns = append(columns, TableColumn{Width: 1})
+			}
+		}
+	}
+	if options["autowidth"] {
+		for i := range columns {
+			columns[i].AutoWidth = true
+		}
+	}
+	rows := make([]TableRow, 0)
+	if len(columns) > 0 {
+		rows = newTableRows(cells, columns)
+	}
+	result := Table{
+		Attributes: attrbs,
+		Columns:    columns,
+		Rows:       rows,
+	}
+	if len(result.Rows) > 0 && (options["header"] || (implicitHeader && !options["noheader"])) {
+		result.Header = result.Rows[0]
+		result.Rows = result.Rows[1:]
+	}
+	if len(result.Rows) > 0 && options["footer"] {
+		result.Footer = result.Rows[len(result.Rows)-1]
+		result.Rows = result.Rows[:len(result.Rows)-1]
+	}
+	log.Debugf("initialized a new table with %d column(s) and %d row(s)", len(result.Columns), len(result.Rows))
+	return result, nil
+}
+
+// Accept implements Visitable#Accept(Visitor)
+func (t Table) Accept(v Visitor) error {
+	err := v.BeforeVisit(t)
+	if err != nil {
+		return errors.Wrapf(err, "error while pre-visiting table")
+	}
+	err = v.Visit(t)
+	if err != nil {
+		return errors.Wrapf(err, "error while visiting table")
+	}
+	for _, row := range t.rows() {
+		err = row.Accept(v)
+		if err != nil {
+			return errors.Wrapf(err, "error while visiting table row")
+		}
+	}
+	err = v.AfterVisit(t)
+	if err != nil {
+		return errors.Wrapf(err, "error while post-visiting table")
+	}
+	return nil
+}
+
+// rows returns the header (if any), the body rows and the footer (if any) of the table
+func (t Table) rows() []TableRow {
+	rows := make([]TableRow, 0, len(t.Rows)+2)
+	if len(t.Header.Cells) > 0 {
+		rows = append(rows, t.Header)
+	}
+	rows = append(rows, t.Rows...)
+	if len(t.Footer.Cells) > 0 {
+		rows = append(rows, t.Footer)
+	}
+	return rows
+}
+
+// tableOptions returns the options of the table, which can be set
+// with the `options` (or `opts`) attribute, or with the `%` shorthand (eg: `[%header]`)
+func tableOptions(attributes map[string]interface{}) map[string]bool {
+	result := make(map[string]bool)
+	for _, key := range []string{AttrOptions, AttrOpts} {
+		if opts, ok := attributes[key].(string); ok {
+			for _, opt := range strings.Split(opts, ",") {
+				result[strings.TrimSpace(opt)] = true
+			}
+		}
+	}
+	for k := range attributes {
+		if strings.HasPrefix(k, "%") {
+			for _, opt := range strings.Split(k, "%") {
+				if opt != "" {
+					result[opt] = true
+				}
+			}
+		}
+	}
+	return result
+}
+
+// newTableRows dispatches the given cells in rows, taking into account the column and row spans
+func newTableRows(cells []TableCell, columns []TableColumn) []TableRow {
+	rows := make([]TableRow, 0)
+	// number of slots already occupied in the upcoming rows by cells that span on multiple rows
+	occupied := make(map[int]int)
+	current := TableRow{
+		Cells: make([]TableCell, 0),
+	}
+	slots := 0
+	for _, cell := range cells {
+		// cell inherits the alignments and style of its column
+		column := columns[(slots+occupied[len(rows)])%len(columns)]
+		if cell.HAlign == HAlignDefault {
+			cell.HAlign = column.HAlign
+		}
+		if cell.VAlign == VAlignDefault {
+			cell.VAlign = column.VAlign
+		}
+		if cell.Style == DefaultCellStyle {
+			cell.Style = column.Style
+		}
+		current.Cells = append(current.Cells, cell)
+		slots += cell.ColSpan
+		for r := 1; r < cell.RowSpan; r++ {
+			occupied[len(rows)+r] += cell.ColSpan
+		}
+		if slots+occupied[len(rows)] >= len(columns) {
+			rows = append(rows, current)
+			current = TableRow{
+				Cells: make([]TableCell, 0),
+			}
+			slots = 0
+		}
+	}
+	// incomplete last row
+	if len(current.Cells) > 0 {
+		rows = append(rows, current)
+	}
+	return rows
+}
+
 // ------------------------------------------
 // Literal blocks
 // ------------------------------------------
 
 // LiteralBlock the structure for the literal blocks
 type LiteralBlock struct {
-	Content string
+	Content  string
+	Location Location
 }
 
 // NewLiteralBlock initializes a new `DelimitedBlock` of the given kind with the given content,
@@ -1495,6 +2865,23 @@ func NewLiteralBlock(spaces, content []interface{}) (LiteralBlock, error) {
 	}, nil
 }
 
+// Accept implements Visitable#Accept(Visitor)
+func (b LiteralBlock) Accept(v Visitor) error {
+	err := v.BeforeVisit(b)
+	if err != nil {
+		return errors.Wrapf(err, "error while pre-visiting literal block")
+	}
+	err = v.Visit(b)
+	if err != nil {
+		return errors.Wrapf(err, "error while visiting literal block")
+	}
+	err = v.AfterVisit(b)
+	if err != nil {
+		return errors.Wrapf(err, "error while post-visiting literal block")
+	}
+	return nil
+}
+
 // ------------------------------------------
 // Comments
 // ------------------------------------------
@@ -1516,6 +2903,23 @@ func NewSingleLineComment(content []interface{}) (SingleLineComment, error) {
 	}, nil
 }
 
+// Accept implements Visitable#Accept(Visitor)
+func (c SingleLineComment) Accept(v Visitor) error {
+	err := v.BeforeVisit(c)
+	if err != nil {
+		return errors.Wrapf(err, "error while pre-visiting single line comment")
+	}
+	err = v.Visit(c)
+	if err != nil {
+		return errors.Wrapf(err, "error while visiting single line comment")
+	}
+	err = v.AfterVisit(c)
+	if err != nil {
+		return errors.Wrapf(err, "error while post-visiting single line comment")
+	}
+	return nil
+}
+
 // ------------------------------------------
 // Elements attributes
 // ------------------------------------------
@@ -1535,6 +2939,12 @@ const (
 	AttrVerseAuthor string = "verseAuthor"
 	// AttrVerseTitle attribute for the title of a verse
 	AttrVerseTitle string = "verseTitle"
+	// AttrLanguage attribute for the language of a source block
+	AttrLanguage string = "language"
+	// AttrQuoteAuthor attribute for the author of a quote
+	AttrQuoteAuthor string = "quoteAuthor"
+	// AttrQuoteTitle attribute for the title of the source of a quote
+	AttrQuoteTitle string = "quoteTitle"
 )
 
 // NewElementAttributes retrieves the ElementID, ElementTitle and ElementLink from the given slice of attributes
@@ -1626,6 +3036,31 @@ func NewGenericAttribute(key []interface{}, value []interface{}) (GenericAttribu
 	return result, nil
 }
 
+// NewSourceAttributes initializes a new map of attributes for a source block with the given (optional) language
+// and other attributes
+func NewSourceAttributes(language string, others []interface{}) (map[string]interface{}, error) {
+	result, err := NewAttributeGroup(others)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to initialize new source attributes")
+	}
+	result[AttrBlockKind] = Source
+	if language = strings.TrimSpace(language); language != "" {
+		result[AttrLanguage] = language
+	}
+	log.Debugf("initialized new source attributes: %v", result)
+	return result, nil
+}
+
+// HasSourceStyle returns true if the given attributes contain the `source` style
+func HasSourceStyle(attributes []interface{}) bool {
+	for _, attr := range attributes {
+		if attr, ok := attr.(map[string]interface{}); ok && attr[AttrBlockKind] == Source {
+			return true
+		}
+	}
+	return false
+}
+
 // NewVerseAttributes initializes a new map of attributes for a verse paragraph
 func NewVerseAttributes(author, title string) (map[string]interface{}, error) {
 	result := make(map[string]interface{}, 3)
@@ -1636,6 +3071,26 @@ func NewVerseAttributes(author, title string) (map[string]interface{}, error) {
 	return result, nil
 }
 
+// HasVerseStyle returns true if the given attributes contain the `verse` style
+func HasVerseStyle(attributes []interface{}) bool {
+	for _, attr := range attributes {
+		if attr, ok := attr.(map[string]interface{}); ok && attr[AttrBlockKind] == Verse {
+			return true
+		}
+	}
+	return false
+}
+
+// NewQuoteAttributes initializes a new map of attributes for a quote block
+func NewQuoteAttributes(author, title string) (map[string]interface{}, error) {
+	result := make(map[string]interface{}, 3)
+	result[AttrBlockKind] = Quote
+	result[AttrQuoteAuthor] = strings.TrimSpace(author)
+	result[AttrQuoteTitle] = strings.TrimSpace(title)
+	log.Debugf("initialized new quote attributes: %v", result)
+	return result, nil
+}
+
 // ------------------------------------------
 // StringElement
 // ------------------------------------------
@@ -1675,6 +3130,7 @@ func (s StringElement) Accept(v Visitor) error {
 type QuotedText struct {
 	Kind     QuotedTextKind
 	Elements []interface{}
+	Location Location
 }
 
 // QuotedTextKind the type for
@@ -1752,6 +3208,7 @@ func NewEscapedQuotedText(backslashes []interface{}, punctuation string, content
 type Passthrough struct {
 	Kind     PassthroughKind
 	Elements []interface{}
+	Location Location
 }
 
 // PassthroughKind the kind of passthrough
@@ -1775,6 +3232,31 @@ func NewPassthrough(kind PassthroughKind, elements []interface{}) (Passthrough,
 
 }
 
+// Accept implements Visitable#Accept(Visitor)
+func (p Passthrough) Accept(v Visitor) error {
+	err := v.BeforeVisit(p)
+	if err != nil {
+		return errors.Wrapf(err, "error while pre-visiting passthrough")
+	}
+	err = v.Visit(p)
+	if err != nil {
+		return errors.Wrapf(err, "error while visiting passthrough")
+	}
+	for _, element := range p.Elements {
+		if visitable, ok := element.(Visitable); ok {
+			err = visitable.Accept(v)
+			if err != nil {
+				return errors.Wrapf(err, "error while visiting passthrough element")
+			}
+		}
+	}
+	err = v.AfterVisit(p)
+	if err != nil {
+		return errors.Wrapf(err, "error while post-visiting passthrough")
+	}
+	return nil
+}
+
 // ------------------------------------------
 // BlankLine
 // ------------------------------------------
@@ -1789,6 +3271,23 @@ func NewBlankLine() (BlankLine, error) {
 	return BlankLine{}, nil
 }
 
+// Accept implements Visitable#Accept(Visitor)
+func (l BlankLine) Accept(v Visitor) error {
+	err := v.BeforeVisit(l)
+	if err != nil {
+		return errors.Wrapf(err, "error while pre-visiting blank line")
+	}
+	err = v.Visit(l)
+	if err != nil {
+		return errors.Wrapf(err, "error while visiting blank line")
+	}
+	err = v.AfterVisit(l)
+	if err != nil {
+		return errors.Wrapf(err, "error while post-visiting blank line")
+	}
+	return nil
+}
+
 // ------------------------------------------
 // Links
 // ------------------------------------------
@@ -1797,6 +3296,7 @@ func NewBlankLine() (BlankLine, error) {
 type Link struct {
 	URL        string
 	Attributes map[string]interface{}
+	Location   Location
 }
 
 // NewLink initializes a new `Link`
@@ -1817,6 +3317,23 @@ func NewLink(url []interface{}, attributes map[string]interface{}) (Link, error)
 	}, nil
 }
 
+// Accept implements Visitable#Accept(Visitor)
+func (l Link) Accept(v Visitor) error {
+	err := v.BeforeVisit(l)
+	if err != nil {
+		return errors.Wrapf(err, "error while pre-visiting link")
+	}
+	err = v.Visit(l)
+	if err != nil {
+		return errors.Wrapf(err, "error while visiting link")
+	}
+	err = v.AfterVisit(l)
+	if err != nil {
+		return errors.Wrapf(err, "error while post-visiting link")
+	}
+	return nil
+}
+
 // Text returns the `text` value for the Link,
 func (l Link) Text() string {
 	if text, ok := l.Attributes[AttrLinkText].(string); ok {
@@ -1837,9 +3354,13 @@ func NewLinkAttributes(text []interface{}, otherAttrs []interface{}) (map[string
 	}
 	result[AttrLinkText] = textStr
 	for _, otherAttr := range otherAttrs {
-		if otherAttr, ok := otherAttr.(map[string]interface{}); ok {
+		if otherAttr, ok := otherAttr.(GenericAttribute); ok {
 			for k, v := range otherAttr {
-				result[k] = v
+				if v, ok := v.(string); ok {
+					result[k] = strings.TrimSpace(v)
+				} else {
+					result[k] = v
+				}
 			}
 		}
 	}
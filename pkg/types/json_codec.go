@@ -0,0 +1,361 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"unicode"
+
+	"github.com/pkg/errors"
+)
+
+// MarshalDocument returns the JSON representation of the given document.
+// Each element is an object with a `type` discriminator (the name of its Go type) and its fields, whose names
+// start with a lowercase letter. The values of the enum types (such as `BlockKind`) are written with their names (eg: `listing`).
+// Values of named types and integers which are held in
+// an `interface{}` (eg: in the element attributes) are also wrapped in an object with a `type` and a `value`,
+// so that the document can be unmarshalled back into the same structs with `UnmarshalDocument`.
+func MarshalDocument(doc Document) ([]byte, error) {
+	result, err := encodeJSON(reflect.ValueOf(doc), false)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to marshal document")
+	}
+	return json.MarshalIndent(result, "", "  ")
+}
+
+// UnmarshalDocument returns the document from its JSON representation, as produced by `MarshalDocument`
+func UnmarshalDocument(data []byte) (Document, error) {
+	doc := Document{}
+	if err := decodeJSON(json.RawMessage(data), reflect.ValueOf(&doc).Elem()); err != nil {
+		return Document{}, errors.Wrap(err, "unable to unmarshal document")
+	}
+	return doc, nil
+}
+
+const (
+	// jsonTypeKey the key of the type discriminator in the JSON objects
+	jsonTypeKey = "type"
+	// jsonValueKey the key of the value of the non-struct types in the JSON objects
+	jsonValueKey = "value"
+	// jsonIntType the type discriminator for the `int` values held in an `interface{}`
+	jsonIntType = "int"
+	// jsonMapType the type discriminator for the `map[string]interface{}` values held in an `interface{}`
+	jsonMapType = "map"
+)
+
+// jsonTypes the types which can be unmarshalled, indexed by their type discriminator
+var jsonTypes = map[string]reflect.Type{}
+
+func init() {
+	for _, v := range []interface{}{
+		Document{}, DocumentAttributes{}, ElementReferences{}, Footnotes{}, FootnoteReferences{},
+		DocumentAuthor{}, DocumentRevision{},
+		DocumentAttributeDeclaration{}, DocumentAttributeReset{}, DocumentAttributeSubstitution{},
+		TableOfContentsMacro{}, Preamble{}, FrontMatter{}, Section{}, SectionTitle{},
+		OrderedList{}, OrderedListItem{}, OrderedListItemPrefix{}, NumberingStyle(""),
+		UnorderedList{}, UnorderedListItem{}, UnorderedListItemPrefix{}, BulletStyle(""),
+		LabeledList{}, LabeledListItem{}, ListItemContinuation{},
+		Paragraph{}, AdmonitionKind(""), InlineElements{}, CrossReference{}, InlineAnchor{}, Footnote{},
+		BlockImage{}, InlineImage{}, ImageMacro{},
+		DelimitedBlock{}, BlockKind(0), VerbatimLine{}, Callout{}, CalloutList{}, CalloutListItem{},
+		Table{}, TableColumn{}, TableRow{}, TableCell{}, TableLine{}, TableCellSpec{},
+		HAlignment(""), VAlignment(""), CellStyle(""),
+		LiteralBlock{}, SingleLineComment{}, GenericAttribute{},
+		StringElement{}, QuotedText{}, QuotedTextKind(0), Passthrough{}, PassthroughKind(0),
+		BlankLine{}, Link{}, Location{}, Position{},
+	} {
+		t := reflect.TypeOf(v)
+		jsonTypes[t.Name()] = t
+	}
+}
+
+// jsonEnumNames the names of the values of the enum types, which do not depend on the order of
+// their declaration and thus remain stable across versions
+var jsonEnumNames = map[reflect.Type]map[int64]string{
+	reflect.TypeOf(BlockKind(0)): {
+		int64(Fenced):           "fenced",
+		int64(Listing):          "listing",
+		int64(Example):          "example",
+		int64(Comment):          "comment",
+		int64(Verse):            "verse",
+		int64(Source):           "source",
+		int64(Quote):            "quote",
+		int64(Sidebar):          "sidebar",
+		int64(Open):             "open",
+		int64(PassthroughBlock): "passthrough",
+	},
+	reflect.TypeOf(QuotedTextKind(0)): {
+		int64(Bold):      "bold",
+		int64(Italic):    "italic",
+		int64(Monospace): "monospace",
+	},
+	reflect.TypeOf(PassthroughKind(0)): {
+		int64(SinglePlusPassthrough): "single-plus",
+		int64(TriplePlusPassthrough): "triple-plus",
+		int64(PassthroughMacro):      "macro",
+	},
+}
+
+// encodeJSON converts the given value into a tree of maps, slices and basic values which can be marshalled in JSON.
+// The `dynamic` flag indicates if the value is held in an `interface{}`, in which case its type must be
+// recorded to be able to unmarshal it.
+func encodeJSON(v reflect.Value, dynamic bool) (interface{}, error) {
+	switch v.Kind() {
+	case reflect.Invalid:
+		return nil, nil
+	case reflect.Interface, reflect.Ptr:
+		if v.IsNil() {
+			return nil, nil
+		}
+		return encodeJSON(v.Elem(), dynamic || v.Kind() == reflect.Interface)
+	case reflect.Struct:
+		if _, found := jsonTypes[v.Type().Name()]; !found || v.Type().PkgPath() != documentType.PkgPath() {
+			return nil, errors.Errorf("unsupported type of element: %s", v.Type())
+		}
+		result := map[string]interface{}{
+			jsonTypeKey: v.Type().Name(),
+		}
+		for i := 0; i < v.NumField(); i++ {
+			f := v.Type().Field(i)
+			if f.PkgPath != "" { // unexported field
+				continue
+			}
+			value, err := encodeJSON(v.Field(i), false)
+			if err != nil {
+				return nil, err
+			}
+			result[jsonFieldName(f.Name)] = value
+		}
+		return result, nil
+	case reflect.Slice:
+		if v.IsNil() {
+			return nil, nil
+		}
+		result := make([]interface{}, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			value, err := encodeJSON(v.Index(i), false)
+			if err != nil {
+				return nil, err
+			}
+			result[i] = value
+		}
+		if dynamic && v.Type() != interfacesType {
+			return wrapJSON(v.Type(), result)
+		}
+		return result, nil
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			return nil, errors.Errorf("unsupported type of map: %s", v.Type())
+		}
+		if v.IsNil() {
+			return nil, nil
+		}
+		result := make(map[string]interface{}, v.Len())
+		for _, k := range v.MapKeys() {
+			value, err := encodeJSON(v.MapIndex(k), false)
+			if err != nil {
+				return nil, err
+			}
+			result[k.String()] = value
+		}
+		if dynamic {
+			if v.Type() == attributesType {
+				return map[string]interface{}{
+					jsonTypeKey:  jsonMapType,
+					jsonValueKey: result,
+				}, nil
+			}
+			return wrapJSON(v.Type(), result)
+		}
+		return result, nil
+	case reflect.String, reflect.Bool, reflect.Float64:
+		if dynamic && v.Type().PkgPath() != "" {
+			return wrapJSON(v.Type(), v.Interface())
+		}
+		return v.Interface(), nil
+	case reflect.Int:
+		if names, found := jsonEnumNames[v.Type()]; found {
+			name, found := names[v.Int()]
+			if !found {
+				return nil, errors.Errorf("unsupported value of %s: %d", v.Type(), v.Int())
+			}
+			if dynamic {
+				return wrapJSON(v.Type(), name)
+			}
+			return name, nil
+		}
+		if dynamic && v.Type().PkgPath() != "" {
+			return wrapJSON(v.Type(), v.Int())
+		} else if dynamic {
+			return map[string]interface{}{
+				jsonTypeKey:  jsonIntType,
+				jsonValueKey: v.Int(),
+			}, nil
+		}
+		return v.Int(), nil
+	default:
+		return nil, errors.Errorf("unsupported type of value: %s", v.Type())
+	}
+}
+
+// wrapJSON wraps the given value in an object with a type discriminator
+func wrapJSON(t reflect.Type, value interface{}) (interface{}, error) {
+	if _, found := jsonTypes[t.Name()]; !found || t.PkgPath() != documentType.PkgPath() {
+		return nil, errors.Errorf("unsupported type of value: %s", t)
+	}
+	return map[string]interface{}{
+		jsonTypeKey:  t.Name(),
+		jsonValueKey: value,
+	}, nil
+}
+
+var documentType = reflect.TypeOf(Document{})
+var interfacesType = reflect.TypeOf([]interface{}{})
+var attributesType = reflect.TypeOf(map[string]interface{}{})
+
+// decodeJSON decodes the given JSON data into the given value, which must be settable
+func decodeJSON(data json.RawMessage, v reflect.Value) error {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 || bytes.Equal(data, []byte("null")) {
+		return nil // keep the zero value
+	}
+	switch v.Kind() {
+	case reflect.Interface:
+		value, err := decodeDynamicJSON(data)
+		if err != nil {
+			return err
+		}
+		v.Set(value)
+		return nil
+	case reflect.Ptr:
+		value := reflect.New(v.Type().Elem())
+		if err := decodeJSON(data, value.Elem()); err != nil {
+			return err
+		}
+		v.Set(value)
+		return nil
+	case reflect.Struct:
+		fields := map[string]json.RawMessage{}
+		if err := json.Unmarshal(data, &fields); err != nil {
+			return errors.Wrapf(err, "unable to decode element of type %s", v.Type())
+		}
+		if t, found := fields[jsonTypeKey]; found {
+			var typeName string
+			if err := json.Unmarshal(t, &typeName); err != nil || typeName != v.Type().Name() {
+				return errors.Errorf("unable to decode element of type %s into %s", t, v.Type())
+			}
+		}
+		for i := 0; i < v.NumField(); i++ {
+			f := v.Type().Field(i)
+			if f.PkgPath != "" { // unexported field
+				continue
+			}
+			if err := decodeJSON(fields[jsonFieldName(f.Name)], v.Field(i)); err != nil {
+				return err
+			}
+		}
+		return nil
+	case reflect.Slice:
+		elements := []json.RawMessage{}
+		if err := json.Unmarshal(data, &elements); err != nil {
+			return errors.Wrapf(err, "unable to decode value of type %s", v.Type())
+		}
+		result := reflect.MakeSlice(v.Type(), len(elements), len(elements))
+		for i, e := range elements {
+			if err := decodeJSON(e, result.Index(i)); err != nil {
+				return err
+			}
+		}
+		v.Set(result)
+		return nil
+	case reflect.Map:
+		entries := map[string]json.RawMessage{}
+		if err := json.Unmarshal(data, &entries); err != nil {
+			return errors.Wrapf(err, "unable to decode value of type %s", v.Type())
+		}
+		result := reflect.MakeMapWithSize(v.Type(), len(entries))
+		for k, e := range entries {
+			value := reflect.New(v.Type().Elem()).Elem()
+			if err := decodeJSON(e, value); err != nil {
+				return err
+			}
+			result.SetMapIndex(reflect.ValueOf(k).Convert(v.Type().Key()), value)
+		}
+		v.Set(result)
+		return nil
+	default:
+		if names, found := jsonEnumNames[v.Type()]; found {
+			var name string
+			if err := json.Unmarshal(data, &name); err != nil {
+				return errors.Wrapf(err, "unable to decode value of type %s", v.Type())
+			}
+			for value, n := range names {
+				if n == name {
+					v.SetInt(value)
+					return nil
+				}
+			}
+			return errors.Errorf("unknown value of %s: '%s'", v.Type(), name)
+		}
+		if err := json.Unmarshal(data, v.Addr().Interface()); err != nil {
+			return errors.Wrapf(err, "unable to decode value of type %s", v.Type())
+		}
+		return nil
+	}
+}
+
+// decodeDynamicJSON decodes the given JSON data held in an `interface{}`, using the type discriminator of the objects
+func decodeDynamicJSON(data json.RawMessage) (reflect.Value, error) {
+	switch data[0] {
+	case '[':
+		value := reflect.New(interfacesType).Elem()
+		err := decodeJSON(data, value)
+		return value, err
+	case '{':
+		fields := map[string]json.RawMessage{}
+		if err := json.Unmarshal(data, &fields); err != nil {
+			return reflect.Value{}, errors.Wrap(err, "unable to decode element")
+		}
+		var typeName string
+		if err := json.Unmarshal(fields[jsonTypeKey], &typeName); err != nil || typeName == "" {
+			return reflect.Value{}, errors.Errorf("missing type of element in '%s'", data)
+		}
+		var t reflect.Type
+		switch typeName {
+		case jsonIntType:
+			t = reflect.TypeOf(0)
+		case jsonMapType:
+			t = attributesType
+		default:
+			var found bool
+			if t, found = jsonTypes[typeName]; !found {
+				return reflect.Value{}, errors.Errorf("unknown type of element: '%s'", typeName)
+			}
+		}
+		value := reflect.New(t).Elem()
+		if t.Kind() == reflect.Struct {
+			return value, decodeJSON(data, value)
+		}
+		return value, decodeJSON(fields[jsonValueKey], value)
+	default:
+		// strings, booleans and numbers
+		var value interface{}
+		if err := json.Unmarshal(data, &value); err != nil {
+			return reflect.Value{}, errors.Wrap(err, "unable to decode value")
+		}
+		return reflect.ValueOf(value), nil
+	}
+}
+
+// jsonFieldName returns the name of the given struct field in JSON, ie, with its leading uppercase letters
+// in lowercase (eg: `Elements` becomes `elements`, `ID` becomes `id` and `HAlign` becomes `hAlign`)
+func jsonFieldName(name string) string {
+	r := []rune(name)
+	for i := 0; i < len(r) && unicode.IsUpper(r[i]); i++ {
+		if i > 0 && i+1 < len(r) && unicode.IsLower(r[i+1]) {
+			break
+		}
+		r[i] = unicode.ToLower(r[i])
+	}
+	return string(r)
+}
@@ -0,0 +1,211 @@
+package types_test
+
+import (
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/parser"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	. "github.com/onsi/ginkgo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var _ = Describe("json codec", func() {
+
+	It("marshal document with type discriminators", func() {
+		// given
+		doc := types.Document{
+			Attributes: types.DocumentAttributes{},
+			Elements: []interface{}{
+				types.DelimitedBlock{
+					Attributes: map[string]interface{}{
+						types.AttrBlockKind: types.Listing,
+						"level":             2,
+					},
+					Elements: []interface{}{
+						types.StringElement{
+							Content: "some content",
+						},
+					},
+					Location: types.Location{
+						File:  "test.adoc",
+						Start: types.Position{Line: 1, Col: 1, Offset: 0},
+						End:   types.Position{Line: 3, Col: 5, Offset: 25},
+					},
+				},
+			},
+		}
+		// when
+		result, err := types.MarshalDocument(doc)
+		// then
+		require.NoError(GinkgoT(), err)
+		assert.Equal(GinkgoT(), `{
+  "attributes": {},
+  "elementReferences": null,
+  "elements": [
+    {
+      "attributes": {
+        "kind": {
+          "type": "BlockKind",
+          "value": "listing"
+        },
+        "level": {
+          "type": "int",
+          "value": 2
+        }
+      },
+      "elements": [
+        {
+          "content": "some content",
+          "type": "StringElement"
+        }
+      ],
+      "location": {
+        "end": {
+          "col": 5,
+          "line": 3,
+          "offset": 25,
+          "type": "Position"
+        },
+        "file": "test.adoc",
+        "start": {
+          "col": 1,
+          "line": 1,
+          "offset": 0,
+          "type": "Position"
+        },
+        "type": "Location"
+      },
+      "type": "DelimitedBlock"
+    }
+  ],
+  "footnoteReferences": null,
+  "footnotes": null,
+  "type": "Document"
+}`, string(result))
+	})
+
+	It("marshal and unmarshal a parsed document", func() {
+		// given
+		content := `= Document Title
+Kismet Chameleon <kismet@asciidoctor.org>
+v1.0, 2019-01-01
+:toc:
+:foo: bar
+
+== Section with *bold* and _italic_ content
+
+a paragraph with a link:https://example.com[link], an image:foo.png[foo, 10, 20], a {foo} substitution,
+a footnote:ref[a note] and a reference to <<_section_with_bold_and_italic_content>>.
+
+[#list]
+. item 1
+.. item 1.1
+
+NOTE: an admonition
+
+* item 2
+
+[source,go]
+----
+func main() {} // <1>
+----
+<1> the callout
+
+[quote, author, title]
+____
+a quote
+____
+
+term:: description
+
+.a table
+[cols="2,1"]
+|===
+|a |b
+|===
+
+ a literal block
+
+image::images/foo.png[the foo]
+
+// a comment
++++<b>pass</b>+++`
+		doc, err := parser.ParseReader("", strings.NewReader(content))
+		require.NoError(GinkgoT(), err)
+		// when
+		data, err := types.MarshalDocument(doc.(types.Document))
+		require.NoError(GinkgoT(), err)
+		result, err := types.UnmarshalDocument(data)
+		// then
+		require.NoError(GinkgoT(), err)
+		assert.Equal(GinkgoT(), doc, result)
+	})
+
+	It("marshal and unmarshal enums with their names", func() {
+		// given
+		doc := types.Document{
+			Elements: []interface{}{
+				types.DelimitedBlock{
+					Attributes: map[string]interface{}{
+						types.AttrBlockKind: types.PassthroughBlock,
+					},
+				},
+				types.Paragraph{
+					Lines: []types.InlineElements{
+						{
+							types.QuotedText{Kind: types.Monospace},
+							types.Passthrough{Kind: types.TriplePlusPassthrough},
+						},
+					},
+				},
+			},
+		}
+		// when
+		data, err := types.MarshalDocument(doc)
+		require.NoError(GinkgoT(), err)
+		result, err := types.UnmarshalDocument(data)
+		// then
+		require.NoError(GinkgoT(), err)
+		assert.Equal(GinkgoT(), doc, result)
+		assert.Contains(GinkgoT(), string(data), `"value": "passthrough"`)
+		assert.Contains(GinkgoT(), string(data), `"kind": "monospace"`)
+		assert.Contains(GinkgoT(), string(data), `"kind": "triple-plus"`)
+		for name, kind := range map[string]types.QuotedTextKind{
+			"bold":      types.Bold,
+			"italic":    types.Italic,
+			"monospace": types.Monospace,
+		} {
+			result, err := types.UnmarshalDocument([]byte(`{"type": "Document", "elements": [{"type": "QuotedText", "kind": "` + name + `"}]}`))
+			require.NoError(GinkgoT(), err)
+			assert.Equal(GinkgoT(), types.QuotedText{Kind: kind}, result.Elements[0])
+		}
+	})
+
+	It("fail to unmarshal unknown value of enum", func() {
+		// when
+		_, err := types.UnmarshalDocument([]byte(`{"type": "Document", "elements": [{"type": "QuotedText", "kind": "strikethrough"}]}`))
+		// then
+		assert.EqualError(GinkgoT(), err, "unable to unmarshal document: unknown value of types.QuotedTextKind: 'strikethrough'")
+	})
+
+	It("fail to unmarshal unknown type of element", func() {
+		// when
+		_, err := types.UnmarshalDocument([]byte(`{"type": "Document", "elements": [{"type": "Unknown"}]}`))
+		// then
+		assert.EqualError(GinkgoT(), err, "unable to unmarshal document: unknown type of element: 'Unknown'")
+	})
+
+	It("fail to marshal unsupported type of element", func() {
+		// given
+		doc := types.Document{
+			Elements: []interface{}{
+				struct{}{},
+			},
+		}
+		// when
+		_, err := types.MarshalDocument(doc)
+		// then
+		assert.Error(GinkgoT(), err)
+	})
+})
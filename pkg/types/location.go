@@ -0,0 +1,266 @@
+package types
+
+import (
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+)
+
+// ------------------------------------------
+// Positions and Locations
+// ------------------------------------------
+
+// Position a position in a source document
+type Position struct {
+	Line   int // the line number, starting at 1
+	Col    int // the column number (in characters), starting at 1
+	Offset int // the offset (in bytes), starting at 0
+}
+
+// Advance returns the position after the given text, which starts at the current position
+func (p Position) Advance(text []byte) Position {
+	result := p
+	result.Offset += len(text)
+	for len(text) > 0 {
+		r, size := utf8.DecodeRune(text)
+		if r == '\n' {
+			result.Line++
+			result.Col = 1
+		} else {
+			result.Col++
+		}
+		text = text[size:]
+	}
+	return result
+}
+
+// Location the range of an element in a source document, from its start (inclusive) to its end (exclusive)
+type Location struct {
+	File  string
+	Start Position
+	End   Position
+}
+
+// IsZero returns true if the location is unknown
+func (l Location) IsZero() bool {
+	return l.Start.Line == 0
+}
+
+// String returns the location in the `file:line:col` format (or `line:col` if the file is unknown)
+func (l Location) String() string {
+	if l.File == "" {
+		return fmt.Sprintf("%d:%d", l.Start.Line, l.Start.Col)
+	}
+	return fmt.Sprintf("%s:%d:%d", l.File, l.Start.Line, l.Start.Col)
+}
+
+// WithLocation returns a copy of the given element with the given location, if the element supports it.
+// Other elements are returned unchanged
+func WithLocation(element interface{}, location Location) interface{} {
+	switch e := element.(type) {
+	case Section:
+		e.Location = location
+		return e
+	case Paragraph:
+		e.Location = location
+		return e
+	case DelimitedBlock:
+		e.Location = location
+		return e
+	case LiteralBlock:
+		e.Location = location
+		return e
+	case BlockImage:
+		e.Location = location
+		return e
+	case Table:
+		e.Location = location
+		return e
+	case OrderedList:
+		e.Location = location
+		return e
+	case UnorderedList:
+		e.Location = location
+		return e
+	case LabeledList:
+		e.Location = location
+		return e
+	case CalloutList:
+		e.Location = location
+		return e
+	case OrderedListItem:
+		e.Location = location
+		return e
+	case UnorderedListItem:
+		e.Location = location
+		return e
+	case LabeledListItem:
+		e.Location = location
+		return e
+	case CalloutListItem:
+		e.Location = location
+		return e
+	case QuotedText:
+		e.Location = location
+		return e
+	case Passthrough:
+		e.Location = location
+		return e
+	case CrossReference:
+		e.Location = location
+		return e
+	case InlineAnchor:
+		e.Location = location
+		return e
+	case Footnote:
+		e.Location = location
+		return e
+	case Link:
+		e.Location = location
+		return e
+	case InlineImage:
+		e.Location = location
+		return e
+	case DocumentAttributeSubstitution:
+		e.Location = location
+		return e
+	default:
+		return element
+	}
+}
+
+// LocationOf returns the location of the given element, or `false` if it is unknown
+func LocationOf(element interface{}) (Location, bool) {
+	var l Location
+	switch e := element.(type) {
+	case Section:
+		l = e.Location
+	case Paragraph:
+		l = e.Location
+	case DelimitedBlock:
+		l = e.Location
+	case LiteralBlock:
+		l = e.Location
+	case BlockImage:
+		l = e.Location
+	case Table:
+		l = e.Location
+	case OrderedList:
+		l = e.Location
+	case UnorderedList:
+		l = e.Location
+	case LabeledList:
+		l = e.Location
+	case CalloutList:
+		l = e.Location
+	case OrderedListItem:
+		l = e.Location
+	case UnorderedListItem:
+		l = e.Location
+	case LabeledListItem:
+		l = e.Location
+	case CalloutListItem:
+		l = e.Location
+	case QuotedText:
+		l = e.Location
+	case Passthrough:
+		l = e.Location
+	case CrossReference:
+		l = e.Location
+	case InlineAnchor:
+		l = e.Location
+	case Footnote:
+		l = e.Location
+	case Link:
+		l = e.Location
+	case InlineImage:
+		l = e.Location
+	case DocumentAttributeSubstitution:
+		l = e.Location
+	}
+	return l, !l.IsZero()
+}
+
+// spanLocation returns the location which spans from the start of the first location to the end of the last one
+func spanLocation(first, last Location) Location {
+	if first.IsZero() || last.IsZero() {
+		return Location{}
+	}
+	return Location{
+		File:  first.File,
+		Start: first.Start,
+		End:   last.End,
+	}
+}
+
+// ------------------------------------------
+// Source Map
+// ------------------------------------------
+
+// SourceLine the origin of a line in the preprocessed content of a document
+type SourceLine struct {
+	// File the name of the file from which the line was read
+	File string
+	// Line the number of the line in its file, starting at 1
+	Line int
+	// Offset the offset of the line in its file
+	Offset int
+	// TargetOffset the offset of the line in the preprocessed content
+	TargetOffset int
+}
+
+// SourceMap the origins of the lines in the preprocessed content of a document, in order
+type SourceMap []SourceLine
+
+// Resolve returns the file and the position in this file of the given position in the preprocessed content.
+// The position is returned unchanged if the map has no entry for its line.
+func (m SourceMap) Resolve(p Position) (string, Position) {
+	if len(m) == 0 || p.Line < 1 {
+		return "", p
+	}
+	i := p.Line - 1
+	if i >= len(m) {
+		// the position is after the last line (eg: at the end of the content)
+		i = len(m) - 1
+	}
+	l := m[i]
+	return l.File, Position{
+		Line:   l.Line + p.Line - 1 - i,
+		Col:    p.Col,
+		Offset: l.Offset + p.Offset - l.TargetOffset,
+	}
+}
+
+// ------------------------------------------
+// Location errors
+// ------------------------------------------
+
+// LocationError an error which occurred while processing the element at the given location
+type LocationError struct {
+	Location Location
+	Err      error
+}
+
+// Error returns the message of the error, prefixed with its location
+func (e LocationError) Error() string {
+	return fmt.Sprintf("%s: %v", e.Location, e.Err)
+}
+
+// WrapWithLocation wraps the given error in a `LocationError` with the location of the given element,
+// unless the error already has a location (the innermost element is the most accurate) or the element has no location.
+func WrapWithLocation(err error, element interface{}) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := errors.Cause(err).(LocationError); ok {
+		return err
+	}
+	if l, ok := LocationOf(element); ok {
+		return LocationError{
+			Location: l,
+			Err:      err,
+		}
+	}
+	return err
+}
@@ -0,0 +1,55 @@
+package types_test
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	. "github.com/onsi/ginkgo"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var _ = Describe("locations", func() {
+
+	It("advance position over multiple lines", func() {
+		start := types.Position{Line: 2, Col: 3, Offset: 10}
+		assert.Equal(GinkgoT(), types.Position{Line: 3, Col: 6, Offset: 22}, start.Advance([]byte("été\nvoilà")))
+	})
+
+	It("resolve position with source map", func() {
+		sourceMap := types.SourceMap{
+			{File: "index.adoc", Line: 1, Offset: 0, TargetOffset: 0},
+			{File: "chapter.adoc", Line: 4, Offset: 30, TargetOffset: 11},
+		}
+		file, position := sourceMap.Resolve(types.Position{Line: 2, Col: 5, Offset: 15})
+		assert.Equal(GinkgoT(), "chapter.adoc", file)
+		assert.Equal(GinkgoT(), types.Position{Line: 4, Col: 5, Offset: 34}, position)
+		// position after the last line
+		file, position = sourceMap.Resolve(types.Position{Line: 3, Col: 1, Offset: 20})
+		assert.Equal(GinkgoT(), "chapter.adoc", file)
+		assert.Equal(GinkgoT(), types.Position{Line: 5, Col: 1, Offset: 39}, position)
+	})
+
+	It("wrap error with the location of the innermost element", func() {
+		paragraph := types.Paragraph{
+			Location: types.Location{
+				File:  "test.adoc",
+				Start: types.Position{Line: 3, Col: 1, Offset: 14},
+			},
+		}
+		section := types.Section{
+			Location: types.Location{
+				File:  "test.adoc",
+				Start: types.Position{Line: 1, Col: 1, Offset: 0},
+			},
+		}
+		err := types.WrapWithLocation(errors.New("an error"), paragraph)
+		err = types.WrapWithLocation(errors.Wrap(err, "unable to render paragraph"), section)
+		require.Error(GinkgoT(), err)
+		assert.Equal(GinkgoT(), "unable to render paragraph: test.adoc:3:1: an error", err.Error())
+	})
+
+	It("keep error unchanged when the location is unknown", func() {
+		err := types.WrapWithLocation(errors.New("an error"), types.Paragraph{})
+		assert.Equal(GinkgoT(), "an error", err.Error())
+	})
+})
@@ -43,15 +43,28 @@ func NewReplaceNonAlphanumericsFunc(replacement string) NormalizationFunc {
 }
 
 // ReplaceNonAlphanumerics replace all non alpha numeric characters with the given `replacement`
+// (the content of the footnotes is ignored)
 func ReplaceNonAlphanumerics(source InlineElements, replacement string) (string, error) {
+	content, err := Rewrite(RewriterFunc(withoutFootnotes), source)
+	if err != nil {
+		return "", err
+	}
 	v := NewReplaceNonAlphanumericsVisitor()
-	err := source.Accept(&v)
+	err = content.(InlineElements).Accept(&v)
 	if err != nil {
 		return "", err
 	}
 	return v.NormalizedContent(), nil
 }
 
+// withoutFootnotes removes the footnotes
+func withoutFootnotes(element interface{}) (interface{}, error) {
+	if _, ok := element.(Footnote); ok {
+		return nil, nil
+	}
+	return element, nil
+}
+
 //ReplaceNonAlphanumericsVisitor a visitor that builds a string representation of the visited elements,
 // in which all non-alphanumeric characters have been replaced with a "_"
 type ReplaceNonAlphanumericsVisitor struct {
@@ -0,0 +1,237 @@
+package types
+
+import (
+	"reflect"
+
+	"github.com/pkg/errors"
+)
+
+// Rewriter a visitor which can replace the elements of a document
+type Rewriter interface {
+	// Rewrite returns the replacement of the given element, whose children were already rewritten.
+	// Returns the element itself to keep it unchanged, or `nil` to remove it from its parent.
+	Rewrite(element interface{}) (interface{}, error)
+}
+
+// RewriterFunc a function which can be used as a Rewriter
+type RewriterFunc func(element interface{}) (interface{}, error)
+
+// Rewrite Implements Rewriter#Rewrite()
+func (f RewriterFunc) Rewrite(element interface{}) (interface{}, error) {
+	return f(element)
+}
+
+// Rewrite returns a copy of the given element in which the element itself and all its children were replaced
+// by the given rewriter, bottom-up (i.e., the children of an element are rewritten before the element itself).
+// The replacements of the list items, table rows and cells and paragraph lines must have the same type
+// as the original elements, since they are held in typed fields.
+func Rewrite(r Rewriter, element interface{}) (interface{}, error) {
+	element, err := rewriteChildren(r, element)
+	if err != nil {
+		return nil, err
+	}
+	return r.Rewrite(element)
+}
+
+// RewriteDocument returns a copy of the given document in which the elements were replaced by the given rewriter
+// (see `Rewrite`). The element references and the footnotes of the document are collected again.
+func RewriteDocument(r Rewriter, doc Document, diagnostics *Diagnostics) (Document, error) {
+	elements, err := rewriteSlice(r, doc.Elements)
+	if err != nil {
+		return Document{}, errors.Wrap(err, "error while rewriting document")
+	}
+	doc.Elements = elements.([]interface{})
+	doc.Elements, doc.ElementReferences, doc.Footnotes, doc.FootnoteReferences, err = collectReferences(doc.Elements, diagnostics)
+	if err != nil {
+		return Document{}, errors.Wrap(err, "error while rewriting document")
+	}
+	return doc, nil
+}
+
+// rewriteChildren returns a copy of the given element in which the children were rewritten
+func rewriteChildren(r Rewriter, element interface{}) (interface{}, error) {
+	var children interface{}
+	var err error
+	switch e := element.(type) {
+	case Document:
+		children, err = rewriteSlice(r, e.Elements)
+		if err == nil {
+			e.Elements = children.([]interface{})
+		}
+		return e, err
+	case Preamble:
+		children, err = rewriteSlice(r, e.Elements)
+		if err == nil {
+			e.Elements = children.([]interface{})
+		}
+		return e, err
+	case Section:
+		title, err := Rewrite(r, e.Title)
+		if err != nil {
+			return nil, err
+		}
+		var ok bool
+		if e.Title, ok = title.(SectionTitle); !ok {
+			return nil, errors.Errorf("unexpected replacement of type %T for a section title", title)
+		}
+		children, err = rewriteSlice(r, e.Elements)
+		if err == nil {
+			e.Elements = children.([]interface{})
+		}
+		return e, err
+	case SectionTitle:
+		children, err = rewriteSlice(r, e.Content)
+		if err == nil {
+			e.Content = children.(InlineElements)
+		}
+		return e, err
+	case Paragraph:
+		children, err = rewriteSlice(r, e.Lines)
+		if err == nil {
+			e.Lines = children.([]InlineElements)
+		}
+		return e, err
+	case InlineElements:
+		return rewriteSlice(r, e)
+	case QuotedText:
+		children, err = rewriteSlice(r, e.Elements)
+		if err == nil {
+			e.Elements = children.([]interface{})
+		}
+		return e, err
+	case Passthrough:
+		children, err = rewriteSlice(r, e.Elements)
+		if err == nil {
+			e.Elements = children.([]interface{})
+		}
+		return e, err
+	case Footnote:
+		children, err = rewriteSlice(r, e.Elements)
+		if err == nil {
+			e.Elements = children.(InlineElements)
+		}
+		return e, err
+	case OrderedList:
+		children, err = rewriteSlice(r, e.Items)
+		if err == nil {
+			e.Items = children.([]OrderedListItem)
+		}
+		return e, err
+	case OrderedListItem:
+		children, err = rewriteSlice(r, e.Elements)
+		if err == nil {
+			e.Elements = children.([]interface{})
+		}
+		return e, err
+	case UnorderedList:
+		children, err = rewriteSlice(r, e.Items)
+		if err == nil {
+			e.Items = children.([]UnorderedListItem)
+		}
+		return e, err
+	case UnorderedListItem:
+		children, err = rewriteSlice(r, e.Elements)
+		if err == nil {
+			e.Elements = children.([]interface{})
+		}
+		return e, err
+	case LabeledList:
+		children, err = rewriteSlice(r, e.Items)
+		if err == nil {
+			e.Items = children.([]LabeledListItem)
+		}
+		return e, err
+	case LabeledListItem:
+		children, err = rewriteSlice(r, e.Elements)
+		if err == nil {
+			e.Elements = children.([]interface{})
+		}
+		return e, err
+	case CalloutList:
+		children, err = rewriteSlice(r, e.Items)
+		if err == nil {
+			e.Items = children.([]CalloutListItem)
+		}
+		return e, err
+	case CalloutListItem:
+		children, err = rewriteSlice(r, e.Elements)
+		if err == nil {
+			e.Elements = children.([]interface{})
+		}
+		return e, err
+	case DelimitedBlock:
+		children, err = rewriteSlice(r, e.Elements)
+		if err == nil {
+			e.Elements = children.([]interface{})
+		}
+		return e, err
+	case Table:
+		if e.Header, err = rewriteTableRow(r, e.Header); err != nil {
+			return nil, err
+		}
+		children, err = rewriteSlice(r, e.Rows)
+		if err != nil {
+			return nil, err
+		}
+		e.Rows = children.([]TableRow)
+		e.Footer, err = rewriteTableRow(r, e.Footer)
+		return e, err
+	case TableRow:
+		children, err = rewriteSlice(r, e.Cells)
+		if err == nil {
+			e.Cells = children.([]TableCell)
+		}
+		return e, err
+	case TableCell:
+		children, err = rewriteSlice(r, e.Lines)
+		if err == nil {
+			e.Lines = children.([]InlineElements)
+		}
+		return e, err
+	default:
+		return element, nil
+	}
+}
+
+// rewriteTableRow rewrites the given table header or footer, which is kept empty if it has no cell
+func rewriteTableRow(r Rewriter, row TableRow) (TableRow, error) {
+	if len(row.Cells) == 0 {
+		return row, nil
+	}
+	result, err := Rewrite(r, row)
+	if err != nil {
+		return TableRow{}, err
+	}
+	if result == nil {
+		return TableRow{}, nil
+	}
+	if row, ok := result.(TableRow); ok {
+		return row, nil
+	}
+	return TableRow{}, errors.Errorf("unexpected replacement of type %T for a table row", result)
+}
+
+// rewriteSlice returns a copy of the given slice (eg: `[]interface{}` or `[]OrderedListItem`) in which
+// all elements were rewritten. The elements replaced by `nil` are removed.
+func rewriteSlice(r Rewriter, slice interface{}) (interface{}, error) {
+	v := reflect.ValueOf(slice)
+	if v.IsNil() {
+		return slice, nil
+	}
+	result := reflect.MakeSlice(v.Type(), 0, v.Len())
+	for i := 0; i < v.Len(); i++ {
+		element, err := Rewrite(r, v.Index(i).Interface())
+		if err != nil {
+			return nil, err
+		}
+		if element == nil {
+			continue
+		}
+		e := reflect.ValueOf(element)
+		if !e.Type().AssignableTo(v.Type().Elem()) {
+			return nil, errors.Errorf("unexpected replacement of type %T for an element of type %s", element, v.Type().Elem())
+		}
+		result = reflect.Append(result, e)
+	}
+	return result.Interface(), nil
+}
@@ -0,0 +1,91 @@
+package types_test
+
+import (
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	. "github.com/onsi/ginkgo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var _ = Describe("rewriter", func() {
+
+	It("replace the elements at all levels", func() {
+		// given
+		doc := parse(GinkgoT(), "== Section\n\n* item with *bold* content")
+		// when
+		result, err := types.RewriteDocument(types.RewriterFunc(func(element interface{}) (interface{}, error) {
+			if s, ok := element.(types.StringElement); ok {
+				return types.StringElement{Content: strings.ToUpper(s.Content)}, nil
+			}
+			return element, nil
+		}), doc, nil)
+		// then
+		require.NoError(GinkgoT(), err)
+		section := result.Elements[0].(types.Section)
+		assert.Equal(GinkgoT(), types.InlineElements{types.StringElement{Content: "SECTION"}}, section.Title.Content)
+		list := section.Elements[1].(types.UnorderedList)
+		line := list.Items[0].Elements[0].(types.Paragraph).Lines[0]
+		assert.Equal(GinkgoT(), types.StringElement{Content: "ITEM WITH "}, line[0])
+		assert.Equal(GinkgoT(), []interface{}{types.StringElement{Content: "BOLD"}}, line[1].(types.QuotedText).Elements)
+		// the original document is unchanged
+		assert.Equal(GinkgoT(), types.InlineElements{types.StringElement{Content: "Section"}}, doc.Elements[0].(types.Section).Title.Content)
+	})
+
+	It("remove and replace blocks", func() {
+		// given
+		doc := parse(GinkgoT(), "first paragraph\n\nsecond paragraph")
+		// when
+		result, err := types.RewriteDocument(types.RewriterFunc(func(element interface{}) (interface{}, error) {
+			switch e := element.(type) {
+			case types.BlankLine:
+				return nil, nil
+			case types.Paragraph:
+				return types.DelimitedBlock{
+					Attributes: map[string]interface{}{
+						types.AttrBlockKind: types.Sidebar,
+					},
+					Elements: []interface{}{e},
+				}, nil
+			default:
+				return element, nil
+			}
+		}), doc, nil)
+		// then
+		require.NoError(GinkgoT(), err)
+		require.Len(GinkgoT(), result.Elements, 2)
+		assert.IsType(GinkgoT(), types.DelimitedBlock{}, result.Elements[0])
+		assert.IsType(GinkgoT(), types.Paragraph{}, result.Elements[1].(types.DelimitedBlock).Elements[0])
+	})
+
+	It("collect the references of the rewritten document", func() {
+		// given
+		doc := parse(GinkgoT(), "a paragraph")
+		// when
+		result, err := types.RewriteDocument(types.RewriterFunc(func(element interface{}) (interface{}, error) {
+			if p, ok := element.(types.Paragraph); ok {
+				p.Attributes[types.AttrID] = "intro"
+				return p, nil
+			}
+			return element, nil
+		}), doc, nil)
+		// then
+		require.NoError(GinkgoT(), err)
+		assert.Contains(GinkgoT(), result.ElementReferences, "intro")
+	})
+
+	It("fail with a replacement of another type for a list item", func() {
+		// given
+		doc := parse(GinkgoT(), "* item")
+		// when
+		_, err := types.RewriteDocument(types.RewriterFunc(func(element interface{}) (interface{}, error) {
+			if _, ok := element.(types.UnorderedListItem); ok {
+				return types.StringElement{Content: "item"}, nil
+			}
+			return element, nil
+		}), doc, nil)
+		// then
+		require.Error(GinkgoT(), err)
+	})
+})
@@ -0,0 +1,58 @@
+package types
+
+import (
+	"github.com/pkg/errors"
+)
+
+// Walk traverses the given elements and their children (depth-first, in document order) with the given visitor.
+// Elements which are not Visitable are skipped.
+func Walk(v Visitor, elements ...interface{}) error {
+	for _, element := range elements {
+		if visitable, ok := element.(Visitable); ok {
+			if err := visitable.Accept(v); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// VisitorFunc a function which is called on each visited element, which can be used as a Visitor
+// when nothing needs to be done before or after visiting the children of an element
+type VisitorFunc func(element Visitable) error
+
+// BeforeVisit Implements Visitor#BeforeVisit()
+func (f VisitorFunc) BeforeVisit(element Visitable) error {
+	return nil
+}
+
+// Visit Implements Visitor#Visit()
+func (f VisitorFunc) Visit(element Visitable) error {
+	return f(element)
+}
+
+// AfterVisit Implements Visitor#AfterVisit()
+func (f VisitorFunc) AfterVisit(element Visitable) error {
+	return nil
+}
+
+// Accept implements Visitable#Accept(Visitor)
+func (d Document) Accept(v Visitor) error {
+	err := v.BeforeVisit(d)
+	if err != nil {
+		return errors.Wrapf(err, "error while pre-visiting document")
+	}
+	err = v.Visit(d)
+	if err != nil {
+		return errors.Wrapf(err, "error while visiting document")
+	}
+	err = Walk(v, d.Elements...)
+	if err != nil {
+		return errors.Wrapf(err, "error while visiting document element")
+	}
+	err = v.AfterVisit(d)
+	if err != nil {
+		return errors.Wrapf(err, "error while post-visiting document")
+	}
+	return nil
+}
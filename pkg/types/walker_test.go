@@ -0,0 +1,167 @@
+package types_test
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/parser"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	. "github.com/onsi/ginkgo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var _ = Describe("walker", func() {
+
+	It("visit all types of elements", func() {
+		// given
+		actualContent := `== Section with *bold*
+
+[#para]
+a paragraph with a footnote:[a note], a https://example.com[link], an image:foo.png[foo] and a <<para>> reference to {foo}.
+
+. ordered item
+
+a paragraph
+
+* unordered item
+
+another paragraph
+
+term:: description
+
+[source,go]
+----
+fmt.Println("hello") <1>
+----
+<1> a callout
+
+|===
+| header
+
+| cell with +++pass+++ content
+|===
+
+____
+a quote
+____
+
+image::bar.png[bar]
+
+....
+literal
+....
+
+// a comment`
+		doc := parse(GinkgoT(), actualContent)
+		visited := map[string]int{}
+		// when
+		err := types.Walk(types.VisitorFunc(func(element types.Visitable) error {
+			visited[fmt.Sprintf("%T", element)]++
+			return nil
+		}), doc.Elements...)
+		// then
+		require.NoError(GinkgoT(), err)
+		for _, t := range []string{
+			"types.Section", "types.SectionTitle", "types.Footnote", "types.QuotedText",
+			"types.Paragraph", "types.InlineElements", "types.StringElement", "types.Link", "types.InlineImage",
+			"types.CrossReference", "types.DocumentAttributeSubstitution", "types.BlankLine",
+			"types.OrderedList", "types.OrderedListItem", "types.UnorderedList", "types.UnorderedListItem",
+			"types.LabeledList", "types.LabeledListItem",
+			"types.DelimitedBlock", "types.VerbatimLine", "types.Callout", "types.CalloutList", "types.CalloutListItem",
+			"types.Table", "types.TableRow", "types.TableCell", "types.Passthrough",
+			"types.BlockImage", "types.LiteralBlock", "types.SingleLineComment",
+		} {
+			assert.Contains(GinkgoT(), visited, t)
+		}
+		assert.Equal(GinkgoT(), 2, visited["types.TableRow"]) // header and body row
+	})
+
+	It("visit elements before, during and after their children", func() {
+		// given
+		doc := parse(GinkgoT(), "* item with *bold* content")
+		v := &tracingVisitor{}
+		// when
+		err := types.Walk(v, doc.Elements...)
+		// then
+		require.NoError(GinkgoT(), err)
+		assert.Equal(GinkgoT(), []string{
+			"before UnorderedList", "visit UnorderedList",
+			"before UnorderedListItem", "visit UnorderedListItem",
+			"before Paragraph", "visit Paragraph",
+			"before InlineElements", "visit InlineElements",
+			"before StringElement", "visit StringElement", "after StringElement",
+			"before QuotedText", "visit QuotedText",
+			"before StringElement", "visit StringElement", "after StringElement",
+			"after QuotedText",
+			"before StringElement", "visit StringElement", "after StringElement",
+			"after InlineElements",
+			"after Paragraph",
+			"after UnorderedListItem",
+			"after UnorderedList",
+		}, v.trace)
+	})
+
+	It("collect the references of the elements with an ID", func() {
+		// given
+		actualContent := `[#para]
+a paragraph
+
+[#list]
+* item
+
+[#image]
+image::foo.png[foo]
+
+[#block]
+----
+some code
+----
+
+[#table]
+|===
+| cell
+|===`
+		// when
+		doc := parse(GinkgoT(), actualContent)
+		// then
+		require.Len(GinkgoT(), doc.ElementReferences, 5)
+		assert.IsType(GinkgoT(), types.Paragraph{}, doc.ElementReferences["para"])
+		assert.IsType(GinkgoT(), types.UnorderedList{}, doc.ElementReferences["list"])
+		assert.IsType(GinkgoT(), types.BlockImage{}, doc.ElementReferences["image"])
+		assert.IsType(GinkgoT(), types.DelimitedBlock{}, doc.ElementReferences["block"])
+		assert.IsType(GinkgoT(), types.Table{}, doc.ElementReferences["table"])
+	})
+})
+
+// tracingVisitor a visitor which records the calls to its methods
+type tracingVisitor struct {
+	trace []string
+}
+
+func (v *tracingVisitor) BeforeVisit(element types.Visitable) error {
+	v.trace = append(v.trace, fmt.Sprintf("before %s", typeName(element)))
+	return nil
+}
+
+func (v *tracingVisitor) Visit(element types.Visitable) error {
+	v.trace = append(v.trace, fmt.Sprintf("visit %s", typeName(element)))
+	return nil
+}
+
+func (v *tracingVisitor) AfterVisit(element types.Visitable) error {
+	v.trace = append(v.trace, fmt.Sprintf("after %s", typeName(element)))
+	return nil
+}
+
+func typeName(element interface{}) string {
+	return fmt.Sprintf("%T", element)[len("types."):]
+}
+
+func parse(t GinkgoTInterface, content string) types.Document {
+	result, err := parser.ParseReader("", strings.NewReader(content))
+	require.NoError(t, err)
+	doc, ok := result.(types.Document)
+	require.True(t, ok)
+	return doc
+}